@@ -0,0 +1,1109 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package boiler
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// AccountFreeze is an object representing the database table.
+type AccountFreeze struct {
+	ID            string    `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	AccountID     string    `boiler:"account_id" boil:"account_id" json:"account_id" toml:"account_id" yaml:"account_id"`
+	Frozen        bool      `boiler:"frozen" boil:"frozen" json:"frozen" toml:"frozen" yaml:"frozen"`
+	FrozenCredits bool      `boiler:"frozen_credits" boil:"frozen_credits" json:"frozen_credits" toml:"frozen_credits" yaml:"frozen_credits"`
+	Reason        string    `boiler:"reason" boil:"reason" json:"reason" toml:"reason" yaml:"reason"`
+	Actor         string    `boiler:"actor" boil:"actor" json:"actor" toml:"actor" yaml:"actor"`
+	CreatedAt     time.Time `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *accountFreezeR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L accountFreezeL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var AccountFreezeColumns = struct {
+	ID            string
+	AccountID     string
+	Frozen        string
+	FrozenCredits string
+	Reason        string
+	Actor         string
+	CreatedAt     string
+}{
+	ID:            "id",
+	AccountID:     "account_id",
+	Frozen:        "frozen",
+	FrozenCredits: "frozen_credits",
+	Reason:        "reason",
+	Actor:         "actor",
+	CreatedAt:     "created_at",
+}
+
+var AccountFreezeTableColumns = struct {
+	ID            string
+	AccountID     string
+	Frozen        string
+	FrozenCredits string
+	Reason        string
+	Actor         string
+	CreatedAt     string
+}{
+	ID:            "account_freezes.id",
+	AccountID:     "account_freezes.account_id",
+	Frozen:        "account_freezes.frozen",
+	FrozenCredits: "account_freezes.frozen_credits",
+	Reason:        "account_freezes.reason",
+	Actor:         "account_freezes.actor",
+	CreatedAt:     "account_freezes.created_at",
+}
+
+// Generated where
+
+type whereHelperbool struct{ field string }
+
+func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+
+type whereHelpertime_Time struct{ field string }
+
+func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var AccountFreezeWhere = struct {
+	ID            whereHelperstring
+	AccountID     whereHelperstring
+	Frozen        whereHelperbool
+	FrozenCredits whereHelperbool
+	Reason        whereHelperstring
+	Actor         whereHelperstring
+	CreatedAt     whereHelpertime_Time
+}{
+	ID:            whereHelperstring{field: "\"account_freezes\".\"id\""},
+	AccountID:     whereHelperstring{field: "\"account_freezes\".\"account_id\""},
+	Frozen:        whereHelperbool{field: "\"account_freezes\".\"frozen\""},
+	FrozenCredits: whereHelperbool{field: "\"account_freezes\".\"frozen_credits\""},
+	Reason:        whereHelperstring{field: "\"account_freezes\".\"reason\""},
+	Actor:         whereHelperstring{field: "\"account_freezes\".\"actor\""},
+	CreatedAt:     whereHelpertime_Time{field: "\"account_freezes\".\"created_at\""},
+}
+
+// AccountFreezeRels is where relationship names are stored.
+var AccountFreezeRels = struct {
+	Account string
+}{
+	Account: "Account",
+}
+
+// accountFreezeR is where relationships are stored.
+type accountFreezeR struct {
+	Account *Account `boiler:"Account" boil:"Account" json:"Account" toml:"Account" yaml:"Account"`
+}
+
+// NewStruct creates a new relationship struct
+func (*accountFreezeR) NewStruct() *accountFreezeR {
+	return &accountFreezeR{}
+}
+
+func (r *accountFreezeR) GetAccount() *Account {
+	if r == nil {
+		return nil
+	}
+	return r.Account
+}
+
+// accountFreezeL is where Load methods for each relationship are stored.
+type accountFreezeL struct{}
+
+var (
+	accountFreezeAllColumns            = []string{"id", "account_id", "frozen", "frozen_credits", "reason", "actor", "created_at"}
+	accountFreezeColumnsWithoutDefault = []string{"account_id", "frozen", "frozen_credits", "reason", "actor"}
+	accountFreezeColumnsWithDefault    = []string{"id", "created_at"}
+	accountFreezePrimaryKeyColumns     = []string{"id"}
+	accountFreezeGeneratedColumns      = []string{}
+)
+
+type (
+	// AccountFreezeSlice is an alias for a slice of pointers to AccountFreeze.
+	// This should almost always be used instead of []AccountFreeze.
+	AccountFreezeSlice []*AccountFreeze
+	// AccountFreezeHook is the signature for custom AccountFreeze hook methods
+	AccountFreezeHook func(boil.Executor, *AccountFreeze) error
+
+	accountFreezeQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	accountFreezeType                 = reflect.TypeOf(&AccountFreeze{})
+	accountFreezeMapping              = queries.MakeStructMapping(accountFreezeType)
+	accountFreezePrimaryKeyMapping, _ = queries.BindMapping(accountFreezeType, accountFreezeMapping, accountFreezePrimaryKeyColumns)
+	accountFreezeInsertCacheMut       sync.RWMutex
+	accountFreezeInsertCache          = make(map[string]insertCache)
+	accountFreezeUpdateCacheMut       sync.RWMutex
+	accountFreezeUpdateCache          = make(map[string]updateCache)
+	accountFreezeUpsertCacheMut       sync.RWMutex
+	accountFreezeUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var accountFreezeAfterSelectHooks []AccountFreezeHook
+
+var accountFreezeBeforeInsertHooks []AccountFreezeHook
+var accountFreezeAfterInsertHooks []AccountFreezeHook
+
+var accountFreezeBeforeUpdateHooks []AccountFreezeHook
+var accountFreezeAfterUpdateHooks []AccountFreezeHook
+
+var accountFreezeBeforeDeleteHooks []AccountFreezeHook
+var accountFreezeAfterDeleteHooks []AccountFreezeHook
+
+var accountFreezeBeforeUpsertHooks []AccountFreezeHook
+var accountFreezeAfterUpsertHooks []AccountFreezeHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *AccountFreeze) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range accountFreezeAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *AccountFreeze) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range accountFreezeBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *AccountFreeze) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range accountFreezeAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *AccountFreeze) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range accountFreezeBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *AccountFreeze) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range accountFreezeAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *AccountFreeze) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range accountFreezeBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *AccountFreeze) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range accountFreezeAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *AccountFreeze) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range accountFreezeBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *AccountFreeze) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range accountFreezeAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddAccountFreezeHook registers your hook function for all future operations.
+func AddAccountFreezeHook(hookPoint boil.HookPoint, accountFreezeHook AccountFreezeHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		accountFreezeAfterSelectHooks = append(accountFreezeAfterSelectHooks, accountFreezeHook)
+	case boil.BeforeInsertHook:
+		accountFreezeBeforeInsertHooks = append(accountFreezeBeforeInsertHooks, accountFreezeHook)
+	case boil.AfterInsertHook:
+		accountFreezeAfterInsertHooks = append(accountFreezeAfterInsertHooks, accountFreezeHook)
+	case boil.BeforeUpdateHook:
+		accountFreezeBeforeUpdateHooks = append(accountFreezeBeforeUpdateHooks, accountFreezeHook)
+	case boil.AfterUpdateHook:
+		accountFreezeAfterUpdateHooks = append(accountFreezeAfterUpdateHooks, accountFreezeHook)
+	case boil.BeforeDeleteHook:
+		accountFreezeBeforeDeleteHooks = append(accountFreezeBeforeDeleteHooks, accountFreezeHook)
+	case boil.AfterDeleteHook:
+		accountFreezeAfterDeleteHooks = append(accountFreezeAfterDeleteHooks, accountFreezeHook)
+	case boil.BeforeUpsertHook:
+		accountFreezeBeforeUpsertHooks = append(accountFreezeBeforeUpsertHooks, accountFreezeHook)
+	case boil.AfterUpsertHook:
+		accountFreezeAfterUpsertHooks = append(accountFreezeAfterUpsertHooks, accountFreezeHook)
+	}
+}
+
+// One returns a single accountFreeze record from the query.
+func (q accountFreezeQuery) One(exec boil.Executor) (*AccountFreeze, error) {
+	o := &AccountFreeze{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: failed to execute a one query for account_freezes")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all AccountFreeze records from the query.
+func (q accountFreezeQuery) All(exec boil.Executor) (AccountFreezeSlice, error) {
+	var o []*AccountFreeze
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "boiler: failed to assign all query results to AccountFreeze slice")
+	}
+
+	if len(accountFreezeAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all AccountFreeze records in the query.
+func (q accountFreezeQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to count account_freezes rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q accountFreezeQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: failed to check if account_freezes exists")
+	}
+
+	return count > 0, nil
+}
+
+// Account pointed to by the foreign key.
+func (o *AccountFreeze) Account(mods ...qm.QueryMod) accountQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.AccountID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Accounts(queryMods...)
+}
+
+// LoadAccount allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (accountFreezeL) LoadAccount(e boil.Executor, singular bool, maybeAccountFreeze interface{}, mods queries.Applicator) error {
+	var slice []*AccountFreeze
+	var object *AccountFreeze
+
+	if singular {
+		var ok bool
+		object, ok = maybeAccountFreeze.(*AccountFreeze)
+		if !ok {
+			object = new(AccountFreeze)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAccountFreeze)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAccountFreeze))
+			}
+		}
+	} else {
+		s, ok := maybeAccountFreeze.(*[]*AccountFreeze)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAccountFreeze)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAccountFreeze))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &accountFreezeR{}
+		}
+		args = append(args, object.AccountID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &accountFreezeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.AccountID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.AccountID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`accounts`),
+		qm.WhereIn(`accounts.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Account")
+	}
+
+	var resultSlice []*Account
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Account")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for accounts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for accounts")
+	}
+
+	if len(accountFreezeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Account = foreign
+		if foreign.R == nil {
+			foreign.R = &accountR{}
+		}
+		foreign.R.AccountFreezes = append(foreign.R.AccountFreezes, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.AccountID == foreign.ID {
+				local.R.Account = foreign
+				if foreign.R == nil {
+					foreign.R = &accountR{}
+				}
+				foreign.R.AccountFreezes = append(foreign.R.AccountFreezes, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetAccount of the accountFreeze to the related item.
+// Sets o.R.Account to related.
+// Adds o to related.R.AccountFreezes.
+func (o *AccountFreeze) SetAccount(exec boil.Executor, insert bool, related *Account) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"account_freezes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"account_id"}),
+		strmangle.WhereClause("\"", "\"", 2, accountFreezePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.AccountID = related.ID
+	if o.R == nil {
+		o.R = &accountFreezeR{
+			Account: related,
+		}
+	} else {
+		o.R.Account = related
+	}
+
+	if related.R == nil {
+		related.R = &accountR{
+			AccountFreezes: AccountFreezeSlice{o},
+		}
+	} else {
+		related.R.AccountFreezes = append(related.R.AccountFreezes, o)
+	}
+
+	return nil
+}
+
+// AccountFreezes retrieves all the records using an executor.
+func AccountFreezes(mods ...qm.QueryMod) accountFreezeQuery {
+	mods = append(mods, qm.From("\"account_freezes\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"account_freezes\".*"})
+	}
+
+	return accountFreezeQuery{q}
+}
+
+// FindAccountFreeze retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAccountFreeze(exec boil.Executor, iD string, selectCols ...string) (*AccountFreeze, error) {
+	accountFreezeObj := &AccountFreeze{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"account_freezes\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, accountFreezeObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: unable to select from account_freezes")
+	}
+
+	if err = accountFreezeObj.doAfterSelectHooks(exec); err != nil {
+		return accountFreezeObj, err
+	}
+
+	return accountFreezeObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *AccountFreeze) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no account_freezes provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(accountFreezeColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	accountFreezeInsertCacheMut.RLock()
+	cache, cached := accountFreezeInsertCache[key]
+	accountFreezeInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			accountFreezeAllColumns,
+			accountFreezeColumnsWithDefault,
+			accountFreezeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(accountFreezeType, accountFreezeMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(accountFreezeType, accountFreezeMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"account_freezes\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"account_freezes\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to insert into account_freezes")
+	}
+
+	if !cached {
+		accountFreezeInsertCacheMut.Lock()
+		accountFreezeInsertCache[key] = cache
+		accountFreezeInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// Update uses an executor to update the AccountFreeze.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *AccountFreeze) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	accountFreezeUpdateCacheMut.RLock()
+	cache, cached := accountFreezeUpdateCache[key]
+	accountFreezeUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			accountFreezeAllColumns,
+			accountFreezePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("boiler: unable to update account_freezes, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"account_freezes\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, accountFreezePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(accountFreezeType, accountFreezeMapping, append(wl, accountFreezePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update account_freezes row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by update for account_freezes")
+	}
+
+	if !cached {
+		accountFreezeUpdateCacheMut.Lock()
+		accountFreezeUpdateCache[key] = cache
+		accountFreezeUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q accountFreezeQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all for account_freezes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected for account_freezes")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o AccountFreezeSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("boiler: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), accountFreezePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"account_freezes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, accountFreezePrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all in accountFreeze slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected all in update all accountFreeze")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *AccountFreeze) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no account_freezes provided for upsert")
+	}
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(accountFreezeColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	accountFreezeUpsertCacheMut.RLock()
+	cache, cached := accountFreezeUpsertCache[key]
+	accountFreezeUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			accountFreezeAllColumns,
+			accountFreezeColumnsWithDefault,
+			accountFreezeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			accountFreezeAllColumns,
+			accountFreezePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("boiler: unable to upsert account_freezes, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(accountFreezePrimaryKeyColumns))
+			copy(conflict, accountFreezePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"account_freezes\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(accountFreezeType, accountFreezeMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(accountFreezeType, accountFreezeMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to upsert account_freezes")
+	}
+
+	if !cached {
+		accountFreezeUpsertCacheMut.Lock()
+		accountFreezeUpsertCache[key] = cache
+		accountFreezeUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// Delete deletes a single AccountFreeze record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *AccountFreeze) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("boiler: no AccountFreeze provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), accountFreezePrimaryKeyMapping)
+	sql := "DELETE FROM \"account_freezes\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete from account_freezes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by delete for account_freezes")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q accountFreezeQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("boiler: no accountFreezeQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from account_freezes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for account_freezes")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o AccountFreezeSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(accountFreezeBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), accountFreezePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"account_freezes\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, accountFreezePrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from accountFreeze slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for account_freezes")
+	}
+
+	if len(accountFreezeAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *AccountFreeze) Reload(exec boil.Executor) error {
+	ret, err := FindAccountFreeze(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *AccountFreezeSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := AccountFreezeSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), accountFreezePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"account_freezes\".* FROM \"account_freezes\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, accountFreezePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to reload all in AccountFreezeSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// AccountFreezeExists checks if the AccountFreeze row exists.
+func AccountFreezeExists(exec boil.Executor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"account_freezes\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: unable to check if account_freezes exists")
+	}
+
+	return exists, nil
+}
@@ -23,52 +23,92 @@ import (
 
 // Account is an object representing the database table.
 type Account struct {
-	ID            string          `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
-	XsynUserID    string          `boiler:"xsyn_user_id" boil:"xsyn_user_id" json:"xsyn_user_id" toml:"xsyn_user_id" yaml:"xsyn_user_id"`
-	AccountCode   int             `boiler:"account_code" boil:"account_code" json:"account_code" toml:"account_code" yaml:"account_code"`
-	Ledger        int             `boiler:"ledger" boil:"ledger" json:"ledger" toml:"ledger" yaml:"ledger"`
-	DebitsPosted  decimal.Decimal `boiler:"debits_posted" boil:"debits_posted" json:"debits_posted" toml:"debits_posted" yaml:"debits_posted"`
-	CreditsPosted decimal.Decimal `boiler:"credits_posted" boil:"credits_posted" json:"credits_posted" toml:"credits_posted" yaml:"credits_posted"`
-	CreatedAt     time.Time       `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	ID                   string          `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	XsynUserID           string          `boiler:"xsyn_user_id" boil:"xsyn_user_id" json:"xsyn_user_id" toml:"xsyn_user_id" yaml:"xsyn_user_id"`
+	AccountCode          int             `boiler:"account_code" boil:"account_code" json:"account_code" toml:"account_code" yaml:"account_code"`
+	Ledger               int             `boiler:"ledger" boil:"ledger" json:"ledger" toml:"ledger" yaml:"ledger"`
+	DebitsPosted         decimal.Decimal `boiler:"debits_posted" boil:"debits_posted" json:"debits_posted" toml:"debits_posted" yaml:"debits_posted"`
+	CreditsPosted        decimal.Decimal `boiler:"credits_posted" boil:"credits_posted" json:"credits_posted" toml:"credits_posted" yaml:"credits_posted"`
+	CreatedAt            time.Time       `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	DebitsPending        decimal.Decimal `boiler:"debits_pending" boil:"debits_pending" json:"debits_pending" toml:"debits_pending" yaml:"debits_pending"`
+	CreditsPending       decimal.Decimal `boiler:"credits_pending" boil:"credits_pending" json:"credits_pending" toml:"credits_pending" yaml:"credits_pending"`
+	AllowNegativeBalance bool            `boiler:"allow_negative_balance" boil:"allow_negative_balance" json:"allow_negative_balance" toml:"allow_negative_balance" yaml:"allow_negative_balance"`
+	OverdraftLimit       decimal.Decimal `boiler:"overdraft_limit" boil:"overdraft_limit" json:"overdraft_limit" toml:"overdraft_limit" yaml:"overdraft_limit"`
+	DebitsDisabled       bool            `boiler:"debits_disabled" boil:"debits_disabled" json:"debits_disabled" toml:"debits_disabled" yaml:"debits_disabled"`
+	CreditsDisabled      bool            `boiler:"credits_disabled" boil:"credits_disabled" json:"credits_disabled" toml:"credits_disabled" yaml:"credits_disabled"`
+	Frozen               bool            `boiler:"frozen" boil:"frozen" json:"frozen" toml:"frozen" yaml:"frozen"`
+	FrozenCredits        bool            `boiler:"frozen_credits" boil:"frozen_credits" json:"frozen_credits" toml:"frozen_credits" yaml:"frozen_credits"`
 
 	R *accountR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
 	L accountL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var AccountColumns = struct {
-	ID            string
-	XsynUserID    string
-	AccountCode   string
-	Ledger        string
-	DebitsPosted  string
-	CreditsPosted string
-	CreatedAt     string
+	ID                   string
+	XsynUserID           string
+	AccountCode          string
+	Ledger               string
+	DebitsPosted         string
+	CreditsPosted        string
+	CreatedAt            string
+	DebitsPending        string
+	CreditsPending       string
+	AllowNegativeBalance string
+	OverdraftLimit       string
+	DebitsDisabled       string
+	CreditsDisabled      string
+	Frozen               string
+	FrozenCredits        string
 }{
-	ID:            "id",
-	XsynUserID:    "xsyn_user_id",
-	AccountCode:   "account_code",
-	Ledger:        "ledger",
-	DebitsPosted:  "debits_posted",
-	CreditsPosted: "credits_posted",
-	CreatedAt:     "created_at",
+	ID:                   "id",
+	XsynUserID:           "xsyn_user_id",
+	AccountCode:          "account_code",
+	Ledger:               "ledger",
+	DebitsPosted:         "debits_posted",
+	CreditsPosted:        "credits_posted",
+	CreatedAt:            "created_at",
+	DebitsPending:        "debits_pending",
+	CreditsPending:       "credits_pending",
+	AllowNegativeBalance: "allow_negative_balance",
+	OverdraftLimit:       "overdraft_limit",
+	DebitsDisabled:       "debits_disabled",
+	CreditsDisabled:      "credits_disabled",
+	Frozen:               "frozen",
+	FrozenCredits:        "frozen_credits",
 }
 
 var AccountTableColumns = struct {
-	ID            string
-	XsynUserID    string
-	AccountCode   string
-	Ledger        string
-	DebitsPosted  string
-	CreditsPosted string
-	CreatedAt     string
+	ID                   string
+	XsynUserID           string
+	AccountCode          string
+	Ledger               string
+	DebitsPosted         string
+	CreditsPosted        string
+	CreatedAt            string
+	DebitsPending        string
+	CreditsPending       string
+	AllowNegativeBalance string
+	OverdraftLimit       string
+	DebitsDisabled       string
+	CreditsDisabled      string
+	Frozen               string
+	FrozenCredits        string
 }{
-	ID:            "accounts.id",
-	XsynUserID:    "accounts.xsyn_user_id",
-	AccountCode:   "accounts.account_code",
-	Ledger:        "accounts.ledger",
-	DebitsPosted:  "accounts.debits_posted",
-	CreditsPosted: "accounts.credits_posted",
-	CreatedAt:     "accounts.created_at",
+	ID:                   "accounts.id",
+	XsynUserID:           "accounts.xsyn_user_id",
+	AccountCode:          "accounts.account_code",
+	Ledger:               "accounts.ledger",
+	DebitsPosted:         "accounts.debits_posted",
+	CreditsPosted:        "accounts.credits_posted",
+	CreatedAt:            "accounts.created_at",
+	DebitsPending:        "accounts.debits_pending",
+	CreditsPending:       "accounts.credits_pending",
+	AllowNegativeBalance: "accounts.allow_negative_balance",
+	OverdraftLimit:       "accounts.overdraft_limit",
+	DebitsDisabled:       "accounts.debits_disabled",
+	CreditsDisabled:      "accounts.credits_disabled",
+	Frozen:               "accounts.frozen",
+	FrozenCredits:        "accounts.frozen_credits",
 }
 
 // Generated where
@@ -94,64 +134,71 @@ func (w whereHelperdecimal_Decimal) GTE(x decimal.Decimal) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GTE, x)
 }
 
-type whereHelpertime_Time struct{ field string }
-
-func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
-}
-func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
-}
-func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
 var AccountWhere = struct {
-	ID            whereHelperstring
-	XsynUserID    whereHelperstring
-	AccountCode   whereHelperint
-	Ledger        whereHelperint
-	DebitsPosted  whereHelperdecimal_Decimal
-	CreditsPosted whereHelperdecimal_Decimal
-	CreatedAt     whereHelpertime_Time
+	ID                   whereHelperstring
+	XsynUserID           whereHelperstring
+	AccountCode          whereHelperint
+	Ledger               whereHelperint
+	DebitsPosted         whereHelperdecimal_Decimal
+	CreditsPosted        whereHelperdecimal_Decimal
+	CreatedAt            whereHelpertime_Time
+	DebitsPending        whereHelperdecimal_Decimal
+	CreditsPending       whereHelperdecimal_Decimal
+	AllowNegativeBalance whereHelperbool
+	OverdraftLimit       whereHelperdecimal_Decimal
+	DebitsDisabled       whereHelperbool
+	CreditsDisabled      whereHelperbool
+	Frozen               whereHelperbool
+	FrozenCredits        whereHelperbool
 }{
-	ID:            whereHelperstring{field: "\"accounts\".\"id\""},
-	XsynUserID:    whereHelperstring{field: "\"accounts\".\"xsyn_user_id\""},
-	AccountCode:   whereHelperint{field: "\"accounts\".\"account_code\""},
-	Ledger:        whereHelperint{field: "\"accounts\".\"ledger\""},
-	DebitsPosted:  whereHelperdecimal_Decimal{field: "\"accounts\".\"debits_posted\""},
-	CreditsPosted: whereHelperdecimal_Decimal{field: "\"accounts\".\"credits_posted\""},
-	CreatedAt:     whereHelpertime_Time{field: "\"accounts\".\"created_at\""},
+	ID:                   whereHelperstring{field: "\"accounts\".\"id\""},
+	XsynUserID:           whereHelperstring{field: "\"accounts\".\"xsyn_user_id\""},
+	AccountCode:          whereHelperint{field: "\"accounts\".\"account_code\""},
+	Ledger:               whereHelperint{field: "\"accounts\".\"ledger\""},
+	DebitsPosted:         whereHelperdecimal_Decimal{field: "\"accounts\".\"debits_posted\""},
+	CreditsPosted:        whereHelperdecimal_Decimal{field: "\"accounts\".\"credits_posted\""},
+	CreatedAt:            whereHelpertime_Time{field: "\"accounts\".\"created_at\""},
+	DebitsPending:        whereHelperdecimal_Decimal{field: "\"accounts\".\"debits_pending\""},
+	CreditsPending:       whereHelperdecimal_Decimal{field: "\"accounts\".\"credits_pending\""},
+	AllowNegativeBalance: whereHelperbool{field: "\"accounts\".\"allow_negative_balance\""},
+	OverdraftLimit:       whereHelperdecimal_Decimal{field: "\"accounts\".\"overdraft_limit\""},
+	DebitsDisabled:       whereHelperbool{field: "\"accounts\".\"debits_disabled\""},
+	CreditsDisabled:      whereHelperbool{field: "\"accounts\".\"credits_disabled\""},
+	Frozen:               whereHelperbool{field: "\"accounts\".\"frozen\""},
+	FrozenCredits:        whereHelperbool{field: "\"accounts\".\"frozen_credits\""},
 }
 
 // AccountRels is where relationship names are stored.
 var AccountRels = struct {
-	AccountAccountCode        string
-	AccountLedger             string
-	CreditAccountTransactions string
-	DebitAccountTransactions  string
+	AccountAccountCode            string
+	AccountLedger                 string
+	AccountFreezes                string
+	BalanceSnapshots              string
+	CreditAccountPendingTransfers string
+	DebitAccountPendingTransfers  string
+	CreditAccountTransactions     string
+	DebitAccountTransactions      string
 }{
-	AccountAccountCode:        "AccountAccountCode",
-	AccountLedger:             "AccountLedger",
-	CreditAccountTransactions: "CreditAccountTransactions",
-	DebitAccountTransactions:  "DebitAccountTransactions",
+	AccountAccountCode:            "AccountAccountCode",
+	AccountLedger:                 "AccountLedger",
+	AccountFreezes:                "AccountFreezes",
+	BalanceSnapshots:              "BalanceSnapshots",
+	CreditAccountPendingTransfers: "CreditAccountPendingTransfers",
+	DebitAccountPendingTransfers:  "DebitAccountPendingTransfers",
+	CreditAccountTransactions:     "CreditAccountTransactions",
+	DebitAccountTransactions:      "DebitAccountTransactions",
 }
 
 // accountR is where relationships are stored.
 type accountR struct {
-	AccountAccountCode        *AccountCode     `boiler:"AccountAccountCode" boil:"AccountAccountCode" json:"AccountAccountCode" toml:"AccountAccountCode" yaml:"AccountAccountCode"`
-	AccountLedger             *Ledger          `boiler:"AccountLedger" boil:"AccountLedger" json:"AccountLedger" toml:"AccountLedger" yaml:"AccountLedger"`
-	CreditAccountTransactions TransactionSlice `boiler:"CreditAccountTransactions" boil:"CreditAccountTransactions" json:"CreditAccountTransactions" toml:"CreditAccountTransactions" yaml:"CreditAccountTransactions"`
-	DebitAccountTransactions  TransactionSlice `boiler:"DebitAccountTransactions" boil:"DebitAccountTransactions" json:"DebitAccountTransactions" toml:"DebitAccountTransactions" yaml:"DebitAccountTransactions"`
+	AccountAccountCode            *AccountCode         `boiler:"AccountAccountCode" boil:"AccountAccountCode" json:"AccountAccountCode" toml:"AccountAccountCode" yaml:"AccountAccountCode"`
+	AccountLedger                 *Ledger              `boiler:"AccountLedger" boil:"AccountLedger" json:"AccountLedger" toml:"AccountLedger" yaml:"AccountLedger"`
+	AccountFreezes                AccountFreezeSlice   `boiler:"AccountFreezes" boil:"AccountFreezes" json:"AccountFreezes" toml:"AccountFreezes" yaml:"AccountFreezes"`
+	BalanceSnapshots              BalanceSnapshotSlice `boiler:"BalanceSnapshots" boil:"BalanceSnapshots" json:"BalanceSnapshots" toml:"BalanceSnapshots" yaml:"BalanceSnapshots"`
+	CreditAccountPendingTransfers PendingTransferSlice `boiler:"CreditAccountPendingTransfers" boil:"CreditAccountPendingTransfers" json:"CreditAccountPendingTransfers" toml:"CreditAccountPendingTransfers" yaml:"CreditAccountPendingTransfers"`
+	DebitAccountPendingTransfers  PendingTransferSlice `boiler:"DebitAccountPendingTransfers" boil:"DebitAccountPendingTransfers" json:"DebitAccountPendingTransfers" toml:"DebitAccountPendingTransfers" yaml:"DebitAccountPendingTransfers"`
+	CreditAccountTransactions     TransactionSlice     `boiler:"CreditAccountTransactions" boil:"CreditAccountTransactions" json:"CreditAccountTransactions" toml:"CreditAccountTransactions" yaml:"CreditAccountTransactions"`
+	DebitAccountTransactions      TransactionSlice     `boiler:"DebitAccountTransactions" boil:"DebitAccountTransactions" json:"DebitAccountTransactions" toml:"DebitAccountTransactions" yaml:"DebitAccountTransactions"`
 }
 
 // NewStruct creates a new relationship struct
@@ -173,6 +220,34 @@ func (r *accountR) GetAccountLedger() *Ledger {
 	return r.AccountLedger
 }
 
+func (r *accountR) GetAccountFreezes() AccountFreezeSlice {
+	if r == nil {
+		return nil
+	}
+	return r.AccountFreezes
+}
+
+func (r *accountR) GetBalanceSnapshots() BalanceSnapshotSlice {
+	if r == nil {
+		return nil
+	}
+	return r.BalanceSnapshots
+}
+
+func (r *accountR) GetCreditAccountPendingTransfers() PendingTransferSlice {
+	if r == nil {
+		return nil
+	}
+	return r.CreditAccountPendingTransfers
+}
+
+func (r *accountR) GetDebitAccountPendingTransfers() PendingTransferSlice {
+	if r == nil {
+		return nil
+	}
+	return r.DebitAccountPendingTransfers
+}
+
 func (r *accountR) GetCreditAccountTransactions() TransactionSlice {
 	if r == nil {
 		return nil
@@ -191,9 +266,9 @@ func (r *accountR) GetDebitAccountTransactions() TransactionSlice {
 type accountL struct{}
 
 var (
-	accountAllColumns            = []string{"id", "xsyn_user_id", "account_code", "ledger", "debits_posted", "credits_posted", "created_at"}
+	accountAllColumns            = []string{"id", "xsyn_user_id", "account_code", "ledger", "debits_posted", "credits_posted", "created_at", "debits_pending", "credits_pending", "allow_negative_balance", "overdraft_limit", "debits_disabled", "credits_disabled", "frozen", "frozen_credits"}
 	accountColumnsWithoutDefault = []string{"xsyn_user_id"}
-	accountColumnsWithDefault    = []string{"id", "account_code", "ledger", "debits_posted", "credits_posted", "created_at"}
+	accountColumnsWithDefault    = []string{"id", "account_code", "ledger", "debits_posted", "credits_posted", "created_at", "debits_pending", "credits_pending", "allow_negative_balance", "overdraft_limit", "debits_disabled", "credits_disabled", "frozen", "frozen_credits"}
 	accountPrimaryKeyColumns     = []string{"id"}
 	accountGeneratedColumns      = []string{}
 )
@@ -462,6 +537,62 @@ func (o *Account) AccountLedger(mods ...qm.QueryMod) ledgerQuery {
 	return Ledgers(queryMods...)
 }
 
+// AccountFreezes retrieves all the account_freeze's AccountFreezes with an executor.
+func (o *Account) AccountFreezes(mods ...qm.QueryMod) accountFreezeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"account_freezes\".\"account_id\"=?", o.ID),
+	)
+
+	return AccountFreezes(queryMods...)
+}
+
+// BalanceSnapshots retrieves all the balance_snapshot's BalanceSnapshots with an executor.
+func (o *Account) BalanceSnapshots(mods ...qm.QueryMod) balanceSnapshotQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"balance_snapshots\".\"account_id\"=?", o.ID),
+	)
+
+	return BalanceSnapshots(queryMods...)
+}
+
+// CreditAccountPendingTransfers retrieves all the pending_transfer's PendingTransfers with an executor via credit_account_id column.
+func (o *Account) CreditAccountPendingTransfers(mods ...qm.QueryMod) pendingTransferQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"pending_transfers\".\"credit_account_id\"=?", o.ID),
+	)
+
+	return PendingTransfers(queryMods...)
+}
+
+// DebitAccountPendingTransfers retrieves all the pending_transfer's PendingTransfers with an executor via debit_account_id column.
+func (o *Account) DebitAccountPendingTransfers(mods ...qm.QueryMod) pendingTransferQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"pending_transfers\".\"debit_account_id\"=?", o.ID),
+	)
+
+	return PendingTransfers(queryMods...)
+}
+
 // CreditAccountTransactions retrieves all the transaction's Transactions with an executor via credit_account_id column.
 func (o *Account) CreditAccountTransactions(mods ...qm.QueryMod) transactionQuery {
 	var queryMods []qm.QueryMod
@@ -730,9 +861,9 @@ func (accountL) LoadAccountLedger(e boil.Executor, singular bool, maybeAccount i
 	return nil
 }
 
-// LoadCreditAccountTransactions allows an eager lookup of values, cached into the
+// LoadAccountFreezes allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (accountL) LoadCreditAccountTransactions(e boil.Executor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
+func (accountL) LoadAccountFreezes(e boil.Executor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
 	var slice []*Account
 	var object *Account
 
@@ -786,8 +917,8 @@ func (accountL) LoadCreditAccountTransactions(e boil.Executor, singular bool, ma
 	}
 
 	query := NewQuery(
-		qm.From(`transactions`),
-		qm.WhereIn(`transactions.credit_account_id in ?`, args...),
+		qm.From(`account_freezes`),
+		qm.WhereIn(`account_freezes.account_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -795,22 +926,22 @@ func (accountL) LoadCreditAccountTransactions(e boil.Executor, singular bool, ma
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load transactions")
+		return errors.Wrap(err, "failed to eager load account_freezes")
 	}
 
-	var resultSlice []*Transaction
+	var resultSlice []*AccountFreeze
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice transactions")
+		return errors.Wrap(err, "failed to bind eager loaded slice account_freezes")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on transactions")
+		return errors.Wrap(err, "failed to close results in eager load on account_freezes")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transactions")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for account_freezes")
 	}
 
-	if len(transactionAfterSelectHooks) != 0 {
+	if len(accountFreezeAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
@@ -818,24 +949,24 @@ func (accountL) LoadCreditAccountTransactions(e boil.Executor, singular bool, ma
 		}
 	}
 	if singular {
-		object.R.CreditAccountTransactions = resultSlice
+		object.R.AccountFreezes = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &transactionR{}
+				foreign.R = &accountFreezeR{}
 			}
-			foreign.R.CreditAccount = object
+			foreign.R.Account = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.CreditAccountID {
-				local.R.CreditAccountTransactions = append(local.R.CreditAccountTransactions, foreign)
+			if local.ID == foreign.AccountID {
+				local.R.AccountFreezes = append(local.R.AccountFreezes, foreign)
 				if foreign.R == nil {
-					foreign.R = &transactionR{}
+					foreign.R = &accountFreezeR{}
 				}
-				foreign.R.CreditAccount = local
+				foreign.R.Account = local
 				break
 			}
 		}
@@ -844,9 +975,9 @@ func (accountL) LoadCreditAccountTransactions(e boil.Executor, singular bool, ma
 	return nil
 }
 
-// LoadDebitAccountTransactions allows an eager lookup of values, cached into the
+// LoadBalanceSnapshots allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (accountL) LoadDebitAccountTransactions(e boil.Executor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
+func (accountL) LoadBalanceSnapshots(e boil.Executor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
 	var slice []*Account
 	var object *Account
 
@@ -900,8 +1031,8 @@ func (accountL) LoadDebitAccountTransactions(e boil.Executor, singular bool, may
 	}
 
 	query := NewQuery(
-		qm.From(`transactions`),
-		qm.WhereIn(`transactions.debit_account_id in ?`, args...),
+		qm.From(`balance_snapshots`),
+		qm.WhereIn(`balance_snapshots.account_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -909,22 +1040,22 @@ func (accountL) LoadDebitAccountTransactions(e boil.Executor, singular bool, may
 
 	results, err := query.Query(e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load transactions")
+		return errors.Wrap(err, "failed to eager load balance_snapshots")
 	}
 
-	var resultSlice []*Transaction
+	var resultSlice []*BalanceSnapshot
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice transactions")
+		return errors.Wrap(err, "failed to bind eager loaded slice balance_snapshots")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on transactions")
+		return errors.Wrap(err, "failed to close results in eager load on balance_snapshots")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transactions")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for balance_snapshots")
 	}
 
-	if len(transactionAfterSelectHooks) != 0 {
+	if len(balanceSnapshotAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(e); err != nil {
 				return err
@@ -932,24 +1063,24 @@ func (accountL) LoadDebitAccountTransactions(e boil.Executor, singular bool, may
 		}
 	}
 	if singular {
-		object.R.DebitAccountTransactions = resultSlice
+		object.R.BalanceSnapshots = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &transactionR{}
+				foreign.R = &balanceSnapshotR{}
 			}
-			foreign.R.DebitAccount = object
+			foreign.R.Account = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.DebitAccountID {
-				local.R.DebitAccountTransactions = append(local.R.DebitAccountTransactions, foreign)
+			if local.ID == foreign.AccountID {
+				local.R.BalanceSnapshots = append(local.R.BalanceSnapshots, foreign)
 				if foreign.R == nil {
-					foreign.R = &transactionR{}
+					foreign.R = &balanceSnapshotR{}
 				}
-				foreign.R.DebitAccount = local
+				foreign.R.Account = local
 				break
 			}
 		}
@@ -958,98 +1089,762 @@ func (accountL) LoadDebitAccountTransactions(e boil.Executor, singular bool, may
 	return nil
 }
 
-// SetAccountAccountCode of the account to the related item.
-// Sets o.R.AccountAccountCode to related.
-// Adds o to related.R.Accounts.
-func (o *Account) SetAccountAccountCode(exec boil.Executor, insert bool, related *AccountCode) error {
-	var err error
-	if insert {
-		if err = related.Insert(exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	}
-
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"accounts\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"account_code"}),
-		strmangle.WhereClause("\"", "\"", 2, accountPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
-
-	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, updateQuery)
-		fmt.Fprintln(boil.DebugWriter, values)
-	}
-	if _, err = exec.Exec(updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
-	}
+// LoadCreditAccountPendingTransfers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (accountL) LoadCreditAccountPendingTransfers(e boil.Executor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
+	var slice []*Account
+	var object *Account
 
-	o.AccountCode = related.ID
-	if o.R == nil {
-		o.R = &accountR{
-			AccountAccountCode: related,
+	if singular {
+		var ok bool
+		object, ok = maybeAccount.(*Account)
+		if !ok {
+			object = new(Account)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAccount))
+			}
 		}
 	} else {
-		o.R.AccountAccountCode = related
+		s, ok := maybeAccount.(*[]*Account)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAccount))
+			}
+		}
 	}
 
-	if related.R == nil {
-		related.R = &accountCodeR{
-			Accounts: AccountSlice{o},
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &accountR{}
 		}
+		args = append(args, object.ID)
 	} else {
-		related.R.Accounts = append(related.R.Accounts, o)
-	}
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &accountR{}
+			}
 
-	return nil
-}
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
 
-// SetAccountLedger of the account to the related item.
-// Sets o.R.AccountLedger to related.
-// Adds o to related.R.Accounts.
-func (o *Account) SetAccountLedger(exec boil.Executor, insert bool, related *Ledger) error {
-	var err error
-	if insert {
-		if err = related.Insert(exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+			args = append(args, obj.ID)
 		}
 	}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"accounts\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"ledger"}),
-		strmangle.WhereClause("\"", "\"", 2, accountPrimaryKeyColumns),
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`pending_transfers`),
+		qm.WhereIn(`pending_transfers.credit_account_id in ?`, args...),
 	)
-	values := []interface{}{related.ID, o.ID}
+	if mods != nil {
+		mods.Apply(query)
+	}
 
-	if boil.DebugMode {
-		fmt.Fprintln(boil.DebugWriter, updateQuery)
-		fmt.Fprintln(boil.DebugWriter, values)
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load pending_transfers")
 	}
-	if _, err = exec.Exec(updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+
+	var resultSlice []*PendingTransfer
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice pending_transfers")
 	}
 
-	o.Ledger = related.ID
-	if o.R == nil {
-		o.R = &accountR{
-			AccountLedger: related,
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on pending_transfers")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for pending_transfers")
+	}
+
+	if len(pendingTransferAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
 		}
-	} else {
-		o.R.AccountLedger = related
+	}
+	if singular {
+		object.R.CreditAccountPendingTransfers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &pendingTransferR{}
+			}
+			foreign.R.CreditAccount = object
+		}
+		return nil
 	}
 
-	if related.R == nil {
-		related.R = &ledgerR{
-			Accounts: AccountSlice{o},
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CreditAccountID {
+				local.R.CreditAccountPendingTransfers = append(local.R.CreditAccountPendingTransfers, foreign)
+				if foreign.R == nil {
+					foreign.R = &pendingTransferR{}
+				}
+				foreign.R.CreditAccount = local
+				break
+			}
 		}
-	} else {
-		related.R.Accounts = append(related.R.Accounts, o)
 	}
 
 	return nil
 }
 
+// LoadDebitAccountPendingTransfers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (accountL) LoadDebitAccountPendingTransfers(e boil.Executor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
+	var slice []*Account
+	var object *Account
+
+	if singular {
+		var ok bool
+		object, ok = maybeAccount.(*Account)
+		if !ok {
+			object = new(Account)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAccount))
+			}
+		}
+	} else {
+		s, ok := maybeAccount.(*[]*Account)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAccount))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &accountR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &accountR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`pending_transfers`),
+		qm.WhereIn(`pending_transfers.debit_account_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load pending_transfers")
+	}
+
+	var resultSlice []*PendingTransfer
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice pending_transfers")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on pending_transfers")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for pending_transfers")
+	}
+
+	if len(pendingTransferAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.DebitAccountPendingTransfers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &pendingTransferR{}
+			}
+			foreign.R.DebitAccount = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.DebitAccountID {
+				local.R.DebitAccountPendingTransfers = append(local.R.DebitAccountPendingTransfers, foreign)
+				if foreign.R == nil {
+					foreign.R = &pendingTransferR{}
+				}
+				foreign.R.DebitAccount = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreditAccountTransactions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (accountL) LoadCreditAccountTransactions(e boil.Executor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
+	var slice []*Account
+	var object *Account
+
+	if singular {
+		var ok bool
+		object, ok = maybeAccount.(*Account)
+		if !ok {
+			object = new(Account)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAccount))
+			}
+		}
+	} else {
+		s, ok := maybeAccount.(*[]*Account)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAccount))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &accountR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &accountR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`transactions`),
+		qm.WhereIn(`transactions.credit_account_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load transactions")
+	}
+
+	var resultSlice []*Transaction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice transactions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on transactions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transactions")
+	}
+
+	if len(transactionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CreditAccountTransactions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &transactionR{}
+			}
+			foreign.R.CreditAccount = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CreditAccountID {
+				local.R.CreditAccountTransactions = append(local.R.CreditAccountTransactions, foreign)
+				if foreign.R == nil {
+					foreign.R = &transactionR{}
+				}
+				foreign.R.CreditAccount = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadDebitAccountTransactions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (accountL) LoadDebitAccountTransactions(e boil.Executor, singular bool, maybeAccount interface{}, mods queries.Applicator) error {
+	var slice []*Account
+	var object *Account
+
+	if singular {
+		var ok bool
+		object, ok = maybeAccount.(*Account)
+		if !ok {
+			object = new(Account)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeAccount))
+			}
+		}
+	} else {
+		s, ok := maybeAccount.(*[]*Account)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeAccount))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &accountR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &accountR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`transactions`),
+		qm.WhereIn(`transactions.debit_account_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load transactions")
+	}
+
+	var resultSlice []*Transaction
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice transactions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on transactions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for transactions")
+	}
+
+	if len(transactionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.DebitAccountTransactions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &transactionR{}
+			}
+			foreign.R.DebitAccount = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.DebitAccountID {
+				local.R.DebitAccountTransactions = append(local.R.DebitAccountTransactions, foreign)
+				if foreign.R == nil {
+					foreign.R = &transactionR{}
+				}
+				foreign.R.DebitAccount = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetAccountAccountCode of the account to the related item.
+// Sets o.R.AccountAccountCode to related.
+// Adds o to related.R.Accounts.
+func (o *Account) SetAccountAccountCode(exec boil.Executor, insert bool, related *AccountCode) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"accounts\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"account_code"}),
+		strmangle.WhereClause("\"", "\"", 2, accountPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.AccountCode = related.ID
+	if o.R == nil {
+		o.R = &accountR{
+			AccountAccountCode: related,
+		}
+	} else {
+		o.R.AccountAccountCode = related
+	}
+
+	if related.R == nil {
+		related.R = &accountCodeR{
+			Accounts: AccountSlice{o},
+		}
+	} else {
+		related.R.Accounts = append(related.R.Accounts, o)
+	}
+
+	return nil
+}
+
+// SetAccountLedger of the account to the related item.
+// Sets o.R.AccountLedger to related.
+// Adds o to related.R.Accounts.
+func (o *Account) SetAccountLedger(exec boil.Executor, insert bool, related *Ledger) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"accounts\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"ledger"}),
+		strmangle.WhereClause("\"", "\"", 2, accountPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.Ledger = related.ID
+	if o.R == nil {
+		o.R = &accountR{
+			AccountLedger: related,
+		}
+	} else {
+		o.R.AccountLedger = related
+	}
+
+	if related.R == nil {
+		related.R = &ledgerR{
+			Accounts: AccountSlice{o},
+		}
+	} else {
+		related.R.Accounts = append(related.R.Accounts, o)
+	}
+
+	return nil
+}
+
+// AddAccountFreezes adds the given related objects to the existing relationships
+// of the account, optionally inserting them as new records.
+// Appends related to o.R.AccountFreezes.
+// Sets related.R.Account appropriately.
+func (o *Account) AddAccountFreezes(exec boil.Executor, insert bool, related ...*AccountFreeze) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.AccountID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"account_freezes\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, accountFreezePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.AccountID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &accountR{
+			AccountFreezes: related,
+		}
+	} else {
+		o.R.AccountFreezes = append(o.R.AccountFreezes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &accountFreezeR{
+				Account: o,
+			}
+		} else {
+			rel.R.Account = o
+		}
+	}
+	return nil
+}
+
+// AddBalanceSnapshots adds the given related objects to the existing relationships
+// of the account, optionally inserting them as new records.
+// Appends related to o.R.BalanceSnapshots.
+// Sets related.R.Account appropriately.
+func (o *Account) AddBalanceSnapshots(exec boil.Executor, insert bool, related ...*BalanceSnapshot) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.AccountID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"balance_snapshots\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, balanceSnapshotPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.AccountID, rel.TakenAt}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.AccountID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &accountR{
+			BalanceSnapshots: related,
+		}
+	} else {
+		o.R.BalanceSnapshots = append(o.R.BalanceSnapshots, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &balanceSnapshotR{
+				Account: o,
+			}
+		} else {
+			rel.R.Account = o
+		}
+	}
+	return nil
+}
+
+// AddCreditAccountPendingTransfers adds the given related objects to the existing relationships
+// of the account, optionally inserting them as new records.
+// Appends related to o.R.CreditAccountPendingTransfers.
+// Sets related.R.CreditAccount appropriately.
+func (o *Account) AddCreditAccountPendingTransfers(exec boil.Executor, insert bool, related ...*PendingTransfer) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.CreditAccountID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"pending_transfers\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"credit_account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, pendingTransferPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.CreditAccountID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &accountR{
+			CreditAccountPendingTransfers: related,
+		}
+	} else {
+		o.R.CreditAccountPendingTransfers = append(o.R.CreditAccountPendingTransfers, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &pendingTransferR{
+				CreditAccount: o,
+			}
+		} else {
+			rel.R.CreditAccount = o
+		}
+	}
+	return nil
+}
+
+// AddDebitAccountPendingTransfers adds the given related objects to the existing relationships
+// of the account, optionally inserting them as new records.
+// Appends related to o.R.DebitAccountPendingTransfers.
+// Sets related.R.DebitAccount appropriately.
+func (o *Account) AddDebitAccountPendingTransfers(exec boil.Executor, insert bool, related ...*PendingTransfer) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.DebitAccountID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"pending_transfers\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"debit_account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, pendingTransferPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.DebitAccountID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &accountR{
+			DebitAccountPendingTransfers: related,
+		}
+	} else {
+		o.R.DebitAccountPendingTransfers = append(o.R.DebitAccountPendingTransfers, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &pendingTransferR{
+				DebitAccount: o,
+			}
+		} else {
+			rel.R.DebitAccount = o
+		}
+	}
+	return nil
+}
+
 // AddCreditAccountTransactions adds the given related objects to the existing relationships
 // of the account, optionally inserting them as new records.
 // Appends related to o.R.CreditAccountTransactions.
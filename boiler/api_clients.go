@@ -0,0 +1,1032 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package boiler
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// APIClient is an object representing the database table.
+type APIClient struct {
+	ID                   string            `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name                 string            `boiler:"name" boil:"name" json:"name" toml:"name" yaml:"name"`
+	KeyHash              string            `boiler:"key_hash" boil:"key_hash" json:"key_hash" toml:"key_hash" yaml:"key_hash"`
+	PreviousKeyHash      null.String       `boiler:"previous_key_hash" boil:"previous_key_hash" json:"previous_key_hash,omitempty" toml:"previous_key_hash" yaml:"previous_key_hash,omitempty"`
+	PreviousKeyExpiresAt null.Time         `boiler:"previous_key_expires_at" boil:"previous_key_expires_at" json:"previous_key_expires_at,omitempty" toml:"previous_key_expires_at" yaml:"previous_key_expires_at,omitempty"`
+	Scopes               types.StringArray `boiler:"scopes" boil:"scopes" json:"scopes" toml:"scopes" yaml:"scopes"`
+	TransferCodes        types.Int64Array  `boiler:"transfer_codes" boil:"transfer_codes" json:"transfer_codes" toml:"transfer_codes" yaml:"transfer_codes"`
+	Ledgers              types.Int64Array  `boiler:"ledgers" boil:"ledgers" json:"ledgers" toml:"ledgers" yaml:"ledgers"`
+	DebitAccountCodes    types.Int64Array  `boiler:"debit_account_codes" boil:"debit_account_codes" json:"debit_account_codes" toml:"debit_account_codes" yaml:"debit_account_codes"`
+	CreatedAt            time.Time         `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	RotatedAt            null.Time         `boiler:"rotated_at" boil:"rotated_at" json:"rotated_at,omitempty" toml:"rotated_at" yaml:"rotated_at,omitempty"`
+	RevokedAt            null.Time         `boiler:"revoked_at" boil:"revoked_at" json:"revoked_at,omitempty" toml:"revoked_at" yaml:"revoked_at,omitempty"`
+
+	R *apiClientR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L apiClientL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var APIClientColumns = struct {
+	ID                   string
+	Name                 string
+	KeyHash              string
+	PreviousKeyHash      string
+	PreviousKeyExpiresAt string
+	Scopes               string
+	TransferCodes        string
+	Ledgers              string
+	DebitAccountCodes    string
+	CreatedAt            string
+	RotatedAt            string
+	RevokedAt            string
+}{
+	ID:                   "id",
+	Name:                 "name",
+	KeyHash:              "key_hash",
+	PreviousKeyHash:      "previous_key_hash",
+	PreviousKeyExpiresAt: "previous_key_expires_at",
+	Scopes:               "scopes",
+	TransferCodes:        "transfer_codes",
+	Ledgers:              "ledgers",
+	DebitAccountCodes:    "debit_account_codes",
+	CreatedAt:            "created_at",
+	RotatedAt:            "rotated_at",
+	RevokedAt:            "revoked_at",
+}
+
+var APIClientTableColumns = struct {
+	ID                   string
+	Name                 string
+	KeyHash              string
+	PreviousKeyHash      string
+	PreviousKeyExpiresAt string
+	Scopes               string
+	TransferCodes        string
+	Ledgers              string
+	DebitAccountCodes    string
+	CreatedAt            string
+	RotatedAt            string
+	RevokedAt            string
+}{
+	ID:                   "api_clients.id",
+	Name:                 "api_clients.name",
+	KeyHash:              "api_clients.key_hash",
+	PreviousKeyHash:      "api_clients.previous_key_hash",
+	PreviousKeyExpiresAt: "api_clients.previous_key_expires_at",
+	Scopes:               "api_clients.scopes",
+	TransferCodes:        "api_clients.transfer_codes",
+	Ledgers:              "api_clients.ledgers",
+	DebitAccountCodes:    "api_clients.debit_account_codes",
+	CreatedAt:            "api_clients.created_at",
+	RotatedAt:            "api_clients.rotated_at",
+	RevokedAt:            "api_clients.revoked_at",
+}
+
+// Generated where
+
+type whereHelpernull_String struct{ field string }
+
+func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_String) IN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+type whereHelpernull_Time struct{ field string }
+
+func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+type whereHelpertypes_StringArray struct{ field string }
+
+func (w whereHelpertypes_StringArray) EQ(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertypes_StringArray) NEQ(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertypes_StringArray) LT(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_StringArray) LTE(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_StringArray) GT(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_StringArray) GTE(x types.StringArray) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+type whereHelpertypes_Int64Array struct{ field string }
+
+func (w whereHelpertypes_Int64Array) EQ(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertypes_Int64Array) NEQ(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertypes_Int64Array) LT(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_Int64Array) LTE(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_Int64Array) GT(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_Int64Array) GTE(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var APIClientWhere = struct {
+	ID                   whereHelperstring
+	Name                 whereHelperstring
+	KeyHash              whereHelperstring
+	PreviousKeyHash      whereHelpernull_String
+	PreviousKeyExpiresAt whereHelpernull_Time
+	Scopes               whereHelpertypes_StringArray
+	TransferCodes        whereHelpertypes_Int64Array
+	Ledgers              whereHelpertypes_Int64Array
+	DebitAccountCodes    whereHelpertypes_Int64Array
+	CreatedAt            whereHelpertime_Time
+	RotatedAt            whereHelpernull_Time
+	RevokedAt            whereHelpernull_Time
+}{
+	ID:                   whereHelperstring{field: "\"api_clients\".\"id\""},
+	Name:                 whereHelperstring{field: "\"api_clients\".\"name\""},
+	KeyHash:              whereHelperstring{field: "\"api_clients\".\"key_hash\""},
+	PreviousKeyHash:      whereHelpernull_String{field: "\"api_clients\".\"previous_key_hash\""},
+	PreviousKeyExpiresAt: whereHelpernull_Time{field: "\"api_clients\".\"previous_key_expires_at\""},
+	Scopes:               whereHelpertypes_StringArray{field: "\"api_clients\".\"scopes\""},
+	TransferCodes:        whereHelpertypes_Int64Array{field: "\"api_clients\".\"transfer_codes\""},
+	Ledgers:              whereHelpertypes_Int64Array{field: "\"api_clients\".\"ledgers\""},
+	DebitAccountCodes:    whereHelpertypes_Int64Array{field: "\"api_clients\".\"debit_account_codes\""},
+	CreatedAt:            whereHelpertime_Time{field: "\"api_clients\".\"created_at\""},
+	RotatedAt:            whereHelpernull_Time{field: "\"api_clients\".\"rotated_at\""},
+	RevokedAt:            whereHelpernull_Time{field: "\"api_clients\".\"revoked_at\""},
+}
+
+// APIClientRels is where relationship names are stored.
+var APIClientRels = struct {
+}{}
+
+// apiClientR is where relationships are stored.
+type apiClientR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*apiClientR) NewStruct() *apiClientR {
+	return &apiClientR{}
+}
+
+// apiClientL is where Load methods for each relationship are stored.
+type apiClientL struct{}
+
+var (
+	apiClientAllColumns            = []string{"id", "name", "key_hash", "previous_key_hash", "previous_key_expires_at", "scopes", "transfer_codes", "ledgers", "debit_account_codes", "created_at", "rotated_at", "revoked_at"}
+	apiClientColumnsWithoutDefault = []string{"name", "key_hash"}
+	apiClientColumnsWithDefault    = []string{"id", "previous_key_hash", "previous_key_expires_at", "scopes", "transfer_codes", "ledgers", "debit_account_codes", "created_at", "rotated_at", "revoked_at"}
+	apiClientPrimaryKeyColumns     = []string{"id"}
+	apiClientGeneratedColumns      = []string{}
+)
+
+type (
+	// APIClientSlice is an alias for a slice of pointers to APIClient.
+	// This should almost always be used instead of []APIClient.
+	APIClientSlice []*APIClient
+	// APIClientHook is the signature for custom APIClient hook methods
+	APIClientHook func(boil.Executor, *APIClient) error
+
+	apiClientQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	apiClientType                 = reflect.TypeOf(&APIClient{})
+	apiClientMapping              = queries.MakeStructMapping(apiClientType)
+	apiClientPrimaryKeyMapping, _ = queries.BindMapping(apiClientType, apiClientMapping, apiClientPrimaryKeyColumns)
+	apiClientInsertCacheMut       sync.RWMutex
+	apiClientInsertCache          = make(map[string]insertCache)
+	apiClientUpdateCacheMut       sync.RWMutex
+	apiClientUpdateCache          = make(map[string]updateCache)
+	apiClientUpsertCacheMut       sync.RWMutex
+	apiClientUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var apiClientAfterSelectHooks []APIClientHook
+
+var apiClientBeforeInsertHooks []APIClientHook
+var apiClientAfterInsertHooks []APIClientHook
+
+var apiClientBeforeUpdateHooks []APIClientHook
+var apiClientAfterUpdateHooks []APIClientHook
+
+var apiClientBeforeDeleteHooks []APIClientHook
+var apiClientAfterDeleteHooks []APIClientHook
+
+var apiClientBeforeUpsertHooks []APIClientHook
+var apiClientAfterUpsertHooks []APIClientHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *APIClient) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range apiClientAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *APIClient) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range apiClientBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *APIClient) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range apiClientAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *APIClient) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range apiClientBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *APIClient) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range apiClientAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *APIClient) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range apiClientBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *APIClient) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range apiClientAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *APIClient) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range apiClientBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *APIClient) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range apiClientAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddAPIClientHook registers your hook function for all future operations.
+func AddAPIClientHook(hookPoint boil.HookPoint, apiClientHook APIClientHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		apiClientAfterSelectHooks = append(apiClientAfterSelectHooks, apiClientHook)
+	case boil.BeforeInsertHook:
+		apiClientBeforeInsertHooks = append(apiClientBeforeInsertHooks, apiClientHook)
+	case boil.AfterInsertHook:
+		apiClientAfterInsertHooks = append(apiClientAfterInsertHooks, apiClientHook)
+	case boil.BeforeUpdateHook:
+		apiClientBeforeUpdateHooks = append(apiClientBeforeUpdateHooks, apiClientHook)
+	case boil.AfterUpdateHook:
+		apiClientAfterUpdateHooks = append(apiClientAfterUpdateHooks, apiClientHook)
+	case boil.BeforeDeleteHook:
+		apiClientBeforeDeleteHooks = append(apiClientBeforeDeleteHooks, apiClientHook)
+	case boil.AfterDeleteHook:
+		apiClientAfterDeleteHooks = append(apiClientAfterDeleteHooks, apiClientHook)
+	case boil.BeforeUpsertHook:
+		apiClientBeforeUpsertHooks = append(apiClientBeforeUpsertHooks, apiClientHook)
+	case boil.AfterUpsertHook:
+		apiClientAfterUpsertHooks = append(apiClientAfterUpsertHooks, apiClientHook)
+	}
+}
+
+// One returns a single apiClient record from the query.
+func (q apiClientQuery) One(exec boil.Executor) (*APIClient, error) {
+	o := &APIClient{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: failed to execute a one query for api_clients")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all APIClient records from the query.
+func (q apiClientQuery) All(exec boil.Executor) (APIClientSlice, error) {
+	var o []*APIClient
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "boiler: failed to assign all query results to APIClient slice")
+	}
+
+	if len(apiClientAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all APIClient records in the query.
+func (q apiClientQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to count api_clients rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q apiClientQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: failed to check if api_clients exists")
+	}
+
+	return count > 0, nil
+}
+
+// APIClients retrieves all the records using an executor.
+func APIClients(mods ...qm.QueryMod) apiClientQuery {
+	mods = append(mods, qm.From("\"api_clients\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"api_clients\".*"})
+	}
+
+	return apiClientQuery{q}
+}
+
+// FindAPIClient retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindAPIClient(exec boil.Executor, iD string, selectCols ...string) (*APIClient, error) {
+	apiClientObj := &APIClient{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"api_clients\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, apiClientObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: unable to select from api_clients")
+	}
+
+	if err = apiClientObj.doAfterSelectHooks(exec); err != nil {
+		return apiClientObj, err
+	}
+
+	return apiClientObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *APIClient) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no api_clients provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(apiClientColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	apiClientInsertCacheMut.RLock()
+	cache, cached := apiClientInsertCache[key]
+	apiClientInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			apiClientAllColumns,
+			apiClientColumnsWithDefault,
+			apiClientColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(apiClientType, apiClientMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(apiClientType, apiClientMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"api_clients\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"api_clients\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to insert into api_clients")
+	}
+
+	if !cached {
+		apiClientInsertCacheMut.Lock()
+		apiClientInsertCache[key] = cache
+		apiClientInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// Update uses an executor to update the APIClient.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *APIClient) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	apiClientUpdateCacheMut.RLock()
+	cache, cached := apiClientUpdateCache[key]
+	apiClientUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			apiClientAllColumns,
+			apiClientPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("boiler: unable to update api_clients, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"api_clients\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, apiClientPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(apiClientType, apiClientMapping, append(wl, apiClientPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update api_clients row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by update for api_clients")
+	}
+
+	if !cached {
+		apiClientUpdateCacheMut.Lock()
+		apiClientUpdateCache[key] = cache
+		apiClientUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q apiClientQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all for api_clients")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected for api_clients")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o APIClientSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("boiler: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), apiClientPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"api_clients\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, apiClientPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all in apiClient slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected all in update all apiClient")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *APIClient) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no api_clients provided for upsert")
+	}
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(apiClientColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	apiClientUpsertCacheMut.RLock()
+	cache, cached := apiClientUpsertCache[key]
+	apiClientUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			apiClientAllColumns,
+			apiClientColumnsWithDefault,
+			apiClientColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			apiClientAllColumns,
+			apiClientPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("boiler: unable to upsert api_clients, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(apiClientPrimaryKeyColumns))
+			copy(conflict, apiClientPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"api_clients\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(apiClientType, apiClientMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(apiClientType, apiClientMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to upsert api_clients")
+	}
+
+	if !cached {
+		apiClientUpsertCacheMut.Lock()
+		apiClientUpsertCache[key] = cache
+		apiClientUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// Delete deletes a single APIClient record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *APIClient) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("boiler: no APIClient provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), apiClientPrimaryKeyMapping)
+	sql := "DELETE FROM \"api_clients\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete from api_clients")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by delete for api_clients")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q apiClientQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("boiler: no apiClientQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from api_clients")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for api_clients")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o APIClientSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(apiClientBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), apiClientPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"api_clients\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, apiClientPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from apiClient slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for api_clients")
+	}
+
+	if len(apiClientAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *APIClient) Reload(exec boil.Executor) error {
+	ret, err := FindAPIClient(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *APIClientSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := APIClientSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), apiClientPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"api_clients\".* FROM \"api_clients\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, apiClientPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to reload all in APIClientSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// APIClientExists checks if the APIClient row exists.
+func APIClientExists(exec boil.Executor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"api_clients\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: unable to check if api_clients exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,1049 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package boiler
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// BalanceSnapshot is an object representing the database table.
+type BalanceSnapshot struct {
+	AccountID     string          `boiler:"account_id" boil:"account_id" json:"account_id" toml:"account_id" yaml:"account_id"`
+	TakenAt       time.Time       `boiler:"taken_at" boil:"taken_at" json:"taken_at" toml:"taken_at" yaml:"taken_at"`
+	DebitsPosted  decimal.Decimal `boiler:"debits_posted" boil:"debits_posted" json:"debits_posted" toml:"debits_posted" yaml:"debits_posted"`
+	CreditsPosted decimal.Decimal `boiler:"credits_posted" boil:"credits_posted" json:"credits_posted" toml:"credits_posted" yaml:"credits_posted"`
+
+	R *balanceSnapshotR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L balanceSnapshotL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var BalanceSnapshotColumns = struct {
+	AccountID     string
+	TakenAt       string
+	DebitsPosted  string
+	CreditsPosted string
+}{
+	AccountID:     "account_id",
+	TakenAt:       "taken_at",
+	DebitsPosted:  "debits_posted",
+	CreditsPosted: "credits_posted",
+}
+
+var BalanceSnapshotTableColumns = struct {
+	AccountID     string
+	TakenAt       string
+	DebitsPosted  string
+	CreditsPosted string
+}{
+	AccountID:     "balance_snapshots.account_id",
+	TakenAt:       "balance_snapshots.taken_at",
+	DebitsPosted:  "balance_snapshots.debits_posted",
+	CreditsPosted: "balance_snapshots.credits_posted",
+}
+
+// Generated where
+
+var BalanceSnapshotWhere = struct {
+	AccountID     whereHelperstring
+	TakenAt       whereHelpertime_Time
+	DebitsPosted  whereHelperdecimal_Decimal
+	CreditsPosted whereHelperdecimal_Decimal
+}{
+	AccountID:     whereHelperstring{field: "\"balance_snapshots\".\"account_id\""},
+	TakenAt:       whereHelpertime_Time{field: "\"balance_snapshots\".\"taken_at\""},
+	DebitsPosted:  whereHelperdecimal_Decimal{field: "\"balance_snapshots\".\"debits_posted\""},
+	CreditsPosted: whereHelperdecimal_Decimal{field: "\"balance_snapshots\".\"credits_posted\""},
+}
+
+// BalanceSnapshotRels is where relationship names are stored.
+var BalanceSnapshotRels = struct {
+	Account string
+}{
+	Account: "Account",
+}
+
+// balanceSnapshotR is where relationships are stored.
+type balanceSnapshotR struct {
+	Account *Account `boiler:"Account" boil:"Account" json:"Account" toml:"Account" yaml:"Account"`
+}
+
+// NewStruct creates a new relationship struct
+func (*balanceSnapshotR) NewStruct() *balanceSnapshotR {
+	return &balanceSnapshotR{}
+}
+
+func (r *balanceSnapshotR) GetAccount() *Account {
+	if r == nil {
+		return nil
+	}
+	return r.Account
+}
+
+// balanceSnapshotL is where Load methods for each relationship are stored.
+type balanceSnapshotL struct{}
+
+var (
+	balanceSnapshotAllColumns            = []string{"account_id", "taken_at", "debits_posted", "credits_posted"}
+	balanceSnapshotColumnsWithoutDefault = []string{"account_id", "taken_at", "debits_posted", "credits_posted"}
+	balanceSnapshotColumnsWithDefault    = []string{}
+	balanceSnapshotPrimaryKeyColumns     = []string{"account_id", "taken_at"}
+	balanceSnapshotGeneratedColumns      = []string{}
+)
+
+type (
+	// BalanceSnapshotSlice is an alias for a slice of pointers to BalanceSnapshot.
+	// This should almost always be used instead of []BalanceSnapshot.
+	BalanceSnapshotSlice []*BalanceSnapshot
+	// BalanceSnapshotHook is the signature for custom BalanceSnapshot hook methods
+	BalanceSnapshotHook func(boil.Executor, *BalanceSnapshot) error
+
+	balanceSnapshotQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	balanceSnapshotType                 = reflect.TypeOf(&BalanceSnapshot{})
+	balanceSnapshotMapping              = queries.MakeStructMapping(balanceSnapshotType)
+	balanceSnapshotPrimaryKeyMapping, _ = queries.BindMapping(balanceSnapshotType, balanceSnapshotMapping, balanceSnapshotPrimaryKeyColumns)
+	balanceSnapshotInsertCacheMut       sync.RWMutex
+	balanceSnapshotInsertCache          = make(map[string]insertCache)
+	balanceSnapshotUpdateCacheMut       sync.RWMutex
+	balanceSnapshotUpdateCache          = make(map[string]updateCache)
+	balanceSnapshotUpsertCacheMut       sync.RWMutex
+	balanceSnapshotUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var balanceSnapshotAfterSelectHooks []BalanceSnapshotHook
+
+var balanceSnapshotBeforeInsertHooks []BalanceSnapshotHook
+var balanceSnapshotAfterInsertHooks []BalanceSnapshotHook
+
+var balanceSnapshotBeforeUpdateHooks []BalanceSnapshotHook
+var balanceSnapshotAfterUpdateHooks []BalanceSnapshotHook
+
+var balanceSnapshotBeforeDeleteHooks []BalanceSnapshotHook
+var balanceSnapshotAfterDeleteHooks []BalanceSnapshotHook
+
+var balanceSnapshotBeforeUpsertHooks []BalanceSnapshotHook
+var balanceSnapshotAfterUpsertHooks []BalanceSnapshotHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *BalanceSnapshot) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range balanceSnapshotAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *BalanceSnapshot) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range balanceSnapshotBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *BalanceSnapshot) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range balanceSnapshotAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *BalanceSnapshot) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range balanceSnapshotBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *BalanceSnapshot) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range balanceSnapshotAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *BalanceSnapshot) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range balanceSnapshotBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *BalanceSnapshot) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range balanceSnapshotAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *BalanceSnapshot) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range balanceSnapshotBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *BalanceSnapshot) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range balanceSnapshotAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddBalanceSnapshotHook registers your hook function for all future operations.
+func AddBalanceSnapshotHook(hookPoint boil.HookPoint, balanceSnapshotHook BalanceSnapshotHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		balanceSnapshotAfterSelectHooks = append(balanceSnapshotAfterSelectHooks, balanceSnapshotHook)
+	case boil.BeforeInsertHook:
+		balanceSnapshotBeforeInsertHooks = append(balanceSnapshotBeforeInsertHooks, balanceSnapshotHook)
+	case boil.AfterInsertHook:
+		balanceSnapshotAfterInsertHooks = append(balanceSnapshotAfterInsertHooks, balanceSnapshotHook)
+	case boil.BeforeUpdateHook:
+		balanceSnapshotBeforeUpdateHooks = append(balanceSnapshotBeforeUpdateHooks, balanceSnapshotHook)
+	case boil.AfterUpdateHook:
+		balanceSnapshotAfterUpdateHooks = append(balanceSnapshotAfterUpdateHooks, balanceSnapshotHook)
+	case boil.BeforeDeleteHook:
+		balanceSnapshotBeforeDeleteHooks = append(balanceSnapshotBeforeDeleteHooks, balanceSnapshotHook)
+	case boil.AfterDeleteHook:
+		balanceSnapshotAfterDeleteHooks = append(balanceSnapshotAfterDeleteHooks, balanceSnapshotHook)
+	case boil.BeforeUpsertHook:
+		balanceSnapshotBeforeUpsertHooks = append(balanceSnapshotBeforeUpsertHooks, balanceSnapshotHook)
+	case boil.AfterUpsertHook:
+		balanceSnapshotAfterUpsertHooks = append(balanceSnapshotAfterUpsertHooks, balanceSnapshotHook)
+	}
+}
+
+// One returns a single balanceSnapshot record from the query.
+func (q balanceSnapshotQuery) One(exec boil.Executor) (*BalanceSnapshot, error) {
+	o := &BalanceSnapshot{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: failed to execute a one query for balance_snapshots")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all BalanceSnapshot records from the query.
+func (q balanceSnapshotQuery) All(exec boil.Executor) (BalanceSnapshotSlice, error) {
+	var o []*BalanceSnapshot
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "boiler: failed to assign all query results to BalanceSnapshot slice")
+	}
+
+	if len(balanceSnapshotAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all BalanceSnapshot records in the query.
+func (q balanceSnapshotQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to count balance_snapshots rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q balanceSnapshotQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: failed to check if balance_snapshots exists")
+	}
+
+	return count > 0, nil
+}
+
+// Account pointed to by the foreign key.
+func (o *BalanceSnapshot) Account(mods ...qm.QueryMod) accountQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.AccountID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Accounts(queryMods...)
+}
+
+// LoadAccount allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (balanceSnapshotL) LoadAccount(e boil.Executor, singular bool, maybeBalanceSnapshot interface{}, mods queries.Applicator) error {
+	var slice []*BalanceSnapshot
+	var object *BalanceSnapshot
+
+	if singular {
+		var ok bool
+		object, ok = maybeBalanceSnapshot.(*BalanceSnapshot)
+		if !ok {
+			object = new(BalanceSnapshot)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBalanceSnapshot)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBalanceSnapshot))
+			}
+		}
+	} else {
+		s, ok := maybeBalanceSnapshot.(*[]*BalanceSnapshot)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBalanceSnapshot)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBalanceSnapshot))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &balanceSnapshotR{}
+		}
+		args = append(args, object.AccountID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &balanceSnapshotR{}
+			}
+
+			for _, a := range args {
+				if a == obj.AccountID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.AccountID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`accounts`),
+		qm.WhereIn(`accounts.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Account")
+	}
+
+	var resultSlice []*Account
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Account")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for accounts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for accounts")
+	}
+
+	if len(balanceSnapshotAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Account = foreign
+		if foreign.R == nil {
+			foreign.R = &accountR{}
+		}
+		foreign.R.BalanceSnapshots = append(foreign.R.BalanceSnapshots, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.AccountID == foreign.ID {
+				local.R.Account = foreign
+				if foreign.R == nil {
+					foreign.R = &accountR{}
+				}
+				foreign.R.BalanceSnapshots = append(foreign.R.BalanceSnapshots, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetAccount of the balanceSnapshot to the related item.
+// Sets o.R.Account to related.
+// Adds o to related.R.BalanceSnapshots.
+func (o *BalanceSnapshot) SetAccount(exec boil.Executor, insert bool, related *Account) error {
+	var err error
+	if insert {
+		if err = related.Insert(exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"balance_snapshots\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"account_id"}),
+		strmangle.WhereClause("\"", "\"", 2, balanceSnapshotPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.AccountID, o.TakenAt}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	if _, err = exec.Exec(updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.AccountID = related.ID
+	if o.R == nil {
+		o.R = &balanceSnapshotR{
+			Account: related,
+		}
+	} else {
+		o.R.Account = related
+	}
+
+	if related.R == nil {
+		related.R = &accountR{
+			BalanceSnapshots: BalanceSnapshotSlice{o},
+		}
+	} else {
+		related.R.BalanceSnapshots = append(related.R.BalanceSnapshots, o)
+	}
+
+	return nil
+}
+
+// BalanceSnapshots retrieves all the records using an executor.
+func BalanceSnapshots(mods ...qm.QueryMod) balanceSnapshotQuery {
+	mods = append(mods, qm.From("\"balance_snapshots\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"balance_snapshots\".*"})
+	}
+
+	return balanceSnapshotQuery{q}
+}
+
+// FindBalanceSnapshot retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindBalanceSnapshot(exec boil.Executor, accountID string, takenAt time.Time, selectCols ...string) (*BalanceSnapshot, error) {
+	balanceSnapshotObj := &BalanceSnapshot{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"balance_snapshots\" where \"account_id\"=$1 AND \"taken_at\"=$2", sel,
+	)
+
+	q := queries.Raw(query, accountID, takenAt)
+
+	err := q.Bind(nil, exec, balanceSnapshotObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: unable to select from balance_snapshots")
+	}
+
+	if err = balanceSnapshotObj.doAfterSelectHooks(exec); err != nil {
+		return balanceSnapshotObj, err
+	}
+
+	return balanceSnapshotObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *BalanceSnapshot) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no balance_snapshots provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(balanceSnapshotColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	balanceSnapshotInsertCacheMut.RLock()
+	cache, cached := balanceSnapshotInsertCache[key]
+	balanceSnapshotInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			balanceSnapshotAllColumns,
+			balanceSnapshotColumnsWithDefault,
+			balanceSnapshotColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(balanceSnapshotType, balanceSnapshotMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(balanceSnapshotType, balanceSnapshotMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"balance_snapshots\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"balance_snapshots\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to insert into balance_snapshots")
+	}
+
+	if !cached {
+		balanceSnapshotInsertCacheMut.Lock()
+		balanceSnapshotInsertCache[key] = cache
+		balanceSnapshotInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// Update uses an executor to update the BalanceSnapshot.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *BalanceSnapshot) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	balanceSnapshotUpdateCacheMut.RLock()
+	cache, cached := balanceSnapshotUpdateCache[key]
+	balanceSnapshotUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			balanceSnapshotAllColumns,
+			balanceSnapshotPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("boiler: unable to update balance_snapshots, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"balance_snapshots\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, balanceSnapshotPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(balanceSnapshotType, balanceSnapshotMapping, append(wl, balanceSnapshotPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update balance_snapshots row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by update for balance_snapshots")
+	}
+
+	if !cached {
+		balanceSnapshotUpdateCacheMut.Lock()
+		balanceSnapshotUpdateCache[key] = cache
+		balanceSnapshotUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q balanceSnapshotQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all for balance_snapshots")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected for balance_snapshots")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o BalanceSnapshotSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("boiler: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), balanceSnapshotPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"balance_snapshots\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, balanceSnapshotPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all in balanceSnapshot slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected all in update all balanceSnapshot")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *BalanceSnapshot) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no balance_snapshots provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(balanceSnapshotColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	balanceSnapshotUpsertCacheMut.RLock()
+	cache, cached := balanceSnapshotUpsertCache[key]
+	balanceSnapshotUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			balanceSnapshotAllColumns,
+			balanceSnapshotColumnsWithDefault,
+			balanceSnapshotColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			balanceSnapshotAllColumns,
+			balanceSnapshotPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("boiler: unable to upsert balance_snapshots, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(balanceSnapshotPrimaryKeyColumns))
+			copy(conflict, balanceSnapshotPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"balance_snapshots\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(balanceSnapshotType, balanceSnapshotMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(balanceSnapshotType, balanceSnapshotMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to upsert balance_snapshots")
+	}
+
+	if !cached {
+		balanceSnapshotUpsertCacheMut.Lock()
+		balanceSnapshotUpsertCache[key] = cache
+		balanceSnapshotUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// Delete deletes a single BalanceSnapshot record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *BalanceSnapshot) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("boiler: no BalanceSnapshot provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), balanceSnapshotPrimaryKeyMapping)
+	sql := "DELETE FROM \"balance_snapshots\" WHERE \"account_id\"=$1 AND \"taken_at\"=$2"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete from balance_snapshots")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by delete for balance_snapshots")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q balanceSnapshotQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("boiler: no balanceSnapshotQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from balance_snapshots")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for balance_snapshots")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o BalanceSnapshotSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(balanceSnapshotBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), balanceSnapshotPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"balance_snapshots\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, balanceSnapshotPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from balanceSnapshot slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for balance_snapshots")
+	}
+
+	if len(balanceSnapshotAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *BalanceSnapshot) Reload(exec boil.Executor) error {
+	ret, err := FindBalanceSnapshot(exec, o.AccountID, o.TakenAt)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *BalanceSnapshotSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := BalanceSnapshotSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), balanceSnapshotPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"balance_snapshots\".* FROM \"balance_snapshots\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, balanceSnapshotPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to reload all in BalanceSnapshotSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// BalanceSnapshotExists checks if the BalanceSnapshot row exists.
+func BalanceSnapshotExists(exec boil.Executor, accountID string, takenAt time.Time) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"balance_snapshots\" where \"account_id\"=$1 AND \"taken_at\"=$2 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, accountID, takenAt)
+	}
+	row := exec.QueryRow(sql, accountID, takenAt)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: unable to check if balance_snapshots exists")
+	}
+
+	return exists, nil
+}
@@ -4,15 +4,33 @@
 package boiler
 
 var TableNames = struct {
-	AccountCodes     string
-	Accounts         string
-	Ledgers          string
-	SchemaMigrations string
-	Transactions     string
+	AccountCodes      string
+	AccountFreezes    string
+	Accounts          string
+	APIClients        string
+	BalanceSnapshots  string
+	IdempotencyKeys   string
+	Leader            string
+	Ledgers           string
+	OutboxEvents      string
+	PendingTransfers  string
+	SchemaMigrations  string
+	Transactions      string
+	WebhookDeliveries string
+	WebhookEndpoints  string
 }{
-	AccountCodes:     "account_codes",
-	Accounts:         "accounts",
-	Ledgers:          "ledgers",
-	SchemaMigrations: "schema_migrations",
-	Transactions:     "transactions",
+	AccountCodes:      "account_codes",
+	AccountFreezes:    "account_freezes",
+	Accounts:          "accounts",
+	APIClients:        "api_clients",
+	BalanceSnapshots:  "balance_snapshots",
+	IdempotencyKeys:   "idempotency_keys",
+	Leader:            "leader",
+	Ledgers:           "ledgers",
+	OutboxEvents:      "outbox_events",
+	PendingTransfers:  "pending_transfers",
+	SchemaMigrations:  "schema_migrations",
+	Transactions:      "transactions",
+	WebhookDeliveries: "webhook_deliveries",
+	WebhookEndpoints:  "webhook_endpoints",
 }
@@ -0,0 +1,890 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package boiler
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// Leader is an object representing the database table.
+type Leader struct {
+	ID         int       `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	InstanceID string    `boiler:"instance_id" boil:"instance_id" json:"instance_id" toml:"instance_id" yaml:"instance_id"`
+	Address    string    `boiler:"address" boil:"address" json:"address" toml:"address" yaml:"address"`
+	ElectedAt  time.Time `boiler:"elected_at" boil:"elected_at" json:"elected_at" toml:"elected_at" yaml:"elected_at"`
+	Epoch      int64     `boiler:"epoch" boil:"epoch" json:"epoch" toml:"epoch" yaml:"epoch"`
+
+	R *leaderR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L leaderL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var LeaderColumns = struct {
+	ID         string
+	InstanceID string
+	Address    string
+	ElectedAt  string
+	Epoch      string
+}{
+	ID:         "id",
+	InstanceID: "instance_id",
+	Address:    "address",
+	ElectedAt:  "elected_at",
+	Epoch:      "epoch",
+}
+
+var LeaderTableColumns = struct {
+	ID         string
+	InstanceID string
+	Address    string
+	ElectedAt  string
+	Epoch      string
+}{
+	ID:         "leader.id",
+	InstanceID: "leader.instance_id",
+	Address:    "leader.address",
+	ElectedAt:  "leader.elected_at",
+	Epoch:      "leader.epoch",
+}
+
+// Generated where
+
+type whereHelperint64 struct{ field string }
+
+func (w whereHelperint64) EQ(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint64) NEQ(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint64) LT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint64) LTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint64) GT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint64) GTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint64) IN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var LeaderWhere = struct {
+	ID         whereHelperint
+	InstanceID whereHelperstring
+	Address    whereHelperstring
+	ElectedAt  whereHelpertime_Time
+	Epoch      whereHelperint64
+}{
+	ID:         whereHelperint{field: "\"leader\".\"id\""},
+	InstanceID: whereHelperstring{field: "\"leader\".\"instance_id\""},
+	Address:    whereHelperstring{field: "\"leader\".\"address\""},
+	ElectedAt:  whereHelpertime_Time{field: "\"leader\".\"elected_at\""},
+	Epoch:      whereHelperint64{field: "\"leader\".\"epoch\""},
+}
+
+// LeaderRels is where relationship names are stored.
+var LeaderRels = struct {
+}{}
+
+// leaderR is where relationships are stored.
+type leaderR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*leaderR) NewStruct() *leaderR {
+	return &leaderR{}
+}
+
+// leaderL is where Load methods for each relationship are stored.
+type leaderL struct{}
+
+var (
+	leaderAllColumns            = []string{"id", "instance_id", "address", "elected_at", "epoch"}
+	leaderColumnsWithoutDefault = []string{"instance_id", "address"}
+	leaderColumnsWithDefault    = []string{"id", "elected_at", "epoch"}
+	leaderPrimaryKeyColumns     = []string{"id"}
+	leaderGeneratedColumns      = []string{}
+)
+
+type (
+	// LeaderSlice is an alias for a slice of pointers to Leader.
+	// This should almost always be used instead of []Leader.
+	LeaderSlice []*Leader
+	// LeaderHook is the signature for custom Leader hook methods
+	LeaderHook func(boil.Executor, *Leader) error
+
+	leaderQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	leaderType                 = reflect.TypeOf(&Leader{})
+	leaderMapping              = queries.MakeStructMapping(leaderType)
+	leaderPrimaryKeyMapping, _ = queries.BindMapping(leaderType, leaderMapping, leaderPrimaryKeyColumns)
+	leaderInsertCacheMut       sync.RWMutex
+	leaderInsertCache          = make(map[string]insertCache)
+	leaderUpdateCacheMut       sync.RWMutex
+	leaderUpdateCache          = make(map[string]updateCache)
+	leaderUpsertCacheMut       sync.RWMutex
+	leaderUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var leaderAfterSelectHooks []LeaderHook
+
+var leaderBeforeInsertHooks []LeaderHook
+var leaderAfterInsertHooks []LeaderHook
+
+var leaderBeforeUpdateHooks []LeaderHook
+var leaderAfterUpdateHooks []LeaderHook
+
+var leaderBeforeDeleteHooks []LeaderHook
+var leaderAfterDeleteHooks []LeaderHook
+
+var leaderBeforeUpsertHooks []LeaderHook
+var leaderAfterUpsertHooks []LeaderHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Leader) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range leaderAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Leader) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range leaderBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Leader) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range leaderAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Leader) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range leaderBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Leader) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range leaderAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Leader) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range leaderBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Leader) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range leaderAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Leader) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range leaderBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Leader) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range leaderAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddLeaderHook registers your hook function for all future operations.
+func AddLeaderHook(hookPoint boil.HookPoint, leaderHook LeaderHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		leaderAfterSelectHooks = append(leaderAfterSelectHooks, leaderHook)
+	case boil.BeforeInsertHook:
+		leaderBeforeInsertHooks = append(leaderBeforeInsertHooks, leaderHook)
+	case boil.AfterInsertHook:
+		leaderAfterInsertHooks = append(leaderAfterInsertHooks, leaderHook)
+	case boil.BeforeUpdateHook:
+		leaderBeforeUpdateHooks = append(leaderBeforeUpdateHooks, leaderHook)
+	case boil.AfterUpdateHook:
+		leaderAfterUpdateHooks = append(leaderAfterUpdateHooks, leaderHook)
+	case boil.BeforeDeleteHook:
+		leaderBeforeDeleteHooks = append(leaderBeforeDeleteHooks, leaderHook)
+	case boil.AfterDeleteHook:
+		leaderAfterDeleteHooks = append(leaderAfterDeleteHooks, leaderHook)
+	case boil.BeforeUpsertHook:
+		leaderBeforeUpsertHooks = append(leaderBeforeUpsertHooks, leaderHook)
+	case boil.AfterUpsertHook:
+		leaderAfterUpsertHooks = append(leaderAfterUpsertHooks, leaderHook)
+	}
+}
+
+// One returns a single leader record from the query.
+func (q leaderQuery) One(exec boil.Executor) (*Leader, error) {
+	o := &Leader{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: failed to execute a one query for leader")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all Leader records from the query.
+func (q leaderQuery) All(exec boil.Executor) (LeaderSlice, error) {
+	var o []*Leader
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "boiler: failed to assign all query results to Leader slice")
+	}
+
+	if len(leaderAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all Leader records in the query.
+func (q leaderQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to count leader rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q leaderQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: failed to check if leader exists")
+	}
+
+	return count > 0, nil
+}
+
+// Leaders retrieves all the records using an executor.
+func Leaders(mods ...qm.QueryMod) leaderQuery {
+	mods = append(mods, qm.From("\"leader\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"leader\".*"})
+	}
+
+	return leaderQuery{q}
+}
+
+// FindLeader retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindLeader(exec boil.Executor, iD int, selectCols ...string) (*Leader, error) {
+	leaderObj := &Leader{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"leader\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, leaderObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: unable to select from leader")
+	}
+
+	if err = leaderObj.doAfterSelectHooks(exec); err != nil {
+		return leaderObj, err
+	}
+
+	return leaderObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Leader) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no leader provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(leaderColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	leaderInsertCacheMut.RLock()
+	cache, cached := leaderInsertCache[key]
+	leaderInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			leaderAllColumns,
+			leaderColumnsWithDefault,
+			leaderColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(leaderType, leaderMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(leaderType, leaderMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"leader\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"leader\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to insert into leader")
+	}
+
+	if !cached {
+		leaderInsertCacheMut.Lock()
+		leaderInsertCache[key] = cache
+		leaderInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// Update uses an executor to update the Leader.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Leader) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	leaderUpdateCacheMut.RLock()
+	cache, cached := leaderUpdateCache[key]
+	leaderUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			leaderAllColumns,
+			leaderPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("boiler: unable to update leader, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"leader\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, leaderPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(leaderType, leaderMapping, append(wl, leaderPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update leader row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by update for leader")
+	}
+
+	if !cached {
+		leaderUpdateCacheMut.Lock()
+		leaderUpdateCache[key] = cache
+		leaderUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q leaderQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all for leader")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected for leader")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o LeaderSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("boiler: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), leaderPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"leader\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, leaderPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all in leader slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected all in update all leader")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Leader) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no leader provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(leaderColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	leaderUpsertCacheMut.RLock()
+	cache, cached := leaderUpsertCache[key]
+	leaderUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			leaderAllColumns,
+			leaderColumnsWithDefault,
+			leaderColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			leaderAllColumns,
+			leaderPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("boiler: unable to upsert leader, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(leaderPrimaryKeyColumns))
+			copy(conflict, leaderPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"leader\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(leaderType, leaderMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(leaderType, leaderMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to upsert leader")
+	}
+
+	if !cached {
+		leaderUpsertCacheMut.Lock()
+		leaderUpsertCache[key] = cache
+		leaderUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// Delete deletes a single Leader record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Leader) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("boiler: no Leader provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), leaderPrimaryKeyMapping)
+	sql := "DELETE FROM \"leader\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete from leader")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by delete for leader")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q leaderQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("boiler: no leaderQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from leader")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for leader")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o LeaderSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(leaderBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), leaderPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"leader\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, leaderPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from leader slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for leader")
+	}
+
+	if len(leaderAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Leader) Reload(exec boil.Executor) error {
+	ret, err := FindLeader(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *LeaderSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := LeaderSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), leaderPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"leader\".* FROM \"leader\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, leaderPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to reload all in LeaderSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// LeaderExists checks if the Leader row exists.
+func LeaderExists(exec boil.Executor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"leader\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: unable to check if leader exists")
+	}
+
+	return exists, nil
+}
@@ -57,17 +57,20 @@ var LedgerWhere = struct {
 
 // LedgerRels is where relationship names are stored.
 var LedgerRels = struct {
-	Accounts     string
-	Transactions string
+	Accounts         string
+	PendingTransfers string
+	Transactions     string
 }{
-	Accounts:     "Accounts",
-	Transactions: "Transactions",
+	Accounts:         "Accounts",
+	PendingTransfers: "PendingTransfers",
+	Transactions:     "Transactions",
 }
 
 // ledgerR is where relationships are stored.
 type ledgerR struct {
-	Accounts     AccountSlice     `boiler:"Accounts" boil:"Accounts" json:"Accounts" toml:"Accounts" yaml:"Accounts"`
-	Transactions TransactionSlice `boiler:"Transactions" boil:"Transactions" json:"Transactions" toml:"Transactions" yaml:"Transactions"`
+	Accounts         AccountSlice         `boiler:"Accounts" boil:"Accounts" json:"Accounts" toml:"Accounts" yaml:"Accounts"`
+	PendingTransfers PendingTransferSlice `boiler:"PendingTransfers" boil:"PendingTransfers" json:"PendingTransfers" toml:"PendingTransfers" yaml:"PendingTransfers"`
+	Transactions     TransactionSlice     `boiler:"Transactions" boil:"Transactions" json:"Transactions" toml:"Transactions" yaml:"Transactions"`
 }
 
 // NewStruct creates a new relationship struct
@@ -82,6 +85,13 @@ func (r *ledgerR) GetAccounts() AccountSlice {
 	return r.Accounts
 }
 
+func (r *ledgerR) GetPendingTransfers() PendingTransferSlice {
+	if r == nil {
+		return nil
+	}
+	return r.PendingTransfers
+}
+
 func (r *ledgerR) GetTransactions() TransactionSlice {
 	if r == nil {
 		return nil
@@ -356,6 +366,20 @@ func (o *Ledger) Accounts(mods ...qm.QueryMod) accountQuery {
 	return Accounts(queryMods...)
 }
 
+// PendingTransfers retrieves all the pending_transfer's PendingTransfers with an executor.
+func (o *Ledger) PendingTransfers(mods ...qm.QueryMod) pendingTransferQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"pending_transfers\".\"ledger\"=?", o.ID),
+	)
+
+	return PendingTransfers(queryMods...)
+}
+
 // Transactions retrieves all the transaction's Transactions with an executor.
 func (o *Ledger) Transactions(mods ...qm.QueryMod) transactionQuery {
 	var queryMods []qm.QueryMod
@@ -484,6 +508,120 @@ func (ledgerL) LoadAccounts(e boil.Executor, singular bool, maybeLedger interfac
 	return nil
 }
 
+// LoadPendingTransfers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (ledgerL) LoadPendingTransfers(e boil.Executor, singular bool, maybeLedger interface{}, mods queries.Applicator) error {
+	var slice []*Ledger
+	var object *Ledger
+
+	if singular {
+		var ok bool
+		object, ok = maybeLedger.(*Ledger)
+		if !ok {
+			object = new(Ledger)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeLedger)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeLedger))
+			}
+		}
+	} else {
+		s, ok := maybeLedger.(*[]*Ledger)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeLedger)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeLedger))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &ledgerR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &ledgerR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`pending_transfers`),
+		qm.WhereIn(`pending_transfers.ledger in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load pending_transfers")
+	}
+
+	var resultSlice []*PendingTransfer
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice pending_transfers")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on pending_transfers")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for pending_transfers")
+	}
+
+	if len(pendingTransferAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PendingTransfers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &pendingTransferR{}
+			}
+			foreign.R.PendingTransferLedger = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.Ledger {
+				local.R.PendingTransfers = append(local.R.PendingTransfers, foreign)
+				if foreign.R == nil {
+					foreign.R = &pendingTransferR{}
+				}
+				foreign.R.PendingTransferLedger = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadTransactions allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (ledgerL) LoadTransactions(e boil.Executor, singular bool, maybeLedger interface{}, mods queries.Applicator) error {
@@ -650,6 +788,58 @@ func (o *Ledger) AddAccounts(exec boil.Executor, insert bool, related ...*Accoun
 	return nil
 }
 
+// AddPendingTransfers adds the given related objects to the existing relationships
+// of the ledger, optionally inserting them as new records.
+// Appends related to o.R.PendingTransfers.
+// Sets related.R.PendingTransferLedger appropriately.
+func (o *Ledger) AddPendingTransfers(exec boil.Executor, insert bool, related ...*PendingTransfer) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.Ledger = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"pending_transfers\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"ledger"}),
+				strmangle.WhereClause("\"", "\"", 2, pendingTransferPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.Ledger = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &ledgerR{
+			PendingTransfers: related,
+		}
+	} else {
+		o.R.PendingTransfers = append(o.R.PendingTransfers, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &pendingTransferR{
+				PendingTransferLedger: o,
+			}
+		} else {
+			rel.R.PendingTransferLedger = o
+		}
+	}
+	return nil
+}
+
 // AddTransactions adds the given related objects to the existing relationships
 // of the ledger, optionally inserting them as new records.
 // Appends related to o.R.Transactions.
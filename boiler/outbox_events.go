@@ -0,0 +1,1091 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package boiler
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// OutboxEvent is an object representing the database table.
+type OutboxEvent struct {
+	ID            int64      `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	TransactionID string     `boiler:"transaction_id" boil:"transaction_id" json:"transaction_id" toml:"transaction_id" yaml:"transaction_id"`
+	Payload       types.JSON `boiler:"payload" boil:"payload" json:"payload" toml:"payload" yaml:"payload"`
+	CreatedAt     time.Time  `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	DispatchedAt  null.Time  `boiler:"dispatched_at" boil:"dispatched_at" json:"dispatched_at,omitempty" toml:"dispatched_at" yaml:"dispatched_at,omitempty"`
+
+	R *outboxEventR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L outboxEventL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var OutboxEventColumns = struct {
+	ID            string
+	TransactionID string
+	Payload       string
+	CreatedAt     string
+	DispatchedAt  string
+}{
+	ID:            "id",
+	TransactionID: "transaction_id",
+	Payload:       "payload",
+	CreatedAt:     "created_at",
+	DispatchedAt:  "dispatched_at",
+}
+
+var OutboxEventTableColumns = struct {
+	ID            string
+	TransactionID string
+	Payload       string
+	CreatedAt     string
+	DispatchedAt  string
+}{
+	ID:            "outbox_events.id",
+	TransactionID: "outbox_events.transaction_id",
+	Payload:       "outbox_events.payload",
+	CreatedAt:     "outbox_events.created_at",
+	DispatchedAt:  "outbox_events.dispatched_at",
+}
+
+// Generated where
+
+type whereHelpertypes_JSON struct{ field string }
+
+func (w whereHelpertypes_JSON) EQ(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertypes_JSON) NEQ(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertypes_JSON) LT(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_JSON) LTE(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_JSON) GT(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_JSON) GTE(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var OutboxEventWhere = struct {
+	ID            whereHelperint64
+	TransactionID whereHelperstring
+	Payload       whereHelpertypes_JSON
+	CreatedAt     whereHelpertime_Time
+	DispatchedAt  whereHelpernull_Time
+}{
+	ID:            whereHelperint64{field: "\"outbox_events\".\"id\""},
+	TransactionID: whereHelperstring{field: "\"outbox_events\".\"transaction_id\""},
+	Payload:       whereHelpertypes_JSON{field: "\"outbox_events\".\"payload\""},
+	CreatedAt:     whereHelpertime_Time{field: "\"outbox_events\".\"created_at\""},
+	DispatchedAt:  whereHelpernull_Time{field: "\"outbox_events\".\"dispatched_at\""},
+}
+
+// OutboxEventRels is where relationship names are stored.
+var OutboxEventRels = struct {
+	EventWebhookDeliveries string
+}{
+	EventWebhookDeliveries: "EventWebhookDeliveries",
+}
+
+// outboxEventR is where relationships are stored.
+type outboxEventR struct {
+	EventWebhookDeliveries WebhookDeliverySlice `boiler:"EventWebhookDeliveries" boil:"EventWebhookDeliveries" json:"EventWebhookDeliveries" toml:"EventWebhookDeliveries" yaml:"EventWebhookDeliveries"`
+}
+
+// NewStruct creates a new relationship struct
+func (*outboxEventR) NewStruct() *outboxEventR {
+	return &outboxEventR{}
+}
+
+func (r *outboxEventR) GetEventWebhookDeliveries() WebhookDeliverySlice {
+	if r == nil {
+		return nil
+	}
+	return r.EventWebhookDeliveries
+}
+
+// outboxEventL is where Load methods for each relationship are stored.
+type outboxEventL struct{}
+
+var (
+	outboxEventAllColumns            = []string{"id", "transaction_id", "payload", "created_at", "dispatched_at"}
+	outboxEventColumnsWithoutDefault = []string{"transaction_id", "payload"}
+	outboxEventColumnsWithDefault    = []string{"id", "created_at", "dispatched_at"}
+	outboxEventPrimaryKeyColumns     = []string{"id"}
+	outboxEventGeneratedColumns      = []string{}
+)
+
+type (
+	// OutboxEventSlice is an alias for a slice of pointers to OutboxEvent.
+	// This should almost always be used instead of []OutboxEvent.
+	OutboxEventSlice []*OutboxEvent
+	// OutboxEventHook is the signature for custom OutboxEvent hook methods
+	OutboxEventHook func(boil.Executor, *OutboxEvent) error
+
+	outboxEventQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	outboxEventType                 = reflect.TypeOf(&OutboxEvent{})
+	outboxEventMapping              = queries.MakeStructMapping(outboxEventType)
+	outboxEventPrimaryKeyMapping, _ = queries.BindMapping(outboxEventType, outboxEventMapping, outboxEventPrimaryKeyColumns)
+	outboxEventInsertCacheMut       sync.RWMutex
+	outboxEventInsertCache          = make(map[string]insertCache)
+	outboxEventUpdateCacheMut       sync.RWMutex
+	outboxEventUpdateCache          = make(map[string]updateCache)
+	outboxEventUpsertCacheMut       sync.RWMutex
+	outboxEventUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var outboxEventAfterSelectHooks []OutboxEventHook
+
+var outboxEventBeforeInsertHooks []OutboxEventHook
+var outboxEventAfterInsertHooks []OutboxEventHook
+
+var outboxEventBeforeUpdateHooks []OutboxEventHook
+var outboxEventAfterUpdateHooks []OutboxEventHook
+
+var outboxEventBeforeDeleteHooks []OutboxEventHook
+var outboxEventAfterDeleteHooks []OutboxEventHook
+
+var outboxEventBeforeUpsertHooks []OutboxEventHook
+var outboxEventAfterUpsertHooks []OutboxEventHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *OutboxEvent) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range outboxEventAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *OutboxEvent) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range outboxEventBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *OutboxEvent) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range outboxEventAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *OutboxEvent) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range outboxEventBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *OutboxEvent) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range outboxEventAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *OutboxEvent) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range outboxEventBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *OutboxEvent) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range outboxEventAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *OutboxEvent) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range outboxEventBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *OutboxEvent) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range outboxEventAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddOutboxEventHook registers your hook function for all future operations.
+func AddOutboxEventHook(hookPoint boil.HookPoint, outboxEventHook OutboxEventHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		outboxEventAfterSelectHooks = append(outboxEventAfterSelectHooks, outboxEventHook)
+	case boil.BeforeInsertHook:
+		outboxEventBeforeInsertHooks = append(outboxEventBeforeInsertHooks, outboxEventHook)
+	case boil.AfterInsertHook:
+		outboxEventAfterInsertHooks = append(outboxEventAfterInsertHooks, outboxEventHook)
+	case boil.BeforeUpdateHook:
+		outboxEventBeforeUpdateHooks = append(outboxEventBeforeUpdateHooks, outboxEventHook)
+	case boil.AfterUpdateHook:
+		outboxEventAfterUpdateHooks = append(outboxEventAfterUpdateHooks, outboxEventHook)
+	case boil.BeforeDeleteHook:
+		outboxEventBeforeDeleteHooks = append(outboxEventBeforeDeleteHooks, outboxEventHook)
+	case boil.AfterDeleteHook:
+		outboxEventAfterDeleteHooks = append(outboxEventAfterDeleteHooks, outboxEventHook)
+	case boil.BeforeUpsertHook:
+		outboxEventBeforeUpsertHooks = append(outboxEventBeforeUpsertHooks, outboxEventHook)
+	case boil.AfterUpsertHook:
+		outboxEventAfterUpsertHooks = append(outboxEventAfterUpsertHooks, outboxEventHook)
+	}
+}
+
+// One returns a single outboxEvent record from the query.
+func (q outboxEventQuery) One(exec boil.Executor) (*OutboxEvent, error) {
+	o := &OutboxEvent{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: failed to execute a one query for outbox_events")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all OutboxEvent records from the query.
+func (q outboxEventQuery) All(exec boil.Executor) (OutboxEventSlice, error) {
+	var o []*OutboxEvent
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "boiler: failed to assign all query results to OutboxEvent slice")
+	}
+
+	if len(outboxEventAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all OutboxEvent records in the query.
+func (q outboxEventQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to count outbox_events rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q outboxEventQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: failed to check if outbox_events exists")
+	}
+
+	return count > 0, nil
+}
+
+// EventWebhookDeliveries retrieves all the webhook_delivery's WebhookDeliveries with an executor via event_id column.
+func (o *OutboxEvent) EventWebhookDeliveries(mods ...qm.QueryMod) webhookDeliveryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"webhook_deliveries\".\"event_id\"=?", o.ID),
+	)
+
+	return WebhookDeliveries(queryMods...)
+}
+
+// LoadEventWebhookDeliveries allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (outboxEventL) LoadEventWebhookDeliveries(e boil.Executor, singular bool, maybeOutboxEvent interface{}, mods queries.Applicator) error {
+	var slice []*OutboxEvent
+	var object *OutboxEvent
+
+	if singular {
+		var ok bool
+		object, ok = maybeOutboxEvent.(*OutboxEvent)
+		if !ok {
+			object = new(OutboxEvent)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOutboxEvent)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOutboxEvent))
+			}
+		}
+	} else {
+		s, ok := maybeOutboxEvent.(*[]*OutboxEvent)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOutboxEvent)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOutboxEvent))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &outboxEventR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &outboxEventR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`webhook_deliveries`),
+		qm.WhereIn(`webhook_deliveries.event_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load webhook_deliveries")
+	}
+
+	var resultSlice []*WebhookDelivery
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice webhook_deliveries")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on webhook_deliveries")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for webhook_deliveries")
+	}
+
+	if len(webhookDeliveryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.EventWebhookDeliveries = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &webhookDeliveryR{}
+			}
+			foreign.R.Event = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.EventID {
+				local.R.EventWebhookDeliveries = append(local.R.EventWebhookDeliveries, foreign)
+				if foreign.R == nil {
+					foreign.R = &webhookDeliveryR{}
+				}
+				foreign.R.Event = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddEventWebhookDeliveries adds the given related objects to the existing relationships
+// of the outbox_event, optionally inserting them as new records.
+// Appends related to o.R.EventWebhookDeliveries.
+// Sets related.R.Event appropriately.
+func (o *OutboxEvent) AddEventWebhookDeliveries(exec boil.Executor, insert bool, related ...*WebhookDelivery) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.EventID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"webhook_deliveries\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"event_id"}),
+				strmangle.WhereClause("\"", "\"", 2, webhookDeliveryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.EventID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &outboxEventR{
+			EventWebhookDeliveries: related,
+		}
+	} else {
+		o.R.EventWebhookDeliveries = append(o.R.EventWebhookDeliveries, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &webhookDeliveryR{
+				Event: o,
+			}
+		} else {
+			rel.R.Event = o
+		}
+	}
+	return nil
+}
+
+// OutboxEvents retrieves all the records using an executor.
+func OutboxEvents(mods ...qm.QueryMod) outboxEventQuery {
+	mods = append(mods, qm.From("\"outbox_events\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"outbox_events\".*"})
+	}
+
+	return outboxEventQuery{q}
+}
+
+// FindOutboxEvent retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindOutboxEvent(exec boil.Executor, iD int64, selectCols ...string) (*OutboxEvent, error) {
+	outboxEventObj := &OutboxEvent{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"outbox_events\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, outboxEventObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: unable to select from outbox_events")
+	}
+
+	if err = outboxEventObj.doAfterSelectHooks(exec); err != nil {
+		return outboxEventObj, err
+	}
+
+	return outboxEventObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *OutboxEvent) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no outbox_events provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(outboxEventColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	outboxEventInsertCacheMut.RLock()
+	cache, cached := outboxEventInsertCache[key]
+	outboxEventInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			outboxEventAllColumns,
+			outboxEventColumnsWithDefault,
+			outboxEventColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(outboxEventType, outboxEventMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(outboxEventType, outboxEventMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"outbox_events\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"outbox_events\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to insert into outbox_events")
+	}
+
+	if !cached {
+		outboxEventInsertCacheMut.Lock()
+		outboxEventInsertCache[key] = cache
+		outboxEventInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// Update uses an executor to update the OutboxEvent.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *OutboxEvent) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	outboxEventUpdateCacheMut.RLock()
+	cache, cached := outboxEventUpdateCache[key]
+	outboxEventUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			outboxEventAllColumns,
+			outboxEventPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("boiler: unable to update outbox_events, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"outbox_events\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, outboxEventPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(outboxEventType, outboxEventMapping, append(wl, outboxEventPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update outbox_events row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by update for outbox_events")
+	}
+
+	if !cached {
+		outboxEventUpdateCacheMut.Lock()
+		outboxEventUpdateCache[key] = cache
+		outboxEventUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q outboxEventQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all for outbox_events")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected for outbox_events")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o OutboxEventSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("boiler: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), outboxEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"outbox_events\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, outboxEventPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all in outboxEvent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected all in update all outboxEvent")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *OutboxEvent) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no outbox_events provided for upsert")
+	}
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(outboxEventColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	outboxEventUpsertCacheMut.RLock()
+	cache, cached := outboxEventUpsertCache[key]
+	outboxEventUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			outboxEventAllColumns,
+			outboxEventColumnsWithDefault,
+			outboxEventColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			outboxEventAllColumns,
+			outboxEventPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("boiler: unable to upsert outbox_events, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(outboxEventPrimaryKeyColumns))
+			copy(conflict, outboxEventPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"outbox_events\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(outboxEventType, outboxEventMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(outboxEventType, outboxEventMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to upsert outbox_events")
+	}
+
+	if !cached {
+		outboxEventUpsertCacheMut.Lock()
+		outboxEventUpsertCache[key] = cache
+		outboxEventUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// Delete deletes a single OutboxEvent record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *OutboxEvent) Delete(exec boil.Executor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("boiler: no OutboxEvent provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), outboxEventPrimaryKeyMapping)
+	sql := "DELETE FROM \"outbox_events\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete from outbox_events")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by delete for outbox_events")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q outboxEventQuery) DeleteAll(exec boil.Executor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("boiler: no outboxEventQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from outbox_events")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for outbox_events")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o OutboxEventSlice) DeleteAll(exec boil.Executor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(outboxEventBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), outboxEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"outbox_events\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, outboxEventPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from outboxEvent slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for outbox_events")
+	}
+
+	if len(outboxEventAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *OutboxEvent) Reload(exec boil.Executor) error {
+	ret, err := FindOutboxEvent(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *OutboxEventSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := OutboxEventSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), outboxEventPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"outbox_events\".* FROM \"outbox_events\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, outboxEventPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to reload all in OutboxEventSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// OutboxEventExists checks if the OutboxEvent row exists.
+func OutboxEventExists(exec boil.Executor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"outbox_events\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: unable to check if outbox_events exists")
+	}
+
+	return exists, nil
+}
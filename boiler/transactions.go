@@ -14,81 +14,118 @@ import (
 
 	"github.com/friendsofgo/errors"
 	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
 	"github.com/volatiletech/strmangle"
 )
 
 // Transaction is an object representing the database table.
 type Transaction struct {
-	ID              string          `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
-	Amount          decimal.Decimal `boiler:"amount" boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
-	CreatedAt       time.Time       `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	DebitAccountID  string          `boiler:"debit_account_id" boil:"debit_account_id" json:"debit_account_id" toml:"debit_account_id" yaml:"debit_account_id"`
-	CreditAccountID string          `boiler:"credit_account_id" boil:"credit_account_id" json:"credit_account_id" toml:"credit_account_id" yaml:"credit_account_id"`
-	Ledger          int             `boiler:"ledger" boil:"ledger" json:"ledger" toml:"ledger" yaml:"ledger"`
-	TransferCode    int             `boiler:"transfer_code" boil:"transfer_code" json:"transfer_code" toml:"transfer_code" yaml:"transfer_code"`
+	ID                  string          `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	Amount              decimal.Decimal `boiler:"amount" boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	CreatedAt           time.Time       `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	DebitAccountID      string          `boiler:"debit_account_id" boil:"debit_account_id" json:"debit_account_id" toml:"debit_account_id" yaml:"debit_account_id"`
+	CreditAccountID     string          `boiler:"credit_account_id" boil:"credit_account_id" json:"credit_account_id" toml:"credit_account_id" yaml:"credit_account_id"`
+	Ledger              int             `boiler:"ledger" boil:"ledger" json:"ledger" toml:"ledger" yaml:"ledger"`
+	TransferCode        int             `boiler:"transfer_code" boil:"transfer_code" json:"transfer_code" toml:"transfer_code" yaml:"transfer_code"`
+	ParentTransactionID null.String     `boiler:"parent_transaction_id" boil:"parent_transaction_id" json:"parent_transaction_id,omitempty" toml:"parent_transaction_id" yaml:"parent_transaction_id,omitempty"`
+	Seq                 int64           `boiler:"seq" boil:"seq" json:"seq" toml:"seq" yaml:"seq"`
+	InitiatorID         null.String     `boiler:"initiator_id" boil:"initiator_id" json:"initiator_id,omitempty" toml:"initiator_id" yaml:"initiator_id,omitempty"`
+	ReferenceID         null.String     `boiler:"reference_id" boil:"reference_id" json:"reference_id,omitempty" toml:"reference_id" yaml:"reference_id,omitempty"`
+	Metadata            types.JSON      `boiler:"metadata" boil:"metadata" json:"metadata" toml:"metadata" yaml:"metadata"`
 
 	R *transactionR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
 	L transactionL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var TransactionColumns = struct {
-	ID              string
-	Amount          string
-	CreatedAt       string
-	DebitAccountID  string
-	CreditAccountID string
-	Ledger          string
-	TransferCode    string
+	ID                  string
+	Amount              string
+	CreatedAt           string
+	DebitAccountID      string
+	CreditAccountID     string
+	Ledger              string
+	TransferCode        string
+	ParentTransactionID string
+	Seq                 string
+	InitiatorID         string
+	ReferenceID         string
+	Metadata            string
 }{
-	ID:              "id",
-	Amount:          "amount",
-	CreatedAt:       "created_at",
-	DebitAccountID:  "debit_account_id",
-	CreditAccountID: "credit_account_id",
-	Ledger:          "ledger",
-	TransferCode:    "transfer_code",
+	ID:                  "id",
+	Amount:              "amount",
+	CreatedAt:           "created_at",
+	DebitAccountID:      "debit_account_id",
+	CreditAccountID:     "credit_account_id",
+	Ledger:              "ledger",
+	TransferCode:        "transfer_code",
+	ParentTransactionID: "parent_transaction_id",
+	Seq:                 "seq",
+	InitiatorID:         "initiator_id",
+	ReferenceID:         "reference_id",
+	Metadata:            "metadata",
 }
 
 var TransactionTableColumns = struct {
-	ID              string
-	Amount          string
-	CreatedAt       string
-	DebitAccountID  string
-	CreditAccountID string
-	Ledger          string
-	TransferCode    string
+	ID                  string
+	Amount              string
+	CreatedAt           string
+	DebitAccountID      string
+	CreditAccountID     string
+	Ledger              string
+	TransferCode        string
+	ParentTransactionID string
+	Seq                 string
+	InitiatorID         string
+	ReferenceID         string
+	Metadata            string
 }{
-	ID:              "transactions.id",
-	Amount:          "transactions.amount",
-	CreatedAt:       "transactions.created_at",
-	DebitAccountID:  "transactions.debit_account_id",
-	CreditAccountID: "transactions.credit_account_id",
-	Ledger:          "transactions.ledger",
-	TransferCode:    "transactions.transfer_code",
+	ID:                  "transactions.id",
+	Amount:              "transactions.amount",
+	CreatedAt:           "transactions.created_at",
+	DebitAccountID:      "transactions.debit_account_id",
+	CreditAccountID:     "transactions.credit_account_id",
+	Ledger:              "transactions.ledger",
+	TransferCode:        "transactions.transfer_code",
+	ParentTransactionID: "transactions.parent_transaction_id",
+	Seq:                 "transactions.seq",
+	InitiatorID:         "transactions.initiator_id",
+	ReferenceID:         "transactions.reference_id",
+	Metadata:            "transactions.metadata",
 }
 
 // Generated where
 
 var TransactionWhere = struct {
-	ID              whereHelperstring
-	Amount          whereHelperdecimal_Decimal
-	CreatedAt       whereHelpertime_Time
-	DebitAccountID  whereHelperstring
-	CreditAccountID whereHelperstring
-	Ledger          whereHelperint
-	TransferCode    whereHelperint
+	ID                  whereHelperstring
+	Amount              whereHelperdecimal_Decimal
+	CreatedAt           whereHelpertime_Time
+	DebitAccountID      whereHelperstring
+	CreditAccountID     whereHelperstring
+	Ledger              whereHelperint
+	TransferCode        whereHelperint
+	ParentTransactionID whereHelpernull_String
+	Seq                 whereHelperint64
+	InitiatorID         whereHelpernull_String
+	ReferenceID         whereHelpernull_String
+	Metadata            whereHelpertypes_JSON
 }{
-	ID:              whereHelperstring{field: "\"transactions\".\"id\""},
-	Amount:          whereHelperdecimal_Decimal{field: "\"transactions\".\"amount\""},
-	CreatedAt:       whereHelpertime_Time{field: "\"transactions\".\"created_at\""},
-	DebitAccountID:  whereHelperstring{field: "\"transactions\".\"debit_account_id\""},
-	CreditAccountID: whereHelperstring{field: "\"transactions\".\"credit_account_id\""},
-	Ledger:          whereHelperint{field: "\"transactions\".\"ledger\""},
-	TransferCode:    whereHelperint{field: "\"transactions\".\"transfer_code\""},
+	ID:                  whereHelperstring{field: "\"transactions\".\"id\""},
+	Amount:              whereHelperdecimal_Decimal{field: "\"transactions\".\"amount\""},
+	CreatedAt:           whereHelpertime_Time{field: "\"transactions\".\"created_at\""},
+	DebitAccountID:      whereHelperstring{field: "\"transactions\".\"debit_account_id\""},
+	CreditAccountID:     whereHelperstring{field: "\"transactions\".\"credit_account_id\""},
+	Ledger:              whereHelperint{field: "\"transactions\".\"ledger\""},
+	TransferCode:        whereHelperint{field: "\"transactions\".\"transfer_code\""},
+	ParentTransactionID: whereHelpernull_String{field: "\"transactions\".\"parent_transaction_id\""},
+	Seq:                 whereHelperint64{field: "\"transactions\".\"seq\""},
+	InitiatorID:         whereHelpernull_String{field: "\"transactions\".\"initiator_id\""},
+	ReferenceID:         whereHelpernull_String{field: "\"transactions\".\"reference_id\""},
+	Metadata:            whereHelpertypes_JSON{field: "\"transactions\".\"metadata\""},
 }
 
 // TransactionRels is where relationship names are stored.
@@ -139,9 +176,9 @@ func (r *transactionR) GetTransactionLedger() *Ledger {
 type transactionL struct{}
 
 var (
-	transactionAllColumns            = []string{"id", "amount", "created_at", "debit_account_id", "credit_account_id", "ledger", "transfer_code"}
+	transactionAllColumns            = []string{"id", "amount", "created_at", "debit_account_id", "credit_account_id", "ledger", "transfer_code", "parent_transaction_id", "seq", "initiator_id", "reference_id", "metadata"}
 	transactionColumnsWithoutDefault = []string{"amount", "debit_account_id", "credit_account_id", "ledger", "transfer_code"}
-	transactionColumnsWithDefault    = []string{"id", "created_at"}
+	transactionColumnsWithDefault    = []string{"id", "created_at", "parent_transaction_id", "seq", "initiator_id", "reference_id", "metadata"}
 	transactionPrimaryKeyColumns     = []string{"id", "created_at"}
 	transactionGeneratedColumns      = []string{}
 )
@@ -0,0 +1,1110 @@
+// Code generated by SQLBoiler 4.13.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package boiler
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// WebhookEndpoint is an object representing the database table.
+type WebhookEndpoint struct {
+	ID        string    `boiler:"id" boil:"id" json:"id" toml:"id" yaml:"id"`
+	URL       string    `boiler:"url" boil:"url" json:"url" toml:"url" yaml:"url"`
+	Secret    string    `boiler:"secret" boil:"secret" json:"secret" toml:"secret" yaml:"secret"`
+	CreatedAt time.Time `boiler:"created_at" boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	DeletedAt null.Time `boiler:"deleted_at" boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+
+	R *webhookEndpointR `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+	L webhookEndpointL  `boiler:"-" boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var WebhookEndpointColumns = struct {
+	ID        string
+	URL       string
+	Secret    string
+	CreatedAt string
+	DeletedAt string
+}{
+	ID:        "id",
+	URL:       "url",
+	Secret:    "secret",
+	CreatedAt: "created_at",
+	DeletedAt: "deleted_at",
+}
+
+var WebhookEndpointTableColumns = struct {
+	ID        string
+	URL       string
+	Secret    string
+	CreatedAt string
+	DeletedAt string
+}{
+	ID:        "webhook_endpoints.id",
+	URL:       "webhook_endpoints.url",
+	Secret:    "webhook_endpoints.secret",
+	CreatedAt: "webhook_endpoints.created_at",
+	DeletedAt: "webhook_endpoints.deleted_at",
+}
+
+// Generated where
+
+var WebhookEndpointWhere = struct {
+	ID        whereHelperstring
+	URL       whereHelperstring
+	Secret    whereHelperstring
+	CreatedAt whereHelpertime_Time
+	DeletedAt whereHelpernull_Time
+}{
+	ID:        whereHelperstring{field: "\"webhook_endpoints\".\"id\""},
+	URL:       whereHelperstring{field: "\"webhook_endpoints\".\"url\""},
+	Secret:    whereHelperstring{field: "\"webhook_endpoints\".\"secret\""},
+	CreatedAt: whereHelpertime_Time{field: "\"webhook_endpoints\".\"created_at\""},
+	DeletedAt: whereHelpernull_Time{field: "\"webhook_endpoints\".\"deleted_at\""},
+}
+
+// WebhookEndpointRels is where relationship names are stored.
+var WebhookEndpointRels = struct {
+	EndpointWebhookDeliveries string
+}{
+	EndpointWebhookDeliveries: "EndpointWebhookDeliveries",
+}
+
+// webhookEndpointR is where relationships are stored.
+type webhookEndpointR struct {
+	EndpointWebhookDeliveries WebhookDeliverySlice `boiler:"EndpointWebhookDeliveries" boil:"EndpointWebhookDeliveries" json:"EndpointWebhookDeliveries" toml:"EndpointWebhookDeliveries" yaml:"EndpointWebhookDeliveries"`
+}
+
+// NewStruct creates a new relationship struct
+func (*webhookEndpointR) NewStruct() *webhookEndpointR {
+	return &webhookEndpointR{}
+}
+
+func (r *webhookEndpointR) GetEndpointWebhookDeliveries() WebhookDeliverySlice {
+	if r == nil {
+		return nil
+	}
+	return r.EndpointWebhookDeliveries
+}
+
+// webhookEndpointL is where Load methods for each relationship are stored.
+type webhookEndpointL struct{}
+
+var (
+	webhookEndpointAllColumns            = []string{"id", "url", "secret", "created_at", "deleted_at"}
+	webhookEndpointColumnsWithoutDefault = []string{"url", "secret"}
+	webhookEndpointColumnsWithDefault    = []string{"id", "created_at", "deleted_at"}
+	webhookEndpointPrimaryKeyColumns     = []string{"id"}
+	webhookEndpointGeneratedColumns      = []string{}
+)
+
+type (
+	// WebhookEndpointSlice is an alias for a slice of pointers to WebhookEndpoint.
+	// This should almost always be used instead of []WebhookEndpoint.
+	WebhookEndpointSlice []*WebhookEndpoint
+	// WebhookEndpointHook is the signature for custom WebhookEndpoint hook methods
+	WebhookEndpointHook func(boil.Executor, *WebhookEndpoint) error
+
+	webhookEndpointQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	webhookEndpointType                 = reflect.TypeOf(&WebhookEndpoint{})
+	webhookEndpointMapping              = queries.MakeStructMapping(webhookEndpointType)
+	webhookEndpointPrimaryKeyMapping, _ = queries.BindMapping(webhookEndpointType, webhookEndpointMapping, webhookEndpointPrimaryKeyColumns)
+	webhookEndpointInsertCacheMut       sync.RWMutex
+	webhookEndpointInsertCache          = make(map[string]insertCache)
+	webhookEndpointUpdateCacheMut       sync.RWMutex
+	webhookEndpointUpdateCache          = make(map[string]updateCache)
+	webhookEndpointUpsertCacheMut       sync.RWMutex
+	webhookEndpointUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var webhookEndpointAfterSelectHooks []WebhookEndpointHook
+
+var webhookEndpointBeforeInsertHooks []WebhookEndpointHook
+var webhookEndpointAfterInsertHooks []WebhookEndpointHook
+
+var webhookEndpointBeforeUpdateHooks []WebhookEndpointHook
+var webhookEndpointAfterUpdateHooks []WebhookEndpointHook
+
+var webhookEndpointBeforeDeleteHooks []WebhookEndpointHook
+var webhookEndpointAfterDeleteHooks []WebhookEndpointHook
+
+var webhookEndpointBeforeUpsertHooks []WebhookEndpointHook
+var webhookEndpointAfterUpsertHooks []WebhookEndpointHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *WebhookEndpoint) doAfterSelectHooks(exec boil.Executor) (err error) {
+	for _, hook := range webhookEndpointAfterSelectHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *WebhookEndpoint) doBeforeInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range webhookEndpointBeforeInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *WebhookEndpoint) doAfterInsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range webhookEndpointAfterInsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *WebhookEndpoint) doBeforeUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range webhookEndpointBeforeUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *WebhookEndpoint) doAfterUpdateHooks(exec boil.Executor) (err error) {
+	for _, hook := range webhookEndpointAfterUpdateHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *WebhookEndpoint) doBeforeDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range webhookEndpointBeforeDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *WebhookEndpoint) doAfterDeleteHooks(exec boil.Executor) (err error) {
+	for _, hook := range webhookEndpointAfterDeleteHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *WebhookEndpoint) doBeforeUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range webhookEndpointBeforeUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *WebhookEndpoint) doAfterUpsertHooks(exec boil.Executor) (err error) {
+	for _, hook := range webhookEndpointAfterUpsertHooks {
+		if err := hook(exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddWebhookEndpointHook registers your hook function for all future operations.
+func AddWebhookEndpointHook(hookPoint boil.HookPoint, webhookEndpointHook WebhookEndpointHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		webhookEndpointAfterSelectHooks = append(webhookEndpointAfterSelectHooks, webhookEndpointHook)
+	case boil.BeforeInsertHook:
+		webhookEndpointBeforeInsertHooks = append(webhookEndpointBeforeInsertHooks, webhookEndpointHook)
+	case boil.AfterInsertHook:
+		webhookEndpointAfterInsertHooks = append(webhookEndpointAfterInsertHooks, webhookEndpointHook)
+	case boil.BeforeUpdateHook:
+		webhookEndpointBeforeUpdateHooks = append(webhookEndpointBeforeUpdateHooks, webhookEndpointHook)
+	case boil.AfterUpdateHook:
+		webhookEndpointAfterUpdateHooks = append(webhookEndpointAfterUpdateHooks, webhookEndpointHook)
+	case boil.BeforeDeleteHook:
+		webhookEndpointBeforeDeleteHooks = append(webhookEndpointBeforeDeleteHooks, webhookEndpointHook)
+	case boil.AfterDeleteHook:
+		webhookEndpointAfterDeleteHooks = append(webhookEndpointAfterDeleteHooks, webhookEndpointHook)
+	case boil.BeforeUpsertHook:
+		webhookEndpointBeforeUpsertHooks = append(webhookEndpointBeforeUpsertHooks, webhookEndpointHook)
+	case boil.AfterUpsertHook:
+		webhookEndpointAfterUpsertHooks = append(webhookEndpointAfterUpsertHooks, webhookEndpointHook)
+	}
+}
+
+// One returns a single webhookEndpoint record from the query.
+func (q webhookEndpointQuery) One(exec boil.Executor) (*WebhookEndpoint, error) {
+	o := &WebhookEndpoint{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(nil, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: failed to execute a one query for webhook_endpoints")
+	}
+
+	if err := o.doAfterSelectHooks(exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all WebhookEndpoint records from the query.
+func (q webhookEndpointQuery) All(exec boil.Executor) (WebhookEndpointSlice, error) {
+	var o []*WebhookEndpoint
+
+	err := q.Bind(nil, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "boiler: failed to assign all query results to WebhookEndpoint slice")
+	}
+
+	if len(webhookEndpointAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all WebhookEndpoint records in the query.
+func (q webhookEndpointQuery) Count(exec boil.Executor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to count webhook_endpoints rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q webhookEndpointQuery) Exists(exec boil.Executor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRow(exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: failed to check if webhook_endpoints exists")
+	}
+
+	return count > 0, nil
+}
+
+// EndpointWebhookDeliveries retrieves all the webhook_delivery's WebhookDeliveries with an executor via endpoint_id column.
+func (o *WebhookEndpoint) EndpointWebhookDeliveries(mods ...qm.QueryMod) webhookDeliveryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"webhook_deliveries\".\"endpoint_id\"=?", o.ID),
+	)
+
+	return WebhookDeliveries(queryMods...)
+}
+
+// LoadEndpointWebhookDeliveries allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (webhookEndpointL) LoadEndpointWebhookDeliveries(e boil.Executor, singular bool, maybeWebhookEndpoint interface{}, mods queries.Applicator) error {
+	var slice []*WebhookEndpoint
+	var object *WebhookEndpoint
+
+	if singular {
+		var ok bool
+		object, ok = maybeWebhookEndpoint.(*WebhookEndpoint)
+		if !ok {
+			object = new(WebhookEndpoint)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWebhookEndpoint)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWebhookEndpoint))
+			}
+		}
+	} else {
+		s, ok := maybeWebhookEndpoint.(*[]*WebhookEndpoint)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWebhookEndpoint)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWebhookEndpoint))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &webhookEndpointR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &webhookEndpointR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`webhook_deliveries`),
+		qm.WhereIn(`webhook_deliveries.endpoint_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.Query(e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load webhook_deliveries")
+	}
+
+	var resultSlice []*WebhookDelivery
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice webhook_deliveries")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on webhook_deliveries")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for webhook_deliveries")
+	}
+
+	if len(webhookDeliveryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.EndpointWebhookDeliveries = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &webhookDeliveryR{}
+			}
+			foreign.R.Endpoint = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.EndpointID {
+				local.R.EndpointWebhookDeliveries = append(local.R.EndpointWebhookDeliveries, foreign)
+				if foreign.R == nil {
+					foreign.R = &webhookDeliveryR{}
+				}
+				foreign.R.Endpoint = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddEndpointWebhookDeliveries adds the given related objects to the existing relationships
+// of the webhook_endpoint, optionally inserting them as new records.
+// Appends related to o.R.EndpointWebhookDeliveries.
+// Sets related.R.Endpoint appropriately.
+func (o *WebhookEndpoint) AddEndpointWebhookDeliveries(exec boil.Executor, insert bool, related ...*WebhookDelivery) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.EndpointID = o.ID
+			if err = rel.Insert(exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"webhook_deliveries\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"endpoint_id"}),
+				strmangle.WhereClause("\"", "\"", 2, webhookDeliveryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+			if _, err = exec.Exec(updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.EndpointID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &webhookEndpointR{
+			EndpointWebhookDeliveries: related,
+		}
+	} else {
+		o.R.EndpointWebhookDeliveries = append(o.R.EndpointWebhookDeliveries, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &webhookDeliveryR{
+				Endpoint: o,
+			}
+		} else {
+			rel.R.Endpoint = o
+		}
+	}
+	return nil
+}
+
+// WebhookEndpoints retrieves all the records using an executor.
+func WebhookEndpoints(mods ...qm.QueryMod) webhookEndpointQuery {
+	mods = append(mods, qm.From("\"webhook_endpoints\""), qmhelper.WhereIsNull("\"webhook_endpoints\".\"deleted_at\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"webhook_endpoints\".*"})
+	}
+
+	return webhookEndpointQuery{q}
+}
+
+// FindWebhookEndpoint retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindWebhookEndpoint(exec boil.Executor, iD string, selectCols ...string) (*WebhookEndpoint, error) {
+	webhookEndpointObj := &WebhookEndpoint{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"webhook_endpoints\" where \"id\"=$1 and \"deleted_at\" is null", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(nil, exec, webhookEndpointObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "boiler: unable to select from webhook_endpoints")
+	}
+
+	if err = webhookEndpointObj.doAfterSelectHooks(exec); err != nil {
+		return webhookEndpointObj, err
+	}
+
+	return webhookEndpointObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *WebhookEndpoint) Insert(exec boil.Executor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no webhook_endpoints provided for insertion")
+	}
+
+	var err error
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeInsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(webhookEndpointColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	webhookEndpointInsertCacheMut.RLock()
+	cache, cached := webhookEndpointInsertCache[key]
+	webhookEndpointInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			webhookEndpointAllColumns,
+			webhookEndpointColumnsWithDefault,
+			webhookEndpointColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(webhookEndpointType, webhookEndpointMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(webhookEndpointType, webhookEndpointMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"webhook_endpoints\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"webhook_endpoints\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to insert into webhook_endpoints")
+	}
+
+	if !cached {
+		webhookEndpointInsertCacheMut.Lock()
+		webhookEndpointInsertCache[key] = cache
+		webhookEndpointInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(exec)
+}
+
+// Update uses an executor to update the WebhookEndpoint.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *WebhookEndpoint) Update(exec boil.Executor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	webhookEndpointUpdateCacheMut.RLock()
+	cache, cached := webhookEndpointUpdateCache[key]
+	webhookEndpointUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			webhookEndpointAllColumns,
+			webhookEndpointPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("boiler: unable to update webhook_endpoints, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"webhook_endpoints\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, webhookEndpointPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(webhookEndpointType, webhookEndpointMapping, append(wl, webhookEndpointPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+	var result sql.Result
+	result, err = exec.Exec(cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update webhook_endpoints row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by update for webhook_endpoints")
+	}
+
+	if !cached {
+		webhookEndpointUpdateCacheMut.Lock()
+		webhookEndpointUpdateCache[key] = cache
+		webhookEndpointUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q webhookEndpointQuery) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all for webhook_endpoints")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected for webhook_endpoints")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o WebhookEndpointSlice) UpdateAll(exec boil.Executor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("boiler: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webhookEndpointPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"webhook_endpoints\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, webhookEndpointPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to update all in webhookEndpoint slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to retrieve rows affected all in update all webhookEndpoint")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *WebhookEndpoint) Upsert(exec boil.Executor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("boiler: no webhook_endpoints provided for upsert")
+	}
+	currTime := time.Now().In(boil.GetLocation())
+
+	if o.CreatedAt.IsZero() {
+		o.CreatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(webhookEndpointColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	webhookEndpointUpsertCacheMut.RLock()
+	cache, cached := webhookEndpointUpsertCache[key]
+	webhookEndpointUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			webhookEndpointAllColumns,
+			webhookEndpointColumnsWithDefault,
+			webhookEndpointColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			webhookEndpointAllColumns,
+			webhookEndpointPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("boiler: unable to upsert webhook_endpoints, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(webhookEndpointPrimaryKeyColumns))
+			copy(conflict, webhookEndpointPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"webhook_endpoints\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(webhookEndpointType, webhookEndpointMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(webhookEndpointType, webhookEndpointMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRow(cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.Exec(cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to upsert webhook_endpoints")
+	}
+
+	if !cached {
+		webhookEndpointUpsertCacheMut.Lock()
+		webhookEndpointUpsertCache[key] = cache
+		webhookEndpointUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(exec)
+}
+
+// Delete deletes a single WebhookEndpoint record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *WebhookEndpoint) Delete(exec boil.Executor, hardDelete bool) (int64, error) {
+	if o == nil {
+		return 0, errors.New("boiler: no WebhookEndpoint provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), webhookEndpointPrimaryKeyMapping)
+		sql = "DELETE FROM \"webhook_endpoints\" WHERE \"id\"=$1"
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		o.DeletedAt = null.TimeFrom(currTime)
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"webhook_endpoints\" SET %s WHERE \"id\"=$2",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		valueMapping, err := queries.BindMapping(webhookEndpointType, webhookEndpointMapping, append(wl, webhookEndpointPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), valueMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete from webhook_endpoints")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by delete for webhook_endpoints")
+	}
+
+	if err := o.doAfterDeleteHooks(exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q webhookEndpointQuery) DeleteAll(exec boil.Executor, hardDelete bool) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("boiler: no webhookEndpointQuery provided for delete all")
+	}
+
+	if hardDelete {
+		queries.SetDelete(q.Query)
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		queries.SetUpdate(q.Query, M{"deleted_at": currTime})
+	}
+
+	result, err := q.Query.Exec(exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from webhook_endpoints")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for webhook_endpoints")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o WebhookEndpointSlice) DeleteAll(exec boil.Executor, hardDelete bool) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(webhookEndpointBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webhookEndpointPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+		}
+		sql = "DELETE FROM \"webhook_endpoints\" WHERE " +
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, webhookEndpointPrimaryKeyColumns, len(o))
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webhookEndpointPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+			obj.DeletedAt = null.TimeFrom(currTime)
+		}
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"webhook_endpoints\" SET %s WHERE "+
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 2, webhookEndpointPrimaryKeyColumns, len(o)),
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		args = append([]interface{}{currTime}, args...)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+	result, err := exec.Exec(sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: unable to delete all from webhookEndpoint slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "boiler: failed to get rows affected by deleteall for webhook_endpoints")
+	}
+
+	if len(webhookEndpointAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *WebhookEndpoint) Reload(exec boil.Executor) error {
+	ret, err := FindWebhookEndpoint(exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WebhookEndpointSlice) ReloadAll(exec boil.Executor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := WebhookEndpointSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), webhookEndpointPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"webhook_endpoints\".* FROM \"webhook_endpoints\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, webhookEndpointPrimaryKeyColumns, len(*o)) +
+		"and \"deleted_at\" is null"
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(nil, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "boiler: unable to reload all in WebhookEndpointSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// WebhookEndpointExists checks if the WebhookEndpoint row exists.
+func WebhookEndpointExists(exec boil.Executor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"webhook_endpoints\" where \"id\"=$1 and \"deleted_at\" is null limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+	row := exec.QueryRow(sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "boiler: unable to check if webhook_endpoints exists")
+	}
+
+	return exists, nil
+}
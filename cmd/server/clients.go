@@ -0,0 +1,144 @@
+package main
+
+import (
+	"fmt"
+	"github.com/rs/zerolog/log"
+	"github.com/urfave/cli/v2"
+	"xsyn-transactions/boiler"
+	"xsyn-transactions/gen/transactions/v1"
+	"xsyn-transactions/storage"
+	"xsyn-transactions/transactor"
+)
+
+func clientCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "client",
+		Usage: "manage the api clients, running servers pick up changes without a restart",
+		Subcommands: []*cli.Command{
+			{
+				Name:  "create",
+				Usage: "create a client and print its key, the key is not stored and can't be shown again",
+				Flags: append(dbFlags(),
+					&cli.StringFlag{Name: "name", Required: true, Usage: "a unique name for the client e.g. supremacy-server"},
+					&cli.StringSliceFlag{Name: "scope", Required: true, Usage: "read, subscribe, transact or admin, repeat for more than one"},
+					&cli.StringSliceFlag{Name: "transfer_code", Usage: "a transfer code the client can make transfers with e.g. Deposit, repeat for more than one"},
+					&cli.StringSliceFlag{Name: "ledger", Usage: "a ledger the client can make transfers on e.g. SUPS, repeat for more than one"},
+					&cli.StringSliceFlag{Name: "debit_account_code", Usage: "an account code the client can debit e.g. AccountUser, repeat for more than one, any account when not set"},
+				),
+				Action: RunClientCreate,
+			},
+			{
+				Name:  "rotate",
+				Usage: "replace a client's key and print the new one, the old key keeps working for the grace period",
+				Flags: append(dbFlags(),
+					&cli.StringFlag{Name: "name", Required: true, Usage: "the name of the client"},
+					&cli.DurationFlag{Name: "grace", Value: 0, Usage: "how long the old key keeps working e.g. 1h"},
+				),
+				Action: RunClientRotate,
+			},
+			{
+				Name:  "revoke",
+				Usage: "stop a client's keys from working",
+				Flags: append(dbFlags(),
+					&cli.StringFlag{Name: "name", Required: true, Usage: "the name of the client"},
+				),
+				Action: RunClientRevoke,
+			},
+		},
+	}
+}
+
+// RunClientCreate creates a client with its scopes and what it can transfer
+func RunClientCreate(c *cli.Context) error {
+	apiClient := &boiler.APIClient{Name: c.String("name")}
+
+	for _, s := range c.StringSlice("scope") {
+		scope, err := transactor.ParseScope(s)
+		if err != nil {
+			return err
+		}
+		apiClient.Scopes = append(apiClient.Scopes, string(scope))
+	}
+	for _, s := range c.StringSlice("transfer_code") {
+		code, ok := transactionsv1.TransferCode_value[s]
+		if !ok || code == int32(transactionsv1.TransferCode_UnusedTransferCode) {
+			return fmt.Errorf("invalid transfer code %q", s)
+		}
+		apiClient.TransferCodes = append(apiClient.TransferCodes, int64(code))
+	}
+	for _, s := range c.StringSlice("ledger") {
+		ledger, ok := transactionsv1.Ledger_value[s]
+		if !ok || ledger == int32(transactionsv1.Ledger_UnusedLedgerCode) {
+			return fmt.Errorf("invalid ledger %q", s)
+		}
+		apiClient.Ledgers = append(apiClient.Ledgers, int64(ledger))
+	}
+	for _, s := range c.StringSlice("debit_account_code") {
+		code, ok := transactionsv1.AccountCode_value[s]
+		if !ok || code == int32(transactionsv1.AccountCode_AccountUnknown) {
+			return fmt.Errorf("invalid account code %q", s)
+		}
+		apiClient.DebitAccountCodes = append(apiClient.DebitAccountCodes, int64(code))
+	}
+
+	key, keyHash, err := transactor.NewClientKey()
+	if err != nil {
+		return err
+	}
+	apiClient.KeyHash = keyHash
+
+	s, err := storage.NewStorage(storageOpts(c))
+	if err != nil {
+		return fmt.Errorf("create new storage instance: %w", err)
+	}
+	defer s.Close()
+
+	err = s.APIClientCreate(apiClient)
+	if err != nil {
+		return err
+	}
+
+	log.Info().Str("id", apiClient.ID).Str("name", apiClient.Name).Strs("scopes", apiClient.Scopes).Msg("created api client")
+	fmt.Println(key)
+	return nil
+}
+
+// RunClientRotate gives a client a new key
+func RunClientRotate(c *cli.Context) error {
+	key, keyHash, err := transactor.NewClientKey()
+	if err != nil {
+		return err
+	}
+
+	s, err := storage.NewStorage(storageOpts(c))
+	if err != nil {
+		return fmt.Errorf("create new storage instance: %w", err)
+	}
+	defer s.Close()
+
+	apiClient, err := s.APIClientRotate(c.String("name"), keyHash, c.Duration("grace"))
+	if err != nil {
+		return err
+	}
+
+	log.Info().Str("id", apiClient.ID).Str("name", apiClient.Name).Time("previousKeyExpiresAt", apiClient.PreviousKeyExpiresAt.Time).Msg("rotated api client key")
+	fmt.Println(key)
+	return nil
+}
+
+// RunClientRevoke revokes a client
+func RunClientRevoke(c *cli.Context) error {
+	s, err := storage.NewStorage(storageOpts(c))
+	if err != nil {
+		return fmt.Errorf("create new storage instance: %w", err)
+	}
+	defer s.Close()
+
+	apiClient, err := s.APIClientRevoke(c.String("name"))
+	if err != nil {
+		return err
+	}
+
+	log.Info().Str("id", apiClient.ID).Str("name", apiClient.Name).Msg("revoked api client")
+	return nil
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"expvar"
 	"fmt"
 	"github.com/bufbuild/connect-go"
 	"github.com/rs/zerolog"
@@ -11,6 +12,8 @@ import (
 	"golang.org/x/net/http2/h2c"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
 	"time"
 	"xsyn-transactions/gen/transactions/v1/transactionsv1connect"
 	"xsyn-transactions/storage"
@@ -39,23 +42,41 @@ func main() {
 				Name:    "serve",
 				Aliases: []string{"s"},
 				Usage:   "run xsyn transaction service",
-				Flags: []cli.Flag{
-					// db details
-					&cli.StringFlag{Name: "db_user", Value: "xsyn-transactions-db", Usage: "The user for postgres", EnvVars: []string{envPrefix + "_DB_USER"}},
-					&cli.StringFlag{Name: "db_pass", Value: "dev", Usage: "The pass for postgres", EnvVars: []string{envPrefix + "_DB_PASS"}},
-					&cli.StringFlag{Name: "db_host", Value: "localhost", Usage: "The host for postgres", EnvVars: []string{envPrefix + "_DB_HOST"}},
-					&cli.IntFlag{Name: "db_port", Value: 5433, Usage: "The port for postgres", EnvVars: []string{envPrefix + "_DB_PORT"}},
-					&cli.StringFlag{Name: "db_name", Value: "xsyn-transactions-db", Usage: "The db name for postgres", EnvVars: []string{envPrefix + "_DB_NAME"}},
-					&cli.IntFlag{Name: "db_max_idle_conns", Value: 40, EnvVars: []string{envPrefix + "_DB_MAX_IDLE_CONNS"}, Usage: "Database max idle conns"},
-					&cli.IntFlag{Name: "db_max_open_conns", Value: 50, EnvVars: []string{envPrefix + "_DB_MAX_OPEN_CONNS"}, Usage: "Database max open conns"},
-
+				Flags: append(dbFlags(),
 					// api details
 					&cli.IntFlag{Name: "api_port", Value: 8087, EnvVars: []string{envPrefix + "_API_PORT"}, Usage: "port to run the API"},
+					&cli.StringFlag{Name: "admin_address", Value: "127.0.0.1:8088", EnvVars: []string{envPrefix + "_ADMIN_ADDRESS"}, Usage: "address to serve the unauthenticated metrics on, keep it off the public network"},
+
+					&cli.DurationFlag{Name: "pending_transfer_timeout", Value: 10 * time.Minute, EnvVars: []string{envPrefix + "_PENDING_TRANSFER_TIMEOUT"}, Usage: "how long a reserved transfer holds funds before it expires"},
+					&cli.DurationFlag{Name: "balance_snapshot_interval", Value: time.Hour, EnvVars: []string{envPrefix + "_BALANCE_SNAPSHOT_INTERVAL"}, Usage: "how often account balances are snapshotted for point in time balance queries"},
+
+					&cli.DurationFlag{Name: "reconcile_interval", Value: time.Hour, EnvVars: []string{envPrefix + "_RECONCILE_INTERVAL"}, Usage: "how often account balances are reconciled against the transactions"},
+					&cli.BoolFlag{Name: "reconcile_repair_cache", Value: true, EnvVars: []string{envPrefix + "_RECONCILE_REPAIR_CACHE"}, Usage: "reload drifted accounts into the balance cache when reconciling"},
+
+					&cli.IntFlag{Name: "subscriber_queue_size", Value: 1000, EnvVars: []string{envPrefix + "_SUBSCRIBER_QUEUE_SIZE"}, Usage: "how many updates a transfer subscriber can fall behind by before the overflow policy applies"},
+					&cli.StringFlag{Name: "subscriber_overflow_policy", Value: string(transactor.OverflowDisconnect), EnvVars: []string{envPrefix + "_SUBSCRIBER_OVERFLOW_POLICY"}, Usage: "what to do with a transfer subscriber that falls behind: disconnect, drop_oldest or coalesce"},
 
-					&cli.StringFlag{Name: "auth_key", Value: "d21f0c89-567e-4b4f-928f-68679e48df6c", EnvVars: []string{envPrefix + "_AUTH_KEY"}, Usage: "Auth key for clients to connect to xsyn-transactions"},
-				},
+					&cli.BoolFlag{Name: "leader_election", Value: true, EnvVars: []string{envPrefix + "_LEADER_ELECTION"}, Usage: "elect one replica to write, the others forward writes to it, without it only one replica can run"},
+					&cli.StringFlag{Name: "advertise_address", Value: "", EnvVars: []string{envPrefix + "_ADVERTISE_ADDRESS"}, Usage: "the url other replicas forward writes to when this replica is the leader e.g. http://10.0.0.5:8087"},
+
+					&cli.IntFlag{Name: "write_shards", Value: 64, EnvVars: []string{envPrefix + "_WRITE_SHARDS"}, Usage: "how many shards account writes are spread over, transfers in different shards commit in parallel"},
+					&cli.IntFlag{Name: "write_queue_size", Value: 100, EnvVars: []string{envPrefix + "_WRITE_QUEUE_SIZE"}, Usage: "how many writes can be in progress or waiting at once, later writes wait for room until their deadline"},
+					&cli.IntFlag{Name: "group_commit_size", Value: 1, EnvVars: []string{envPrefix + "_GROUP_COMMIT_SIZE"}, Usage: "the most transfers committed in one db transaction, 1 commits each transfer on its own"},
+					&cli.DurationFlag{Name: "group_commit_window", Value: 2 * time.Millisecond, EnvVars: []string{envPrefix + "_GROUP_COMMIT_WINDOW"}, Usage: "how long a group commit waits for more transfers before committing"},
+
+					&cli.DurationFlag{Name: "shutdown_timeout", Value: 30 * time.Second, EnvVars: []string{envPrefix + "_SHUTDOWN_TIMEOUT"}, Usage: "how long to wait for queued transfers, broadcasts and requests to finish on shutdown"},
+
+					&cli.StringFlag{Name: "auth_key", Value: "", EnvVars: []string{envPrefix + "_AUTH_KEY"}, Usage: "deprecated, the shared key from before per-client keys, seeds the legacy-auth-key client with every permission"},
+				),
 				Action: RunService,
 			},
+			{
+				Name:   "reconcile",
+				Usage:  "check account balances against the transactions, exits with an error on drift",
+				Flags:  dbFlags(),
+				Action: RunReconcile,
+			},
+			clientCommand(),
 		},
 	}
 
@@ -64,31 +85,78 @@ func main() {
 	}
 }
 
-func RunService(c *cli.Context) error {
-	toDbUser := c.String("db_user")
-	toDbPass := c.String("db_pass")
-	toDbHost := c.String("db_host")
-	toDbPort := c.Int("db_port")
-	toDbName := c.String("db_name")
-	toDbMaxIdleConns := c.Int("db_max_idle_conns")
-	toDbMaxOpenConns := c.Int("db_max_open_conns")
+func dbFlags() []cli.Flag {
+	return []cli.Flag{
+		// db details
+		&cli.StringFlag{Name: "db_user", Value: "xsyn-transactions-db", Usage: "The user for postgres", EnvVars: []string{envPrefix + "_DB_USER"}},
+		&cli.StringFlag{Name: "db_pass", Value: "dev", Usage: "The pass for postgres", EnvVars: []string{envPrefix + "_DB_PASS"}},
+		&cli.StringFlag{Name: "db_host", Value: "localhost", Usage: "The host for postgres", EnvVars: []string{envPrefix + "_DB_HOST"}},
+		&cli.IntFlag{Name: "db_port", Value: 5433, Usage: "The port for postgres", EnvVars: []string{envPrefix + "_DB_PORT"}},
+		&cli.StringFlag{Name: "db_name", Value: "xsyn-transactions-db", Usage: "The db name for postgres", EnvVars: []string{envPrefix + "_DB_NAME"}},
+		&cli.IntFlag{Name: "db_max_idle_conns", Value: 40, EnvVars: []string{envPrefix + "_DB_MAX_IDLE_CONNS"}, Usage: "Database max idle conns"},
+		&cli.IntFlag{Name: "db_max_open_conns", Value: 50, EnvVars: []string{envPrefix + "_DB_MAX_OPEN_CONNS"}, Usage: "Database max open conns"},
+	}
+}
 
+func storageOpts(c *cli.Context) *storage.Opts {
+	return &storage.Opts{
+		DatabaseTxUser: c.String("db_user"),
+		DatabaseTxPass: c.String("db_pass"),
+		DatabaseHost:   c.String("db_host"),
+		DatabasePort:   c.Int("db_port"),
+		DatabaseName:   c.String("db_name"),
+		MaxIdle:        c.Int("db_max_idle_conns"),
+		MaxOpen:        c.Int("db_max_open_conns"),
+		Log:            &log.Logger,
+	}
+}
+
+func RunService(c *cli.Context) error {
 	apiPort := c.Int("api_port")
-	authKey := c.String("auth_key")
+	adminAddress := c.String("admin_address")
+	pendingTransferTimeout := c.Duration("pending_transfer_timeout")
+	balanceSnapshotInterval := c.Duration("balance_snapshot_interval")
+	reconcileInterval := c.Duration("reconcile_interval")
+	reconcileRepairCache := c.Bool("reconcile_repair_cache")
+	subscriberQueueSize := c.Int("subscriber_queue_size")
+	leaderElection := c.Bool("leader_election")
+	advertiseAddress := c.String("advertise_address")
+	if leaderElection && advertiseAddress == "" {
+		// fine for a single replica, followers on other hosts can't reach it
+		advertiseAddress = fmt.Sprintf("http://localhost:%d", apiPort)
+		log.Warn().Str("address", advertiseAddress).Msg("advertise address not set, other replicas will forward writes to localhost")
+	}
+	writeShards := c.Int("write_shards")
+	writeQueueSize := c.Int("write_queue_size")
+	groupCommitSize := c.Int("group_commit_size")
+	groupCommitWindow := c.Duration("group_commit_window")
+	shutdownTimeout := c.Duration("shutdown_timeout")
+	legacyAuthKey := c.String("auth_key")
+	if legacyAuthKey != "" {
+		log.Warn().Msg("auth_key is deprecated and will be removed, create a client for each caller and revoke the legacy-auth-key client")
+	}
+	subscriberOverflowPolicy, err := transactor.ParseOverflowPolicy(c.String("subscriber_overflow_policy"))
+	if err != nil {
+		return err
+	}
 
 	newTransactor, err := transactor.NewTransactor(
 		&transactor.NewTransactorOpts{
-			StorageOpts: &storage.Opts{
-				DatabaseTxUser: toDbUser,
-				DatabaseTxPass: toDbPass,
-				DatabaseHost:   toDbHost,
-				DatabasePort:   toDbPort,
-				DatabaseName:   toDbName,
-				MaxIdle:        toDbMaxIdleConns,
-				MaxOpen:        toDbMaxOpenConns,
-				Log:            &log.Logger,
-			},
-			Log: &log.Logger,
+			StorageOpts:              storageOpts(c),
+			Log:                      &log.Logger,
+			PendingTransferTimeout:   pendingTransferTimeout,
+			BalanceSnapshotInterval:  balanceSnapshotInterval,
+			ReconcileInterval:        reconcileInterval,
+			ReconcileRepairCache:     reconcileRepairCache,
+			SubscriberQueueSize:      subscriberQueueSize,
+			SubscriberOverflowPolicy: subscriberOverflowPolicy,
+			LeaderElection:           leaderElection,
+			AdvertiseAddress:         advertiseAddress,
+			WriteShards:              writeShards,
+			WriteQueueSize:           writeQueueSize,
+			GroupCommitSize:          groupCommitSize,
+			GroupCommitWindow:        groupCommitWindow,
+			LegacyAuthKey:            legacyAuthKey,
 		},
 	)
 	if err != nil {
@@ -96,45 +164,133 @@ func RunService(c *cli.Context) error {
 	}
 
 	mux := http.NewServeMux()
-	path, handler := transactionsv1connect.NewTransactorHandler(newTransactor, connect.WithInterceptors(newAuthInterceptor(authKey)))
+	path, handler := transactionsv1connect.NewTransactorHandler(newTransactor, connect.WithInterceptors(newTransactor.NewAuthInterceptor(), transactor.NewValidationInterceptor()))
 	mux.Handle(path, handler)
-	path, handler = transactionsv1connect.NewAccountsHandler(newTransactor, connect.WithInterceptors(newAuthInterceptor(authKey)))
+	path, handler = transactionsv1connect.NewAccountsHandler(newTransactor, connect.WithInterceptors(newTransactor.NewAuthInterceptor(), transactor.NewValidationInterceptor()))
+	mux.Handle(path, handler)
+	path, handler = transactionsv1connect.NewWebhooksHandler(newTransactor, connect.WithInterceptors(newTransactor.NewAuthInterceptor(), transactor.NewValidationInterceptor()))
 	mux.Handle(path, handler)
 
+	// subscriber lag and queue metrics, served on the admin listener as they aren't behind the client auth
+	expvar.Publish("transfer_subscribers", expvar.Func(newTransactor.SubscriberMetrics))
+	adminMux := http.NewServeMux()
+	adminMux.Handle("/debug/vars", metricsHandler())
+	adminServer := &http.Server{
+		Addr:    adminAddress,
+		Handler: adminMux,
+	}
+
 	hostAddr := fmt.Sprintf("0.0.0.0:%d", apiPort)
 
-	log.Info().Msgf("serving transactor on %s", hostAddr)
-	err = http.ListenAndServe(
-		hostAddr,
+	server := &http.Server{
+		Addr: hostAddr,
 		// Use h2c, so we can serve HTTP/2 without TLS.
-		h2c.NewHandler(mux, &http2.Server{}),
-	)
-	if err != nil {
+		Handler: h2c.NewHandler(mux, &http2.Server{}),
+	}
+
+	serveErr := make(chan error, 2)
+	go func() {
+		log.Info().Msgf("serving transactor on %s", hostAddr)
+		serveErr <- server.ListenAndServe()
+	}()
+	go func() {
+		log.Info().Msgf("serving metrics on %s", adminAddress)
+		serveErr <- adminServer.ListenAndServe()
+	}()
+
+	stop, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	select {
+	case err = <-serveErr:
 		return err
+	case <-stop.Done():
 	}
 
+	log.Info().Dur("timeout", shutdownTimeout).Msg("shutting down")
+	ctx, cancelTimeout := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancelTimeout()
+
+	// finish the queued transfers and flush their broadcasts first, the subscriber streams end once they're flushed
+	err = newTransactor.Close(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to close transactor")
+	}
+
+	err = server.Shutdown(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to shut down server")
+	}
+
+	err = adminServer.Shutdown(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to shut down admin server")
+	}
+
+	err = newTransactor.Storage.Close()
+	if err != nil {
+		return fmt.Errorf("close storage: %w", err)
+	}
+
+	log.Info().Msg("shut down")
 	return nil
 }
 
-// newAuthInterceptor created the rpc middleware for our auth
-func newAuthInterceptor(authKey string) connect.UnaryInterceptorFunc {
-	interceptor := func(next connect.UnaryFunc) connect.UnaryFunc {
-		return func(
-			ctx context.Context,
-			req connect.AnyRequest,
-		) (connect.AnyResponse, error) {
-			if req.Spec().IsClient {
-				// Send a token with client requests.
-				req.Header().Set("xsyn-transaction-auth-key", authKey)
-			} else if req.Header().Get("xsyn-transaction-auth-key") != authKey {
-				// Check token in handlers.
-				return nil, connect.NewError(
-					connect.CodeUnauthenticated,
-					fmt.Errorf("invalid token provided"),
-				)
+// metricsHandler serves the expvar metrics like expvar.Handler, without cmdline as the args hold the db password
+func metricsHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		fmt.Fprintf(w, "{\n")
+		first := true
+		expvar.Do(func(kv expvar.KeyValue) {
+			if kv.Key == "cmdline" {
+				return
+			}
+			if !first {
+				fmt.Fprintf(w, ",\n")
 			}
-			return next(ctx, req)
+			first = false
+			fmt.Fprintf(w, "%q: %s", kv.Key, kv.Value)
+		})
+		fmt.Fprintf(w, "\n}\n")
+	})
+}
+
+// RunReconcile checks every account's balance columns against the transactions, the balance cache lives in the server so is not checked
+func RunReconcile(c *cli.Context) error {
+	s, err := storage.NewStorage(storageOpts(c))
+	if err != nil {
+		return fmt.Errorf("create new storage instance: %w", err)
+	}
+
+	snapshot, err := s.BeginSnapshot()
+	if err != nil {
+		return err
+	}
+	defer snapshot.Rollback()
+
+	totals, err := s.AccountTotalsGet(snapshot)
+	if err != nil {
+		return err
+	}
+
+	drifted := transactor.ReconcileTotals(totals, nil)
+	for _, d := range drifted {
+		for _, drift := range d.Drift {
+			log.Error().
+				Str("accountID", d.AccountID).
+				Str("userID", d.UserID).
+				Str("ledger", d.Ledger.String()).
+				Str("field", drift.Field).
+				Str("expected", drift.Expected).
+				Str("actual", drift.Actual).
+				Msg("account balance drift")
 		}
 	}
-	return interceptor
+	if len(drifted) > 0 {
+		return fmt.Errorf("%d of %d accounts drifted", len(drifted), len(totals))
+	}
+
+	log.Info().Int("accounts", len(totals)).Msg("all accounts reconciled")
+	return nil
 }
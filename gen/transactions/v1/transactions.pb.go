@@ -9,6 +9,7 @@ package transactionsv1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -20,6 +21,61 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type PendingTransferStatus int32
+
+const (
+	PendingTransferStatus_PendingStatusUnknown PendingTransferStatus = 0
+	PendingTransferStatus_PendingStatusPending PendingTransferStatus = 1
+	PendingTransferStatus_PendingStatusPosted  PendingTransferStatus = 2
+	PendingTransferStatus_PendingStatusVoided  PendingTransferStatus = 3
+	PendingTransferStatus_PendingStatusExpired PendingTransferStatus = 4
+)
+
+// Enum value maps for PendingTransferStatus.
+var (
+	PendingTransferStatus_name = map[int32]string{
+		0: "PendingStatusUnknown",
+		1: "PendingStatusPending",
+		2: "PendingStatusPosted",
+		3: "PendingStatusVoided",
+		4: "PendingStatusExpired",
+	}
+	PendingTransferStatus_value = map[string]int32{
+		"PendingStatusUnknown": 0,
+		"PendingStatusPending": 1,
+		"PendingStatusPosted":  2,
+		"PendingStatusVoided":  3,
+		"PendingStatusExpired": 4,
+	}
+)
+
+func (x PendingTransferStatus) Enum() *PendingTransferStatus {
+	p := new(PendingTransferStatus)
+	*p = x
+	return p
+}
+
+func (x PendingTransferStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PendingTransferStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_transactions_v1_transactions_proto_enumTypes[0].Descriptor()
+}
+
+func (PendingTransferStatus) Type() protoreflect.EnumType {
+	return &file_transactions_v1_transactions_proto_enumTypes[0]
+}
+
+func (x PendingTransferStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PendingTransferStatus.Descriptor instead.
+func (PendingTransferStatus) EnumDescriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{0}
+}
+
 type TransferCode int32
 
 const (
@@ -152,11 +208,11 @@ func (x TransferCode) String() string {
 }
 
 func (TransferCode) Descriptor() protoreflect.EnumDescriptor {
-	return file_transactions_v1_transactions_proto_enumTypes[0].Descriptor()
+	return file_transactions_v1_transactions_proto_enumTypes[1].Descriptor()
 }
 
 func (TransferCode) Type() protoreflect.EnumType {
-	return &file_transactions_v1_transactions_proto_enumTypes[0]
+	return &file_transactions_v1_transactions_proto_enumTypes[1]
 }
 
 func (x TransferCode) Number() protoreflect.EnumNumber {
@@ -165,7 +221,7 @@ func (x TransferCode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TransferCode.Descriptor instead.
 func (TransferCode) EnumDescriptor() ([]byte, []int) {
-	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{0}
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{1}
 }
 
 type Ledger int32
@@ -198,11 +254,11 @@ func (x Ledger) String() string {
 }
 
 func (Ledger) Descriptor() protoreflect.EnumDescriptor {
-	return file_transactions_v1_transactions_proto_enumTypes[1].Descriptor()
+	return file_transactions_v1_transactions_proto_enumTypes[2].Descriptor()
 }
 
 func (Ledger) Type() protoreflect.EnumType {
-	return &file_transactions_v1_transactions_proto_enumTypes[1]
+	return &file_transactions_v1_transactions_proto_enumTypes[2]
 }
 
 func (x Ledger) Number() protoreflect.EnumNumber {
@@ -211,7 +267,7 @@ func (x Ledger) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Ledger.Descriptor instead.
 func (Ledger) EnumDescriptor() ([]byte, []int) {
-	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{1}
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{2}
 }
 
 type AccountCode int32
@@ -253,11 +309,11 @@ func (x AccountCode) String() string {
 }
 
 func (AccountCode) Descriptor() protoreflect.EnumDescriptor {
-	return file_transactions_v1_transactions_proto_enumTypes[2].Descriptor()
+	return file_transactions_v1_transactions_proto_enumTypes[3].Descriptor()
 }
 
 func (AccountCode) Type() protoreflect.EnumType {
-	return &file_transactions_v1_transactions_proto_enumTypes[2]
+	return &file_transactions_v1_transactions_proto_enumTypes[3]
 }
 
 func (x AccountCode) Number() protoreflect.EnumNumber {
@@ -266,7 +322,264 @@ func (x AccountCode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AccountCode.Descriptor instead.
 func (AccountCode) EnumDescriptor() ([]byte, []int) {
-	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{2}
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{3}
+}
+
+type TransferDirection int32
+
+const (
+	TransferDirection_TransferDirectionAny TransferDirection = 0
+	TransferDirection_TransferDirectionIn  TransferDirection = 1
+	TransferDirection_TransferDirectionOut TransferDirection = 2
+)
+
+// Enum value maps for TransferDirection.
+var (
+	TransferDirection_name = map[int32]string{
+		0: "TransferDirectionAny",
+		1: "TransferDirectionIn",
+		2: "TransferDirectionOut",
+	}
+	TransferDirection_value = map[string]int32{
+		"TransferDirectionAny": 0,
+		"TransferDirectionIn":  1,
+		"TransferDirectionOut": 2,
+	}
+)
+
+func (x TransferDirection) Enum() *TransferDirection {
+	p := new(TransferDirection)
+	*p = x
+	return p
+}
+
+func (x TransferDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransferDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_transactions_v1_transactions_proto_enumTypes[4].Descriptor()
+}
+
+func (TransferDirection) Type() protoreflect.EnumType {
+	return &file_transactions_v1_transactions_proto_enumTypes[4]
+}
+
+func (x TransferDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransferDirection.Descriptor instead.
+func (TransferDirection) EnumDescriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{4}
+}
+
+type StatementDirection int32
+
+const (
+	StatementDirection_StatementDirectionUnknown StatementDirection = 0
+	StatementDirection_StatementDirectionCredit  StatementDirection = 1
+	StatementDirection_StatementDirectionDebit   StatementDirection = 2
+)
+
+// Enum value maps for StatementDirection.
+var (
+	StatementDirection_name = map[int32]string{
+		0: "StatementDirectionUnknown",
+		1: "StatementDirectionCredit",
+		2: "StatementDirectionDebit",
+	}
+	StatementDirection_value = map[string]int32{
+		"StatementDirectionUnknown": 0,
+		"StatementDirectionCredit":  1,
+		"StatementDirectionDebit":   2,
+	}
+)
+
+func (x StatementDirection) Enum() *StatementDirection {
+	p := new(StatementDirection)
+	*p = x
+	return p
+}
+
+func (x StatementDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatementDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_transactions_v1_transactions_proto_enumTypes[5].Descriptor()
+}
+
+func (StatementDirection) Type() protoreflect.EnumType {
+	return &file_transactions_v1_transactions_proto_enumTypes[5]
+}
+
+func (x StatementDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatementDirection.Descriptor instead.
+func (StatementDirection) EnumDescriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{5}
+}
+
+type WebhookDeliveryStatus int32
+
+const (
+	WebhookDeliveryStatus_WebhookDeliveryStatusUnknown   WebhookDeliveryStatus = 0
+	WebhookDeliveryStatus_WebhookDeliveryStatusPending   WebhookDeliveryStatus = 1
+	WebhookDeliveryStatus_WebhookDeliveryStatusDelivered WebhookDeliveryStatus = 2
+	WebhookDeliveryStatus_WebhookDeliveryStatusDead      WebhookDeliveryStatus = 3
+)
+
+// Enum value maps for WebhookDeliveryStatus.
+var (
+	WebhookDeliveryStatus_name = map[int32]string{
+		0: "WebhookDeliveryStatusUnknown",
+		1: "WebhookDeliveryStatusPending",
+		2: "WebhookDeliveryStatusDelivered",
+		3: "WebhookDeliveryStatusDead",
+	}
+	WebhookDeliveryStatus_value = map[string]int32{
+		"WebhookDeliveryStatusUnknown":   0,
+		"WebhookDeliveryStatusPending":   1,
+		"WebhookDeliveryStatusDelivered": 2,
+		"WebhookDeliveryStatusDead":      3,
+	}
+)
+
+func (x WebhookDeliveryStatus) Enum() *WebhookDeliveryStatus {
+	p := new(WebhookDeliveryStatus)
+	*p = x
+	return p
+}
+
+func (x WebhookDeliveryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebhookDeliveryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_transactions_v1_transactions_proto_enumTypes[6].Descriptor()
+}
+
+func (WebhookDeliveryStatus) Type() protoreflect.EnumType {
+	return &file_transactions_v1_transactions_proto_enumTypes[6]
+}
+
+func (x WebhookDeliveryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebhookDeliveryStatus.Descriptor instead.
+func (WebhookDeliveryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{6}
+}
+
+// ErrorReason is why a request failed, failed requests carry it in an ErrorDetail so clients don't have to match on messages
+type ErrorReason int32
+
+const (
+	ErrorReason_ErrorReasonUnknown                   ErrorReason = 0
+	ErrorReason_ErrorReasonInvalidArgument           ErrorReason = 1
+	ErrorReason_ErrorReasonInvalidAmount             ErrorReason = 2
+	ErrorReason_ErrorReasonInvalidID                 ErrorReason = 3
+	ErrorReason_ErrorReasonNotFound                  ErrorReason = 4
+	ErrorReason_ErrorReasonAccountNotFound           ErrorReason = 5
+	ErrorReason_ErrorReasonTransactionNotFound       ErrorReason = 6
+	ErrorReason_ErrorReasonPendingTransferNotFound   ErrorReason = 7
+	ErrorReason_ErrorReasonTransferToSelf            ErrorReason = 8
+	ErrorReason_ErrorReasonLedgerMismatch            ErrorReason = 9
+	ErrorReason_ErrorReasonInsufficientFunds         ErrorReason = 10
+	ErrorReason_ErrorReasonDebitAccountFrozen        ErrorReason = 11
+	ErrorReason_ErrorReasonCreditAccountFrozen       ErrorReason = 12
+	ErrorReason_ErrorReasonDebitsDisabled            ErrorReason = 13
+	ErrorReason_ErrorReasonCreditsDisabled           ErrorReason = 14
+	ErrorReason_ErrorReasonRefundExceedsOriginal     ErrorReason = 15
+	ErrorReason_ErrorReasonTransferCodeNotRefundable ErrorReason = 16
+	ErrorReason_ErrorReasonPendingTransferResolved   ErrorReason = 17
+	ErrorReason_ErrorReasonIdempotencyKeyReused      ErrorReason = 18
+	ErrorReason_ErrorReasonAlreadyExists             ErrorReason = 19
+	ErrorReason_ErrorReasonPermissionDenied          ErrorReason = 20
+	ErrorReason_ErrorReasonPendingTransferExpired    ErrorReason = 21
+)
+
+// Enum value maps for ErrorReason.
+var (
+	ErrorReason_name = map[int32]string{
+		0:  "ErrorReasonUnknown",
+		1:  "ErrorReasonInvalidArgument",
+		2:  "ErrorReasonInvalidAmount",
+		3:  "ErrorReasonInvalidID",
+		4:  "ErrorReasonNotFound",
+		5:  "ErrorReasonAccountNotFound",
+		6:  "ErrorReasonTransactionNotFound",
+		7:  "ErrorReasonPendingTransferNotFound",
+		8:  "ErrorReasonTransferToSelf",
+		9:  "ErrorReasonLedgerMismatch",
+		10: "ErrorReasonInsufficientFunds",
+		11: "ErrorReasonDebitAccountFrozen",
+		12: "ErrorReasonCreditAccountFrozen",
+		13: "ErrorReasonDebitsDisabled",
+		14: "ErrorReasonCreditsDisabled",
+		15: "ErrorReasonRefundExceedsOriginal",
+		16: "ErrorReasonTransferCodeNotRefundable",
+		17: "ErrorReasonPendingTransferResolved",
+		18: "ErrorReasonIdempotencyKeyReused",
+		19: "ErrorReasonAlreadyExists",
+		20: "ErrorReasonPermissionDenied",
+		21: "ErrorReasonPendingTransferExpired",
+	}
+	ErrorReason_value = map[string]int32{
+		"ErrorReasonUnknown":                   0,
+		"ErrorReasonInvalidArgument":           1,
+		"ErrorReasonInvalidAmount":             2,
+		"ErrorReasonInvalidID":                 3,
+		"ErrorReasonNotFound":                  4,
+		"ErrorReasonAccountNotFound":           5,
+		"ErrorReasonTransactionNotFound":       6,
+		"ErrorReasonPendingTransferNotFound":   7,
+		"ErrorReasonTransferToSelf":            8,
+		"ErrorReasonLedgerMismatch":            9,
+		"ErrorReasonInsufficientFunds":         10,
+		"ErrorReasonDebitAccountFrozen":        11,
+		"ErrorReasonCreditAccountFrozen":       12,
+		"ErrorReasonDebitsDisabled":            13,
+		"ErrorReasonCreditsDisabled":           14,
+		"ErrorReasonRefundExceedsOriginal":     15,
+		"ErrorReasonTransferCodeNotRefundable": 16,
+		"ErrorReasonPendingTransferResolved":   17,
+		"ErrorReasonIdempotencyKeyReused":      18,
+		"ErrorReasonAlreadyExists":             19,
+		"ErrorReasonPermissionDenied":          20,
+		"ErrorReasonPendingTransferExpired":    21,
+	}
+)
+
+func (x ErrorReason) Enum() *ErrorReason {
+	p := new(ErrorReason)
+	*p = x
+	return p
+}
+
+func (x ErrorReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_transactions_v1_transactions_proto_enumTypes[7].Descriptor()
+}
+
+func (ErrorReason) Type() protoreflect.EnumType {
+	return &file_transactions_v1_transactions_proto_enumTypes[7]
+}
+
+func (x ErrorReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorReason.Descriptor instead.
+func (ErrorReason) EnumDescriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{7}
 }
 
 type Account struct {
@@ -274,14 +587,22 @@ type Account struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id            string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string      `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Ledger        Ledger      `protobuf:"varint,3,opt,name=ledger,proto3,enum=transactions.v1.Ledger" json:"ledger,omitempty"`
-	Code          AccountCode `protobuf:"varint,4,opt,name=code,proto3,enum=transactions.v1.AccountCode" json:"code,omitempty"`
-	DebitsPosted  string      `protobuf:"bytes,5,opt,name=debits_posted,json=debitsPosted,proto3" json:"debits_posted,omitempty"`
-	CreditsPosted string      `protobuf:"bytes,6,opt,name=credits_posted,json=creditsPosted,proto3" json:"credits_posted,omitempty"`
-	Balance       string      `protobuf:"bytes,8,opt,name=balance,proto3" json:"balance,omitempty"`
-	CreatedAt     int64       `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Id                   string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId               string      `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Ledger               Ledger      `protobuf:"varint,3,opt,name=ledger,proto3,enum=transactions.v1.Ledger" json:"ledger,omitempty"`
+	Code                 AccountCode `protobuf:"varint,4,opt,name=code,proto3,enum=transactions.v1.AccountCode" json:"code,omitempty"`
+	DebitsPosted         string      `protobuf:"bytes,5,opt,name=debits_posted,json=debitsPosted,proto3" json:"debits_posted,omitempty"`
+	CreditsPosted        string      `protobuf:"bytes,6,opt,name=credits_posted,json=creditsPosted,proto3" json:"credits_posted,omitempty"`
+	Balance              string      `protobuf:"bytes,8,opt,name=balance,proto3" json:"balance,omitempty"`
+	CreatedAt            int64       `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DebitsPending        string      `protobuf:"bytes,10,opt,name=debits_pending,json=debitsPending,proto3" json:"debits_pending,omitempty"`
+	CreditsPending       string      `protobuf:"bytes,11,opt,name=credits_pending,json=creditsPending,proto3" json:"credits_pending,omitempty"`
+	AllowNegativeBalance bool        `protobuf:"varint,12,opt,name=allow_negative_balance,json=allowNegativeBalance,proto3" json:"allow_negative_balance,omitempty"`
+	OverdraftLimit       string      `protobuf:"bytes,13,opt,name=overdraft_limit,json=overdraftLimit,proto3" json:"overdraft_limit,omitempty"`
+	DebitsDisabled       bool        `protobuf:"varint,14,opt,name=debits_disabled,json=debitsDisabled,proto3" json:"debits_disabled,omitempty"`
+	CreditsDisabled      bool        `protobuf:"varint,15,opt,name=credits_disabled,json=creditsDisabled,proto3" json:"credits_disabled,omitempty"`
+	Frozen               bool        `protobuf:"varint,16,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	FrozenCredits        bool        `protobuf:"varint,17,opt,name=frozen_credits,json=frozenCredits,proto3" json:"frozen_credits,omitempty"`
 }
 
 func (x *Account) Reset() {
@@ -372,24 +693,78 @@ func (x *Account) GetCreatedAt() int64 {
 	return 0
 }
 
-type MigrationTransfer struct {
+func (x *Account) GetDebitsPending() string {
+	if x != nil {
+		return x.DebitsPending
+	}
+	return ""
+}
+
+func (x *Account) GetCreditsPending() string {
+	if x != nil {
+		return x.CreditsPending
+	}
+	return ""
+}
+
+func (x *Account) GetAllowNegativeBalance() bool {
+	if x != nil {
+		return x.AllowNegativeBalance
+	}
+	return false
+}
+
+func (x *Account) GetOverdraftLimit() string {
+	if x != nil {
+		return x.OverdraftLimit
+	}
+	return ""
+}
+
+func (x *Account) GetDebitsDisabled() bool {
+	if x != nil {
+		return x.DebitsDisabled
+	}
+	return false
+}
+
+func (x *Account) GetCreditsDisabled() bool {
+	if x != nil {
+		return x.CreditsDisabled
+	}
+	return false
+}
+
+func (x *Account) GetFrozen() bool {
+	if x != nil {
+		return x.Frozen
+	}
+	return false
+}
+
+func (x *Account) GetFrozenCredits() bool {
+	if x != nil {
+		return x.FrozenCredits
+	}
+	return false
+}
+
+type AccountFreeze struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id              string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreditUserId    string       `protobuf:"bytes,2,opt,name=credit_user_id,json=creditUserId,proto3" json:"credit_user_id,omitempty"`
-	CreditAccountId string       `protobuf:"bytes,3,opt,name=credit_account_id,json=creditAccountId,proto3" json:"credit_account_id,omitempty"`
-	DebitUserId     string       `protobuf:"bytes,4,opt,name=debit_user_id,json=debitUserId,proto3" json:"debit_user_id,omitempty"`
-	DebitAccountId  string       `protobuf:"bytes,5,opt,name=debit_account_id,json=debitAccountId,proto3" json:"debit_account_id,omitempty"`
-	Amount          string       `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
-	Ledger          Ledger       `protobuf:"varint,7,opt,name=ledger,proto3,enum=transactions.v1.Ledger" json:"ledger,omitempty"`
-	Code            TransferCode `protobuf:"varint,8,opt,name=code,proto3,enum=transactions.v1.TransferCode" json:"code,omitempty"`
-	Timestamp       int64        `protobuf:"varint,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AccountId     string `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Frozen        bool   `protobuf:"varint,3,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	FrozenCredits bool   `protobuf:"varint,4,opt,name=frozen_credits,json=frozenCredits,proto3" json:"frozen_credits,omitempty"`
+	Reason        string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Actor         string `protobuf:"bytes,6,opt,name=actor,proto3" json:"actor,omitempty"`
+	CreatedAt     int64  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
-func (x *MigrationTransfer) Reset() {
-	*x = MigrationTransfer{}
+func (x *AccountFreeze) Reset() {
+	*x = AccountFreeze{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_transactions_v1_transactions_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -397,13 +772,13 @@ func (x *MigrationTransfer) Reset() {
 	}
 }
 
-func (x *MigrationTransfer) String() string {
+func (x *AccountFreeze) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MigrationTransfer) ProtoMessage() {}
+func (*AccountFreeze) ProtoMessage() {}
 
-func (x *MigrationTransfer) ProtoReflect() protoreflect.Message {
+func (x *AccountFreeze) ProtoReflect() protoreflect.Message {
 	mi := &file_transactions_v1_transactions_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -415,75 +790,61 @@ func (x *MigrationTransfer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MigrationTransfer.ProtoReflect.Descriptor instead.
-func (*MigrationTransfer) Descriptor() ([]byte, []int) {
+// Deprecated: Use AccountFreeze.ProtoReflect.Descriptor instead.
+func (*AccountFreeze) Descriptor() ([]byte, []int) {
 	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *MigrationTransfer) GetId() string {
+func (x *AccountFreeze) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *MigrationTransfer) GetCreditUserId() string {
+func (x *AccountFreeze) GetAccountId() string {
 	if x != nil {
-		return x.CreditUserId
+		return x.AccountId
 	}
 	return ""
 }
 
-func (x *MigrationTransfer) GetCreditAccountId() string {
+func (x *AccountFreeze) GetFrozen() bool {
 	if x != nil {
-		return x.CreditAccountId
+		return x.Frozen
 	}
-	return ""
+	return false
 }
 
-func (x *MigrationTransfer) GetDebitUserId() string {
+func (x *AccountFreeze) GetFrozenCredits() bool {
 	if x != nil {
-		return x.DebitUserId
+		return x.FrozenCredits
 	}
-	return ""
+	return false
 }
 
-func (x *MigrationTransfer) GetDebitAccountId() string {
+func (x *AccountFreeze) GetReason() string {
 	if x != nil {
-		return x.DebitAccountId
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *MigrationTransfer) GetAmount() string {
+func (x *AccountFreeze) GetActor() string {
 	if x != nil {
-		return x.Amount
+		return x.Actor
 	}
 	return ""
 }
 
-func (x *MigrationTransfer) GetLedger() Ledger {
-	if x != nil {
-		return x.Ledger
-	}
-	return Ledger_UnusedLedgerCode
-}
-
-func (x *MigrationTransfer) GetCode() TransferCode {
-	if x != nil {
-		return x.Code
-	}
-	return TransferCode_UnusedTransferCode
-}
-
-func (x *MigrationTransfer) GetTimestamp() int64 {
+func (x *AccountFreeze) GetCreatedAt() int64 {
 	if x != nil {
-		return x.Timestamp
+		return x.CreatedAt
 	}
 	return 0
 }
 
-type CompletedTransfer struct {
+type MigrationTransfer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -499,8 +860,8 @@ type CompletedTransfer struct {
 	Timestamp       int64        `protobuf:"varint,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
-func (x *CompletedTransfer) Reset() {
-	*x = CompletedTransfer{}
+func (x *MigrationTransfer) Reset() {
+	*x = MigrationTransfer{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_transactions_v1_transactions_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -508,13 +869,13 @@ func (x *CompletedTransfer) Reset() {
 	}
 }
 
-func (x *CompletedTransfer) String() string {
+func (x *MigrationTransfer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CompletedTransfer) ProtoMessage() {}
+func (*MigrationTransfer) ProtoMessage() {}
 
-func (x *CompletedTransfer) ProtoReflect() protoreflect.Message {
+func (x *MigrationTransfer) ProtoReflect() protoreflect.Message {
 	mi := &file_transactions_v1_transactions_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -526,86 +887,100 @@ func (x *CompletedTransfer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CompletedTransfer.ProtoReflect.Descriptor instead.
-func (*CompletedTransfer) Descriptor() ([]byte, []int) {
+// Deprecated: Use MigrationTransfer.ProtoReflect.Descriptor instead.
+func (*MigrationTransfer) Descriptor() ([]byte, []int) {
 	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *CompletedTransfer) GetId() string {
+func (x *MigrationTransfer) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *CompletedTransfer) GetCreditUserId() string {
+func (x *MigrationTransfer) GetCreditUserId() string {
 	if x != nil {
 		return x.CreditUserId
 	}
 	return ""
 }
 
-func (x *CompletedTransfer) GetCreditAccountId() string {
+func (x *MigrationTransfer) GetCreditAccountId() string {
 	if x != nil {
 		return x.CreditAccountId
 	}
 	return ""
 }
 
-func (x *CompletedTransfer) GetDebitUserId() string {
+func (x *MigrationTransfer) GetDebitUserId() string {
 	if x != nil {
 		return x.DebitUserId
 	}
 	return ""
 }
 
-func (x *CompletedTransfer) GetDebitAccountId() string {
+func (x *MigrationTransfer) GetDebitAccountId() string {
 	if x != nil {
 		return x.DebitAccountId
 	}
 	return ""
 }
 
-func (x *CompletedTransfer) GetAmount() string {
+func (x *MigrationTransfer) GetAmount() string {
 	if x != nil {
 		return x.Amount
 	}
 	return ""
 }
 
-func (x *CompletedTransfer) GetLedger() Ledger {
+func (x *MigrationTransfer) GetLedger() Ledger {
 	if x != nil {
 		return x.Ledger
 	}
 	return Ledger_UnusedLedgerCode
 }
 
-func (x *CompletedTransfer) GetCode() TransferCode {
+func (x *MigrationTransfer) GetCode() TransferCode {
 	if x != nil {
 		return x.Code
 	}
 	return TransferCode_UnusedTransferCode
 }
 
-func (x *CompletedTransfer) GetTimestamp() int64 {
+func (x *MigrationTransfer) GetTimestamp() int64 {
 	if x != nil {
 		return x.Timestamp
 	}
 	return 0
 }
 
-type AccountGetViaUserRequest struct {
+type CompletedTransfer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId            string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Ledger            Ledger `protobuf:"varint,2,opt,name=ledger,proto3,enum=transactions.v1.Ledger" json:"ledger,omitempty"`
-	CreateIfNotExists bool   `protobuf:"varint,3,opt,name=create_if_not_exists,json=createIfNotExists,proto3" json:"create_if_not_exists,omitempty"`
+	Id                  string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreditUserId        string       `protobuf:"bytes,2,opt,name=credit_user_id,json=creditUserId,proto3" json:"credit_user_id,omitempty"`
+	CreditAccountId     string       `protobuf:"bytes,3,opt,name=credit_account_id,json=creditAccountId,proto3" json:"credit_account_id,omitempty"`
+	DebitUserId         string       `protobuf:"bytes,4,opt,name=debit_user_id,json=debitUserId,proto3" json:"debit_user_id,omitempty"`
+	DebitAccountId      string       `protobuf:"bytes,5,opt,name=debit_account_id,json=debitAccountId,proto3" json:"debit_account_id,omitempty"`
+	Amount              string       `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Ledger              Ledger       `protobuf:"varint,7,opt,name=ledger,proto3,enum=transactions.v1.Ledger" json:"ledger,omitempty"`
+	Code                TransferCode `protobuf:"varint,8,opt,name=code,proto3,enum=transactions.v1.TransferCode" json:"code,omitempty"`
+	Timestamp           int64        `protobuf:"varint,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	ParentTransactionId string       `protobuf:"bytes,10,opt,name=parent_transaction_id,json=parentTransactionId,proto3" json:"parent_transaction_id,omitempty"`
+	// sequence increases with every committed transfer
+	Sequence int64 `protobuf:"varint,11,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// initiator_id is the id of the api client that made the transfer, empty for transfers made before clients were recorded
+	InitiatorId string `protobuf:"bytes,12,opt,name=initiator_id,json=initiatorId,proto3" json:"initiator_id,omitempty"`
+	// reference_id is the caller's id of what the transfer is for e.g. a battle, item or order
+	ReferenceId string           `protobuf:"bytes,13,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	Metadata    *structpb.Struct `protobuf:"bytes,14,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
-func (x *AccountGetViaUserRequest) Reset() {
-	*x = AccountGetViaUserRequest{}
+func (x *CompletedTransfer) Reset() {
+	*x = CompletedTransfer{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_transactions_v1_transactions_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -613,13 +988,13 @@ func (x *AccountGetViaUserRequest) Reset() {
 	}
 }
 
-func (x *AccountGetViaUserRequest) String() string {
+func (x *CompletedTransfer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccountGetViaUserRequest) ProtoMessage() {}
+func (*CompletedTransfer) ProtoMessage() {}
 
-func (x *AccountGetViaUserRequest) ProtoReflect() protoreflect.Message {
+func (x *CompletedTransfer) ProtoReflect() protoreflect.Message {
 	mi := &file_transactions_v1_transactions_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -631,42 +1006,134 @@ func (x *AccountGetViaUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AccountGetViaUserRequest.ProtoReflect.Descriptor instead.
-func (*AccountGetViaUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CompletedTransfer.ProtoReflect.Descriptor instead.
+func (*CompletedTransfer) Descriptor() ([]byte, []int) {
 	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *AccountGetViaUserRequest) GetUserId() string {
+func (x *CompletedTransfer) GetId() string {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *AccountGetViaUserRequest) GetLedger() Ledger {
+func (x *CompletedTransfer) GetCreditUserId() string {
+	if x != nil {
+		return x.CreditUserId
+	}
+	return ""
+}
+
+func (x *CompletedTransfer) GetCreditAccountId() string {
+	if x != nil {
+		return x.CreditAccountId
+	}
+	return ""
+}
+
+func (x *CompletedTransfer) GetDebitUserId() string {
+	if x != nil {
+		return x.DebitUserId
+	}
+	return ""
+}
+
+func (x *CompletedTransfer) GetDebitAccountId() string {
+	if x != nil {
+		return x.DebitAccountId
+	}
+	return ""
+}
+
+func (x *CompletedTransfer) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *CompletedTransfer) GetLedger() Ledger {
 	if x != nil {
 		return x.Ledger
 	}
 	return Ledger_UnusedLedgerCode
 }
 
-func (x *AccountGetViaUserRequest) GetCreateIfNotExists() bool {
+func (x *CompletedTransfer) GetCode() TransferCode {
 	if x != nil {
-		return x.CreateIfNotExists
+		return x.Code
 	}
-	return false
+	return TransferCode_UnusedTransferCode
 }
 
-type AccountGetViaUserResponse struct {
+func (x *CompletedTransfer) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *CompletedTransfer) GetParentTransactionId() string {
+	if x != nil {
+		return x.ParentTransactionId
+	}
+	return ""
+}
+
+func (x *CompletedTransfer) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *CompletedTransfer) GetInitiatorId() string {
+	if x != nil {
+		return x.InitiatorId
+	}
+	return ""
+}
+
+func (x *CompletedTransfer) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *CompletedTransfer) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type PendingTransfer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-}
-
-func (x *AccountGetViaUserResponse) Reset() {
-	*x = AccountGetViaUserResponse{}
+	Id              string                `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreditUserId    string                `protobuf:"bytes,2,opt,name=credit_user_id,json=creditUserId,proto3" json:"credit_user_id,omitempty"`
+	CreditAccountId string                `protobuf:"bytes,3,opt,name=credit_account_id,json=creditAccountId,proto3" json:"credit_account_id,omitempty"`
+	DebitUserId     string                `protobuf:"bytes,4,opt,name=debit_user_id,json=debitUserId,proto3" json:"debit_user_id,omitempty"`
+	DebitAccountId  string                `protobuf:"bytes,5,opt,name=debit_account_id,json=debitAccountId,proto3" json:"debit_account_id,omitempty"`
+	Amount          string                `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Ledger          Ledger                `protobuf:"varint,7,opt,name=ledger,proto3,enum=transactions.v1.Ledger" json:"ledger,omitempty"`
+	Code            TransferCode          `protobuf:"varint,8,opt,name=code,proto3,enum=transactions.v1.TransferCode" json:"code,omitempty"`
+	Status          PendingTransferStatus `protobuf:"varint,9,opt,name=status,proto3,enum=transactions.v1.PendingTransferStatus" json:"status,omitempty"`
+	TransactionId   string                `protobuf:"bytes,10,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	ExpiresAt       int64                 `protobuf:"varint,11,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt       int64                 `protobuf:"varint,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// the initiator, reference and metadata are carried over to the transaction when the transfer is posted
+	InitiatorId string           `protobuf:"bytes,13,opt,name=initiator_id,json=initiatorId,proto3" json:"initiator_id,omitempty"`
+	ReferenceId string           `protobuf:"bytes,14,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	Metadata    *structpb.Struct `protobuf:"bytes,15,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *PendingTransfer) Reset() {
+	*x = PendingTransfer{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_transactions_v1_transactions_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -674,13 +1141,13 @@ func (x *AccountGetViaUserResponse) Reset() {
 	}
 }
 
-func (x *AccountGetViaUserResponse) String() string {
+func (x *PendingTransfer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccountGetViaUserResponse) ProtoMessage() {}
+func (*PendingTransfer) ProtoMessage() {}
 
-func (x *AccountGetViaUserResponse) ProtoReflect() protoreflect.Message {
+func (x *PendingTransfer) ProtoReflect() protoreflect.Message {
 	mi := &file_transactions_v1_transactions_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -692,29 +1159,128 @@ func (x *AccountGetViaUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AccountGetViaUserResponse.ProtoReflect.Descriptor instead.
-func (*AccountGetViaUserResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PendingTransfer.ProtoReflect.Descriptor instead.
+func (*PendingTransfer) Descriptor() ([]byte, []int) {
 	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *AccountGetViaUserResponse) GetAccount() *Account {
+func (x *PendingTransfer) GetId() string {
 	if x != nil {
-		return x.Account
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PendingTransfer) GetCreditUserId() string {
+	if x != nil {
+		return x.CreditUserId
+	}
+	return ""
+}
+
+func (x *PendingTransfer) GetCreditAccountId() string {
+	if x != nil {
+		return x.CreditAccountId
+	}
+	return ""
+}
+
+func (x *PendingTransfer) GetDebitUserId() string {
+	if x != nil {
+		return x.DebitUserId
+	}
+	return ""
+}
+
+func (x *PendingTransfer) GetDebitAccountId() string {
+	if x != nil {
+		return x.DebitAccountId
+	}
+	return ""
+}
+
+func (x *PendingTransfer) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *PendingTransfer) GetLedger() Ledger {
+	if x != nil {
+		return x.Ledger
+	}
+	return Ledger_UnusedLedgerCode
+}
+
+func (x *PendingTransfer) GetCode() TransferCode {
+	if x != nil {
+		return x.Code
+	}
+	return TransferCode_UnusedTransferCode
+}
+
+func (x *PendingTransfer) GetStatus() PendingTransferStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PendingTransferStatus_PendingStatusUnknown
+}
+
+func (x *PendingTransfer) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *PendingTransfer) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *PendingTransfer) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *PendingTransfer) GetInitiatorId() string {
+	if x != nil {
+		return x.InitiatorId
+	}
+	return ""
+}
+
+func (x *PendingTransfer) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *PendingTransfer) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
 	}
 	return nil
 }
 
-type AccountsUserRequest struct {
+type AccountGetViaUserRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId           string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	CreateIfNotExist []Ledger `protobuf:"varint,2,rep,packed,name=create_if_not_exist,json=createIfNotExist,proto3,enum=transactions.v1.Ledger" json:"create_if_not_exist,omitempty"`
+	UserId            string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Ledger            Ledger `protobuf:"varint,2,opt,name=ledger,proto3,enum=transactions.v1.Ledger" json:"ledger,omitempty"`
+	CreateIfNotExists bool   `protobuf:"varint,3,opt,name=create_if_not_exists,json=createIfNotExists,proto3" json:"create_if_not_exists,omitempty"`
 }
 
-func (x *AccountsUserRequest) Reset() {
-	*x = AccountsUserRequest{}
+func (x *AccountGetViaUserRequest) Reset() {
+	*x = AccountGetViaUserRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_transactions_v1_transactions_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -722,13 +1288,13 @@ func (x *AccountsUserRequest) Reset() {
 	}
 }
 
-func (x *AccountsUserRequest) String() string {
+func (x *AccountGetViaUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccountsUserRequest) ProtoMessage() {}
+func (*AccountGetViaUserRequest) ProtoMessage() {}
 
-func (x *AccountsUserRequest) ProtoReflect() protoreflect.Message {
+func (x *AccountGetViaUserRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_transactions_v1_transactions_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -740,35 +1306,42 @@ func (x *AccountsUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AccountsUserRequest.ProtoReflect.Descriptor instead.
-func (*AccountsUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AccountGetViaUserRequest.ProtoReflect.Descriptor instead.
+func (*AccountGetViaUserRequest) Descriptor() ([]byte, []int) {
 	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *AccountsUserRequest) GetUserId() string {
+func (x *AccountGetViaUserRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *AccountsUserRequest) GetCreateIfNotExist() []Ledger {
+func (x *AccountGetViaUserRequest) GetLedger() Ledger {
 	if x != nil {
-		return x.CreateIfNotExist
+		return x.Ledger
 	}
-	return nil
+	return Ledger_UnusedLedgerCode
 }
 
-type AccountsUserResponse struct {
+func (x *AccountGetViaUserRequest) GetCreateIfNotExists() bool {
+	if x != nil {
+		return x.CreateIfNotExists
+	}
+	return false
+}
+
+type AccountGetViaUserResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Accounts []*Account `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-func (x *AccountsUserResponse) Reset() {
-	*x = AccountsUserResponse{}
+func (x *AccountGetViaUserResponse) Reset() {
+	*x = AccountGetViaUserResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_transactions_v1_transactions_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -776,13 +1349,13 @@ func (x *AccountsUserResponse) Reset() {
 	}
 }
 
-func (x *AccountsUserResponse) String() string {
+func (x *AccountGetViaUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccountsUserResponse) ProtoMessage() {}
+func (*AccountGetViaUserResponse) ProtoMessage() {}
 
-func (x *AccountsUserResponse) ProtoReflect() protoreflect.Message {
+func (x *AccountGetViaUserResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_transactions_v1_transactions_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -794,9 +1367,111 @@ func (x *AccountsUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
+// Deprecated: Use AccountGetViaUserResponse.ProtoReflect.Descriptor instead.
+func (*AccountGetViaUserResponse) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AccountGetViaUserResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type AccountsUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId           string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CreateIfNotExist []Ledger `protobuf:"varint,2,rep,packed,name=create_if_not_exist,json=createIfNotExist,proto3,enum=transactions.v1.Ledger" json:"create_if_not_exist,omitempty"`
+}
+
+func (x *AccountsUserRequest) Reset() {
+	*x = AccountsUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transactions_v1_transactions_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountsUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountsUserRequest) ProtoMessage() {}
+
+func (x *AccountsUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountsUserRequest.ProtoReflect.Descriptor instead.
+func (*AccountsUserRequest) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AccountsUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AccountsUserRequest) GetCreateIfNotExist() []Ledger {
+	if x != nil {
+		return x.CreateIfNotExist
+	}
+	return nil
+}
+
+type AccountsUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Accounts []*Account `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+}
+
+func (x *AccountsUserResponse) Reset() {
+	*x = AccountsUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transactions_v1_transactions_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountsUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountsUserResponse) ProtoMessage() {}
+
+func (x *AccountsUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
 // Deprecated: Use AccountsUserResponse.ProtoReflect.Descriptor instead.
 func (*AccountsUserResponse) Descriptor() ([]byte, []int) {
-	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{6}
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *AccountsUserResponse) GetAccounts() []*Account {
@@ -819,7 +1494,7 @@ type GetBalanceRequest struct {
 func (x *GetBalanceRequest) Reset() {
 	*x = GetBalanceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_transactions_v1_transactions_proto_msgTypes[7]
+		mi := &file_transactions_v1_transactions_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -832,7 +1507,7 @@ func (x *GetBalanceRequest) String() string {
 func (*GetBalanceRequest) ProtoMessage() {}
 
 func (x *GetBalanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_transactions_v1_transactions_proto_msgTypes[7]
+	mi := &file_transactions_v1_transactions_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -845,7 +1520,7 @@ func (x *GetBalanceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBalanceRequest.ProtoReflect.Descriptor instead.
 func (*GetBalanceRequest) Descriptor() ([]byte, []int) {
-	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{7}
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetBalanceRequest) GetUserId() string {
@@ -880,7 +1555,7 @@ type GetBalanceResponse struct {
 func (x *GetBalanceResponse) Reset() {
 	*x = GetBalanceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_transactions_v1_transactions_proto_msgTypes[8]
+		mi := &file_transactions_v1_transactions_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -893,7 +1568,7 @@ func (x *GetBalanceResponse) String() string {
 func (*GetBalanceResponse) ProtoMessage() {}
 
 func (x *GetBalanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_transactions_v1_transactions_proto_msgTypes[8]
+	mi := &file_transactions_v1_transactions_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -906,7 +1581,7 @@ func (x *GetBalanceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBalanceResponse.ProtoReflect.Descriptor instead.
 func (*GetBalanceResponse) Descriptor() ([]byte, []int) {
-	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{8}
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetBalanceResponse) GetBalance() string {
@@ -916,6 +1591,116 @@ func (x *GetBalanceResponse) GetBalance() string {
 	return ""
 }
 
+type GetBalanceAtRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Ledger    Ledger `protobuf:"varint,2,opt,name=ledger,proto3,enum=transactions.v1.Ledger" json:"ledger,omitempty"`
+	Timestamp int64  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *GetBalanceAtRequest) Reset() {
+	*x = GetBalanceAtRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transactions_v1_transactions_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBalanceAtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceAtRequest) ProtoMessage() {}
+
+func (x *GetBalanceAtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceAtRequest.ProtoReflect.Descriptor instead.
+func (*GetBalanceAtRequest) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetBalanceAtRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetBalanceAtRequest) GetLedger() Ledger {
+	if x != nil {
+		return x.Ledger
+	}
+	return Ledger_UnusedLedgerCode
+}
+
+func (x *GetBalanceAtRequest) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type GetBalanceAtResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Balance string `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`
+}
+
+func (x *GetBalanceAtResponse) Reset() {
+	*x = GetBalanceAtResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transactions_v1_transactions_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBalanceAtResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBalanceAtResponse) ProtoMessage() {}
+
+func (x *GetBalanceAtResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBalanceAtResponse.ProtoReflect.Descriptor instead.
+func (*GetBalanceAtResponse) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetBalanceAtResponse) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
 type TransactionGetByIDRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -927,7 +1712,7 @@ type TransactionGetByIDRequest struct {
 func (x *TransactionGetByIDRequest) Reset() {
 	*x = TransactionGetByIDRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_transactions_v1_transactions_proto_msgTypes[9]
+		mi := &file_transactions_v1_transactions_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -940,7 +1725,7 @@ func (x *TransactionGetByIDRequest) String() string {
 func (*TransactionGetByIDRequest) ProtoMessage() {}
 
 func (x *TransactionGetByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_transactions_v1_transactions_proto_msgTypes[9]
+	mi := &file_transactions_v1_transactions_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -953,7 +1738,7 @@ func (x *TransactionGetByIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransactionGetByIDRequest.ProtoReflect.Descriptor instead.
 func (*TransactionGetByIDRequest) Descriptor() ([]byte, []int) {
-	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{9}
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *TransactionGetByIDRequest) GetTransactionId() string {
@@ -968,13 +1753,14 @@ type TransactionGetByIDResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Transaction *CompletedTransfer `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	Transaction *CompletedTransfer   `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	Refunds     []*CompletedTransfer `protobuf:"bytes,2,rep,name=refunds,proto3" json:"refunds,omitempty"`
 }
 
 func (x *TransactionGetByIDResponse) Reset() {
 	*x = TransactionGetByIDResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_transactions_v1_transactions_proto_msgTypes[10]
+		mi := &file_transactions_v1_transactions_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -987,7 +1773,7 @@ func (x *TransactionGetByIDResponse) String() string {
 func (*TransactionGetByIDResponse) ProtoMessage() {}
 
 func (x *TransactionGetByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_transactions_v1_transactions_proto_msgTypes[10]
+	mi := &file_transactions_v1_transactions_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1000,7 +1786,7 @@ func (x *TransactionGetByIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransactionGetByIDResponse.ProtoReflect.Descriptor instead.
 func (*TransactionGetByIDResponse) Descriptor() ([]byte, []int) {
-	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{10}
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *TransactionGetByIDResponse) GetTransaction() *CompletedTransfer {
@@ -1010,6 +1796,14 @@ func (x *TransactionGetByIDResponse) GetTransaction() *CompletedTransfer {
 	return nil
 }
 
+func (x *TransactionGetByIDResponse) GetRefunds() []*CompletedTransfer {
+	if x != nil {
+		return x.Refunds
+	}
+	return nil
+}
+
+// TransactionsGetByAccountIDRequest pages with page_token when sorting by created_at, offset is only used without a page token
 type TransactionsGetByAccountIDRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1017,15 +1811,31 @@ type TransactionsGetByAccountIDRequest struct {
 
 	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
 	Offset    int32  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// page_size defaults to 50 and can be at most 1000
 	PageSize  int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	SortBy    string `protobuf:"bytes,4,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
 	SortDir   string `protobuf:"bytes,5,opt,name=sort_dir,json=sortDir,proto3" json:"sort_dir,omitempty"`
+	PageToken string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// skip_total skips counting the matching transactions, total is returned as -1
+	SkipTotal bool `protobuf:"varint,7,opt,name=skip_total,json=skipTotal,proto3" json:"skip_total,omitempty"`
+	// from and to are unix timestamps, from is inclusive and to is exclusive, zero leaves the range open
+	From                  int64             `protobuf:"varint,8,opt,name=from,proto3" json:"from,omitempty"`
+	To                    int64             `protobuf:"varint,9,opt,name=to,proto3" json:"to,omitempty"`
+	Codes                 []TransferCode    `protobuf:"varint,10,rep,packed,name=codes,proto3,enum=transactions.v1.TransferCode" json:"codes,omitempty"`
+	Direction             TransferDirection `protobuf:"varint,11,opt,name=direction,proto3,enum=transactions.v1.TransferDirection" json:"direction,omitempty"`
+	CounterpartyAccountId string            `protobuf:"bytes,12,opt,name=counterparty_account_id,json=counterpartyAccountId,proto3" json:"counterparty_account_id,omitempty"`
+	AmountMin             string            `protobuf:"bytes,13,opt,name=amount_min,json=amountMin,proto3" json:"amount_min,omitempty"`
+	AmountMax             string            `protobuf:"bytes,14,opt,name=amount_max,json=amountMax,proto3" json:"amount_max,omitempty"`
+	InitiatorId           string            `protobuf:"bytes,15,opt,name=initiator_id,json=initiatorId,proto3" json:"initiator_id,omitempty"`
+	ReferenceId           string            `protobuf:"bytes,16,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	// metadata matches the transactions whose metadata contains it
+	Metadata *structpb.Struct `protobuf:"bytes,17,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
 func (x *TransactionsGetByAccountIDRequest) Reset() {
 	*x = TransactionsGetByAccountIDRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_transactions_v1_transactions_proto_msgTypes[11]
+		mi := &file_transactions_v1_transactions_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1038,7 +1848,7 @@ func (x *TransactionsGetByAccountIDRequest) String() string {
 func (*TransactionsGetByAccountIDRequest) ProtoMessage() {}
 
 func (x *TransactionsGetByAccountIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_transactions_v1_transactions_proto_msgTypes[11]
+	mi := &file_transactions_v1_transactions_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1051,7 +1861,7 @@ func (x *TransactionsGetByAccountIDRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use TransactionsGetByAccountIDRequest.ProtoReflect.Descriptor instead.
 func (*TransactionsGetByAccountIDRequest) Descriptor() ([]byte, []int) {
-	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{11}
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *TransactionsGetByAccountIDRequest) GetAccountId() string {
@@ -1089,32 +1899,117 @@ func (x *TransactionsGetByAccountIDRequest) GetSortDir() string {
 	return ""
 }
 
-type TransactionsGetByAccountIDResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *TransactionsGetByAccountIDRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
 
-	Total        int64                `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
-	Transactions []*CompletedTransfer `protobuf:"bytes,2,rep,name=transactions,proto3" json:"transactions,omitempty"`
+func (x *TransactionsGetByAccountIDRequest) GetSkipTotal() bool {
+	if x != nil {
+		return x.SkipTotal
+	}
+	return false
 }
 
-func (x *TransactionsGetByAccountIDResponse) Reset() {
-	*x = TransactionsGetByAccountIDResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_transactions_v1_transactions_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *TransactionsGetByAccountIDRequest) GetFrom() int64 {
+	if x != nil {
+		return x.From
 	}
+	return 0
 }
 
-func (x *TransactionsGetByAccountIDResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TransactionsGetByAccountIDRequest) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
 }
 
-func (*TransactionsGetByAccountIDResponse) ProtoMessage() {}
+func (x *TransactionsGetByAccountIDRequest) GetCodes() []TransferCode {
+	if x != nil {
+		return x.Codes
+	}
+	return nil
+}
 
-func (x *TransactionsGetByAccountIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_transactions_v1_transactions_proto_msgTypes[12]
+func (x *TransactionsGetByAccountIDRequest) GetDirection() TransferDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return TransferDirection_TransferDirectionAny
+}
+
+func (x *TransactionsGetByAccountIDRequest) GetCounterpartyAccountId() string {
+	if x != nil {
+		return x.CounterpartyAccountId
+	}
+	return ""
+}
+
+func (x *TransactionsGetByAccountIDRequest) GetAmountMin() string {
+	if x != nil {
+		return x.AmountMin
+	}
+	return ""
+}
+
+func (x *TransactionsGetByAccountIDRequest) GetAmountMax() string {
+	if x != nil {
+		return x.AmountMax
+	}
+	return ""
+}
+
+func (x *TransactionsGetByAccountIDRequest) GetInitiatorId() string {
+	if x != nil {
+		return x.InitiatorId
+	}
+	return ""
+}
+
+func (x *TransactionsGetByAccountIDRequest) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *TransactionsGetByAccountIDRequest) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type TransactionsGetByAccountIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total         int64                `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Transactions  []*CompletedTransfer `protobuf:"bytes,2,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	NextPageToken string               `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *TransactionsGetByAccountIDResponse) Reset() {
+	*x = TransactionsGetByAccountIDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transactions_v1_transactions_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionsGetByAccountIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionsGetByAccountIDResponse) ProtoMessage() {}
+
+func (x *TransactionsGetByAccountIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1127,7 +2022,7 @@ func (x *TransactionsGetByAccountIDResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use TransactionsGetByAccountIDResponse.ProtoReflect.Descriptor instead.
 func (*TransactionsGetByAccountIDResponse) Descriptor() ([]byte, []int) {
-	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{12}
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *TransactionsGetByAccountIDResponse) GetTotal() int64 {
@@ -1144,36 +2039,43 @@ func (x *TransactionsGetByAccountIDResponse) GetTransactions() []*CompletedTrans
 	return nil
 }
 
-type TransactWithIDRequest struct {
+func (x *TransactionsGetByAccountIDResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// StatementGetRequest covers transfers after from and up to and including to, both unix timestamps.
+// A range with more than 10000 transfers fails with failed_precondition, split it into shorter ones.
+type StatementGetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreditUserId string       `protobuf:"bytes,1,opt,name=credit_user_id,json=creditUserId,proto3" json:"credit_user_id,omitempty"`
-	DebitUserId  string       `protobuf:"bytes,2,opt,name=debit_user_id,json=debitUserId,proto3" json:"debit_user_id,omitempty"`
-	Code         TransferCode `protobuf:"varint,3,opt,name=code,proto3,enum=transactions.v1.TransferCode" json:"code,omitempty"`
-	Ledger       Ledger       `protobuf:"varint,4,opt,name=ledger,proto3,enum=transactions.v1.Ledger" json:"ledger,omitempty"`
-	Amount       string       `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
-	TxId         string       `protobuf:"bytes,6,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Ledger Ledger `protobuf:"varint,2,opt,name=ledger,proto3,enum=transactions.v1.Ledger" json:"ledger,omitempty"`
+	From   int64  `protobuf:"varint,3,opt,name=from,proto3" json:"from,omitempty"`
+	To     int64  `protobuf:"varint,4,opt,name=to,proto3" json:"to,omitempty"`
 }
 
-func (x *TransactWithIDRequest) Reset() {
-	*x = TransactWithIDRequest{}
+func (x *StatementGetRequest) Reset() {
+	*x = StatementGetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_transactions_v1_transactions_proto_msgTypes[13]
+		mi := &file_transactions_v1_transactions_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TransactWithIDRequest) String() string {
+func (x *StatementGetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransactWithIDRequest) ProtoMessage() {}
+func (*StatementGetRequest) ProtoMessage() {}
 
-func (x *TransactWithIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_transactions_v1_transactions_proto_msgTypes[13]
+func (x *StatementGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1184,78 +2086,64 @@ func (x *TransactWithIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransactWithIDRequest.ProtoReflect.Descriptor instead.
-func (*TransactWithIDRequest) Descriptor() ([]byte, []int) {
-	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *TransactWithIDRequest) GetCreditUserId() string {
-	if x != nil {
-		return x.CreditUserId
-	}
-	return ""
+// Deprecated: Use StatementGetRequest.ProtoReflect.Descriptor instead.
+func (*StatementGetRequest) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *TransactWithIDRequest) GetDebitUserId() string {
+func (x *StatementGetRequest) GetUserId() string {
 	if x != nil {
-		return x.DebitUserId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *TransactWithIDRequest) GetCode() TransferCode {
-	if x != nil {
-		return x.Code
-	}
-	return TransferCode_UnusedTransferCode
-}
-
-func (x *TransactWithIDRequest) GetLedger() Ledger {
+func (x *StatementGetRequest) GetLedger() Ledger {
 	if x != nil {
 		return x.Ledger
 	}
 	return Ledger_UnusedLedgerCode
 }
 
-func (x *TransactWithIDRequest) GetAmount() string {
+func (x *StatementGetRequest) GetFrom() int64 {
 	if x != nil {
-		return x.Amount
+		return x.From
 	}
-	return ""
+	return 0
 }
 
-func (x *TransactWithIDRequest) GetTxId() string {
+func (x *StatementGetRequest) GetTo() int64 {
 	if x != nil {
-		return x.TxId
+		return x.To
 	}
-	return ""
+	return 0
 }
 
-type TransactWithIDResponse struct {
+type StatementGetResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Transfer *CompletedTransfer `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	Statement *Statement `protobuf:"bytes,1,opt,name=statement,proto3" json:"statement,omitempty"`
 }
 
-func (x *TransactWithIDResponse) Reset() {
-	*x = TransactWithIDResponse{}
+func (x *StatementGetResponse) Reset() {
+	*x = StatementGetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_transactions_v1_transactions_proto_msgTypes[14]
+		mi := &file_transactions_v1_transactions_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TransactWithIDResponse) String() string {
+func (x *StatementGetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransactWithIDResponse) ProtoMessage() {}
+func (*StatementGetResponse) ProtoMessage() {}
 
-func (x *TransactWithIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_transactions_v1_transactions_proto_msgTypes[14]
+func (x *StatementGetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1266,47 +2154,51 @@ func (x *TransactWithIDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransactWithIDResponse.ProtoReflect.Descriptor instead.
-func (*TransactWithIDResponse) Descriptor() ([]byte, []int) {
-	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use StatementGetResponse.ProtoReflect.Descriptor instead.
+func (*StatementGetResponse) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *TransactWithIDResponse) GetTransfer() *CompletedTransfer {
+func (x *StatementGetResponse) GetStatement() *Statement {
 	if x != nil {
-		return x.Transfer
+		return x.Statement
 	}
 	return nil
 }
 
-type TransactRequest struct {
+type Statement struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CreditUserId string       `protobuf:"bytes,1,opt,name=credit_user_id,json=creditUserId,proto3" json:"credit_user_id,omitempty"`
-	DebitUserId  string       `protobuf:"bytes,2,opt,name=debit_user_id,json=debitUserId,proto3" json:"debit_user_id,omitempty"`
-	Code         TransferCode `protobuf:"varint,3,opt,name=code,proto3,enum=transactions.v1.TransferCode" json:"code,omitempty"`
-	Ledger       Ledger       `protobuf:"varint,4,opt,name=ledger,proto3,enum=transactions.v1.Ledger" json:"ledger,omitempty"`
-	Amount       string       `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	AccountId      string               `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	UserId         string               `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Ledger         Ledger               `protobuf:"varint,3,opt,name=ledger,proto3,enum=transactions.v1.Ledger" json:"ledger,omitempty"`
+	From           int64                `protobuf:"varint,4,opt,name=from,proto3" json:"from,omitempty"`
+	To             int64                `protobuf:"varint,5,opt,name=to,proto3" json:"to,omitempty"`
+	OpeningBalance string               `protobuf:"bytes,6,opt,name=opening_balance,json=openingBalance,proto3" json:"opening_balance,omitempty"`
+	ClosingBalance string               `protobuf:"bytes,7,opt,name=closing_balance,json=closingBalance,proto3" json:"closing_balance,omitempty"`
+	Lines          []*StatementLine     `protobuf:"bytes,8,rep,name=lines,proto3" json:"lines,omitempty"`
+	Subtotals      []*StatementSubtotal `protobuf:"bytes,9,rep,name=subtotals,proto3" json:"subtotals,omitempty"`
 }
 
-func (x *TransactRequest) Reset() {
-	*x = TransactRequest{}
+func (x *Statement) Reset() {
+	*x = Statement{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_transactions_v1_transactions_proto_msgTypes[15]
+		mi := &file_transactions_v1_transactions_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TransactRequest) String() string {
+func (x *Statement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransactRequest) ProtoMessage() {}
+func (*Statement) ProtoMessage() {}
 
-func (x *TransactRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_transactions_v1_transactions_proto_msgTypes[15]
+func (x *Statement) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1317,71 +2209,103 @@ func (x *TransactRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransactRequest.ProtoReflect.Descriptor instead.
-func (*TransactRequest) Descriptor() ([]byte, []int) {
-	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use Statement.ProtoReflect.Descriptor instead.
+func (*Statement) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *TransactRequest) GetCreditUserId() string {
+func (x *Statement) GetAccountId() string {
 	if x != nil {
-		return x.CreditUserId
+		return x.AccountId
 	}
 	return ""
 }
 
-func (x *TransactRequest) GetDebitUserId() string {
+func (x *Statement) GetUserId() string {
 	if x != nil {
-		return x.DebitUserId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *TransactRequest) GetCode() TransferCode {
+func (x *Statement) GetLedger() Ledger {
 	if x != nil {
-		return x.Code
+		return x.Ledger
 	}
-	return TransferCode_UnusedTransferCode
+	return Ledger_UnusedLedgerCode
 }
 
-func (x *TransactRequest) GetLedger() Ledger {
+func (x *Statement) GetFrom() int64 {
 	if x != nil {
-		return x.Ledger
+		return x.From
 	}
-	return Ledger_UnusedLedgerCode
+	return 0
 }
 
-func (x *TransactRequest) GetAmount() string {
+func (x *Statement) GetTo() int64 {
 	if x != nil {
-		return x.Amount
+		return x.To
+	}
+	return 0
+}
+
+func (x *Statement) GetOpeningBalance() string {
+	if x != nil {
+		return x.OpeningBalance
 	}
 	return ""
 }
 
-type TransactResponse struct {
+func (x *Statement) GetClosingBalance() string {
+	if x != nil {
+		return x.ClosingBalance
+	}
+	return ""
+}
+
+func (x *Statement) GetLines() []*StatementLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *Statement) GetSubtotals() []*StatementSubtotal {
+	if x != nil {
+		return x.Subtotals
+	}
+	return nil
+}
+
+type StatementLine struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Transfer *CompletedTransfer `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	Transfer  *CompletedTransfer `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	Direction StatementDirection `protobuf:"varint,2,opt,name=direction,proto3,enum=transactions.v1.StatementDirection" json:"direction,omitempty"`
+	// amount is signed, negative for debits
+	Amount         string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	RunningBalance string `protobuf:"bytes,4,opt,name=running_balance,json=runningBalance,proto3" json:"running_balance,omitempty"`
 }
 
-func (x *TransactResponse) Reset() {
-	*x = TransactResponse{}
+func (x *StatementLine) Reset() {
+	*x = StatementLine{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_transactions_v1_transactions_proto_msgTypes[16]
+		mi := &file_transactions_v1_transactions_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TransactResponse) String() string {
+func (x *StatementLine) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransactResponse) ProtoMessage() {}
+func (*StatementLine) ProtoMessage() {}
 
-func (x *TransactResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_transactions_v1_transactions_proto_msgTypes[16]
+func (x *StatementLine) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1392,43 +2316,68 @@ func (x *TransactResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransactResponse.ProtoReflect.Descriptor instead.
-func (*TransactResponse) Descriptor() ([]byte, []int) {
-	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use StatementLine.ProtoReflect.Descriptor instead.
+func (*StatementLine) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *TransactResponse) GetTransfer() *CompletedTransfer {
+func (x *StatementLine) GetTransfer() *CompletedTransfer {
 	if x != nil {
 		return x.Transfer
 	}
 	return nil
 }
 
-type TransferCompleteSubscribeRequest struct {
+func (x *StatementLine) GetDirection() StatementDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return StatementDirection_StatementDirectionUnknown
+}
+
+func (x *StatementLine) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *StatementLine) GetRunningBalance() string {
+	if x != nil {
+		return x.RunningBalance
+	}
+	return ""
+}
+
+type StatementSubtotal struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code    TransferCode `protobuf:"varint,1,opt,name=code,proto3,enum=transactions.v1.TransferCode" json:"code,omitempty"`
+	Credits string       `protobuf:"bytes,2,opt,name=credits,proto3" json:"credits,omitempty"`
+	Debits  string       `protobuf:"bytes,3,opt,name=debits,proto3" json:"debits,omitempty"`
+	Net     string       `protobuf:"bytes,4,opt,name=net,proto3" json:"net,omitempty"`
+	Count   int64        `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *TransferCompleteSubscribeRequest) Reset() {
-	*x = TransferCompleteSubscribeRequest{}
+func (x *StatementSubtotal) Reset() {
+	*x = StatementSubtotal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_transactions_v1_transactions_proto_msgTypes[17]
+		mi := &file_transactions_v1_transactions_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TransferCompleteSubscribeRequest) String() string {
+func (x *StatementSubtotal) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransferCompleteSubscribeRequest) ProtoMessage() {}
+func (*StatementSubtotal) ProtoMessage() {}
 
-func (x *TransferCompleteSubscribeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_transactions_v1_transactions_proto_msgTypes[17]
+func (x *StatementSubtotal) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1439,44 +2388,78 @@ func (x *TransferCompleteSubscribeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransferCompleteSubscribeRequest.ProtoReflect.Descriptor instead.
-func (*TransferCompleteSubscribeRequest) Descriptor() ([]byte, []int) {
-	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use StatementSubtotal.ProtoReflect.Descriptor instead.
+func (*StatementSubtotal) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *TransferCompleteSubscribeRequest) GetId() string {
+func (x *StatementSubtotal) GetCode() TransferCode {
 	if x != nil {
-		return x.Id
+		return x.Code
+	}
+	return TransferCode_UnusedTransferCode
+}
+
+func (x *StatementSubtotal) GetCredits() string {
+	if x != nil {
+		return x.Credits
 	}
 	return ""
 }
 
-type TransferCompleteSubscribeResponse struct {
+func (x *StatementSubtotal) GetDebits() string {
+	if x != nil {
+		return x.Debits
+	}
+	return ""
+}
+
+func (x *StatementSubtotal) GetNet() string {
+	if x != nil {
+		return x.Net
+	}
+	return ""
+}
+
+func (x *StatementSubtotal) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type AccountFlagsSetRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Account     *Account           `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	Transaction *CompletedTransfer `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	UserId               string      `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Ledger               Ledger      `protobuf:"varint,2,opt,name=ledger,proto3,enum=transactions.v1.Ledger" json:"ledger,omitempty"`
+	CreateIfNotExists    bool        `protobuf:"varint,3,opt,name=create_if_not_exists,json=createIfNotExists,proto3" json:"create_if_not_exists,omitempty"`
+	Code                 AccountCode `protobuf:"varint,4,opt,name=code,proto3,enum=transactions.v1.AccountCode" json:"code,omitempty"`
+	AllowNegativeBalance bool        `protobuf:"varint,5,opt,name=allow_negative_balance,json=allowNegativeBalance,proto3" json:"allow_negative_balance,omitempty"`
+	OverdraftLimit       string      `protobuf:"bytes,6,opt,name=overdraft_limit,json=overdraftLimit,proto3" json:"overdraft_limit,omitempty"`
+	DebitsDisabled       bool        `protobuf:"varint,7,opt,name=debits_disabled,json=debitsDisabled,proto3" json:"debits_disabled,omitempty"`
+	CreditsDisabled      bool        `protobuf:"varint,8,opt,name=credits_disabled,json=creditsDisabled,proto3" json:"credits_disabled,omitempty"`
 }
 
-func (x *TransferCompleteSubscribeResponse) Reset() {
-	*x = TransferCompleteSubscribeResponse{}
+func (x *AccountFlagsSetRequest) Reset() {
+	*x = AccountFlagsSetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_transactions_v1_transactions_proto_msgTypes[18]
+		mi := &file_transactions_v1_transactions_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TransferCompleteSubscribeResponse) String() string {
+func (x *AccountFlagsSetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransferCompleteSubscribeResponse) ProtoMessage() {}
+func (*AccountFlagsSetRequest) ProtoMessage() {}
 
-func (x *TransferCompleteSubscribeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_transactions_v1_transactions_proto_msgTypes[18]
+func (x *AccountFlagsSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1487,121 +2470,2499 @@ func (x *TransferCompleteSubscribeResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransferCompleteSubscribeResponse.ProtoReflect.Descriptor instead.
-func (*TransferCompleteSubscribeResponse) Descriptor() ([]byte, []int) {
-	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use AccountFlagsSetRequest.ProtoReflect.Descriptor instead.
+func (*AccountFlagsSetRequest) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *TransferCompleteSubscribeResponse) GetAccount() *Account {
+func (x *AccountFlagsSetRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AccountFlagsSetRequest) GetLedger() Ledger {
+	if x != nil {
+		return x.Ledger
+	}
+	return Ledger_UnusedLedgerCode
+}
+
+func (x *AccountFlagsSetRequest) GetCreateIfNotExists() bool {
+	if x != nil {
+		return x.CreateIfNotExists
+	}
+	return false
+}
+
+func (x *AccountFlagsSetRequest) GetCode() AccountCode {
+	if x != nil {
+		return x.Code
+	}
+	return AccountCode_AccountUnknown
+}
+
+func (x *AccountFlagsSetRequest) GetAllowNegativeBalance() bool {
+	if x != nil {
+		return x.AllowNegativeBalance
+	}
+	return false
+}
+
+func (x *AccountFlagsSetRequest) GetOverdraftLimit() string {
+	if x != nil {
+		return x.OverdraftLimit
+	}
+	return ""
+}
+
+func (x *AccountFlagsSetRequest) GetDebitsDisabled() bool {
+	if x != nil {
+		return x.DebitsDisabled
+	}
+	return false
+}
+
+func (x *AccountFlagsSetRequest) GetCreditsDisabled() bool {
+	if x != nil {
+		return x.CreditsDisabled
+	}
+	return false
+}
+
+type AccountFlagsSetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (x *AccountFlagsSetResponse) Reset() {
+	*x = AccountFlagsSetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transactions_v1_transactions_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountFlagsSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountFlagsSetResponse) ProtoMessage() {}
+
+func (x *AccountFlagsSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountFlagsSetResponse.ProtoReflect.Descriptor instead.
+func (*AccountFlagsSetResponse) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AccountFlagsSetResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type AccountFreezeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId        string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Ledger        Ledger `protobuf:"varint,2,opt,name=ledger,proto3,enum=transactions.v1.Ledger" json:"ledger,omitempty"`
+	FreezeCredits bool   `protobuf:"varint,3,opt,name=freeze_credits,json=freezeCredits,proto3" json:"freeze_credits,omitempty"`
+	Reason        string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Actor         string `protobuf:"bytes,5,opt,name=actor,proto3" json:"actor,omitempty"`
+}
+
+func (x *AccountFreezeRequest) Reset() {
+	*x = AccountFreezeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transactions_v1_transactions_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountFreezeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountFreezeRequest) ProtoMessage() {}
+
+func (x *AccountFreezeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountFreezeRequest.ProtoReflect.Descriptor instead.
+func (*AccountFreezeRequest) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AccountFreezeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AccountFreezeRequest) GetLedger() Ledger {
+	if x != nil {
+		return x.Ledger
+	}
+	return Ledger_UnusedLedgerCode
+}
+
+func (x *AccountFreezeRequest) GetFreezeCredits() bool {
+	if x != nil {
+		return x.FreezeCredits
+	}
+	return false
+}
+
+func (x *AccountFreezeRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AccountFreezeRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+type AccountFreezeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account *Account       `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Freeze  *AccountFreeze `protobuf:"bytes,2,opt,name=freeze,proto3" json:"freeze,omitempty"`
+}
+
+func (x *AccountFreezeResponse) Reset() {
+	*x = AccountFreezeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transactions_v1_transactions_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountFreezeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountFreezeResponse) ProtoMessage() {}
+
+func (x *AccountFreezeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountFreezeResponse.ProtoReflect.Descriptor instead.
+func (*AccountFreezeResponse) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AccountFreezeResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *AccountFreezeResponse) GetFreeze() *AccountFreeze {
+	if x != nil {
+		return x.Freeze
+	}
+	return nil
+}
+
+type AccountUnfreezeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Ledger Ledger `protobuf:"varint,2,opt,name=ledger,proto3,enum=transactions.v1.Ledger" json:"ledger,omitempty"`
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Actor  string `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+}
+
+func (x *AccountUnfreezeRequest) Reset() {
+	*x = AccountUnfreezeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transactions_v1_transactions_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountUnfreezeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountUnfreezeRequest) ProtoMessage() {}
+
+func (x *AccountUnfreezeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountUnfreezeRequest.ProtoReflect.Descriptor instead.
+func (*AccountUnfreezeRequest) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AccountUnfreezeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AccountUnfreezeRequest) GetLedger() Ledger {
+	if x != nil {
+		return x.Ledger
+	}
+	return Ledger_UnusedLedgerCode
+}
+
+func (x *AccountUnfreezeRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AccountUnfreezeRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+type AccountUnfreezeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account *Account       `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Freeze  *AccountFreeze `protobuf:"bytes,2,opt,name=freeze,proto3" json:"freeze,omitempty"`
+}
+
+func (x *AccountUnfreezeResponse) Reset() {
+	*x = AccountUnfreezeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transactions_v1_transactions_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountUnfreezeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountUnfreezeResponse) ProtoMessage() {}
+
+func (x *AccountUnfreezeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountUnfreezeResponse.ProtoReflect.Descriptor instead.
+func (*AccountUnfreezeResponse) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AccountUnfreezeResponse) GetAccount() *Account {
 	if x != nil {
 		return x.Account
 	}
 	return nil
 }
 
-func (x *TransferCompleteSubscribeResponse) GetTransaction() *CompletedTransfer {
+func (x *AccountUnfreezeResponse) GetFreeze() *AccountFreeze {
+	if x != nil {
+		return x.Freeze
+	}
+	return nil
+}
+
+type AccountFreezeHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Ledger Ledger `protobuf:"varint,2,opt,name=ledger,proto3,enum=transactions.v1.Ledger" json:"ledger,omitempty"`
+}
+
+func (x *AccountFreezeHistoryRequest) Reset() {
+	*x = AccountFreezeHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transactions_v1_transactions_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountFreezeHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountFreezeHistoryRequest) ProtoMessage() {}
+
+func (x *AccountFreezeHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountFreezeHistoryRequest.ProtoReflect.Descriptor instead.
+func (*AccountFreezeHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AccountFreezeHistoryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AccountFreezeHistoryRequest) GetLedger() Ledger {
+	if x != nil {
+		return x.Ledger
+	}
+	return Ledger_UnusedLedgerCode
+}
+
+type AccountFreezeHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Freezes []*AccountFreeze `protobuf:"bytes,1,rep,name=freezes,proto3" json:"freezes,omitempty"`
+}
+
+func (x *AccountFreezeHistoryResponse) Reset() {
+	*x = AccountFreezeHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transactions_v1_transactions_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountFreezeHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountFreezeHistoryResponse) ProtoMessage() {}
+
+func (x *AccountFreezeHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountFreezeHistoryResponse.ProtoReflect.Descriptor instead.
+func (*AccountFreezeHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AccountFreezeHistoryResponse) GetFreezes() []*AccountFreeze {
+	if x != nil {
+		return x.Freezes
+	}
+	return nil
+}
+
+type TransactWithIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreditUserId string           `protobuf:"bytes,1,opt,name=credit_user_id,json=creditUserId,proto3" json:"credit_user_id,omitempty"`
+	DebitUserId  string           `protobuf:"bytes,2,opt,name=debit_user_id,json=debitUserId,proto3" json:"debit_user_id,omitempty"`
+	Code         TransferCode     `protobuf:"varint,3,opt,name=code,proto3,enum=transactions.v1.TransferCode" json:"code,omitempty"`
+	Ledger       Ledger           `protobuf:"varint,4,opt,name=ledger,proto3,enum=transactions.v1.Ledger" json:"ledger,omitempty"`
+	Amount       string           `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	TxId         string           `protobuf:"bytes,6,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	ReferenceId  string           `protobuf:"bytes,7,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	Metadata     *structpb.Struct `protobuf:"bytes,8,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *TransactWithIDRequest) Reset() {
+	*x = TransactWithIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transactions_v1_transactions_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactWithIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactWithIDRequest) ProtoMessage() {}
+
+func (x *TransactWithIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactWithIDRequest.ProtoReflect.Descriptor instead.
+func (*TransactWithIDRequest) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *TransactWithIDRequest) GetCreditUserId() string {
+	if x != nil {
+		return x.CreditUserId
+	}
+	return ""
+}
+
+func (x *TransactWithIDRequest) GetDebitUserId() string {
+	if x != nil {
+		return x.DebitUserId
+	}
+	return ""
+}
+
+func (x *TransactWithIDRequest) GetCode() TransferCode {
+	if x != nil {
+		return x.Code
+	}
+	return TransferCode_UnusedTransferCode
+}
+
+func (x *TransactWithIDRequest) GetLedger() Ledger {
+	if x != nil {
+		return x.Ledger
+	}
+	return Ledger_UnusedLedgerCode
+}
+
+func (x *TransactWithIDRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *TransactWithIDRequest) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+func (x *TransactWithIDRequest) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *TransactWithIDRequest) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type TransactWithIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transfer *CompletedTransfer `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+}
+
+func (x *TransactWithIDResponse) Reset() {
+	*x = TransactWithIDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transactions_v1_transactions_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactWithIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactWithIDResponse) ProtoMessage() {}
+
+func (x *TransactWithIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactWithIDResponse.ProtoReflect.Descriptor instead.
+func (*TransactWithIDResponse) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *TransactWithIDResponse) GetTransfer() *CompletedTransfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+type TransactRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreditUserId string       `protobuf:"bytes,1,opt,name=credit_user_id,json=creditUserId,proto3" json:"credit_user_id,omitempty"`
+	DebitUserId  string       `protobuf:"bytes,2,opt,name=debit_user_id,json=debitUserId,proto3" json:"debit_user_id,omitempty"`
+	Code         TransferCode `protobuf:"varint,3,opt,name=code,proto3,enum=transactions.v1.TransferCode" json:"code,omitempty"`
+	Ledger       Ledger       `protobuf:"varint,4,opt,name=ledger,proto3,enum=transactions.v1.Ledger" json:"ledger,omitempty"`
+	Amount       string       `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	// idempotency_key belongs to the client, a retry with it returns the original transfer, it can't be set on the transfers of a batch
+	IdempotencyKey string `protobuf:"bytes,6,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// reference_id and metadata are stored with the transfer, the initiator is the client making the request
+	ReferenceId string           `protobuf:"bytes,7,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	Metadata    *structpb.Struct `protobuf:"bytes,8,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *TransactRequest) Reset() {
+	*x = TransactRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transactions_v1_transactions_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactRequest) ProtoMessage() {}
+
+func (x *TransactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactRequest.ProtoReflect.Descriptor instead.
+func (*TransactRequest) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *TransactRequest) GetCreditUserId() string {
+	if x != nil {
+		return x.CreditUserId
+	}
+	return ""
+}
+
+func (x *TransactRequest) GetDebitUserId() string {
+	if x != nil {
+		return x.DebitUserId
+	}
+	return ""
+}
+
+func (x *TransactRequest) GetCode() TransferCode {
+	if x != nil {
+		return x.Code
+	}
+	return TransferCode_UnusedTransferCode
+}
+
+func (x *TransactRequest) GetLedger() Ledger {
+	if x != nil {
+		return x.Ledger
+	}
+	return Ledger_UnusedLedgerCode
+}
+
+func (x *TransactRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *TransactRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *TransactRequest) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *TransactRequest) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type TransactResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transfer *CompletedTransfer `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+}
+
+func (x *TransactResponse) Reset() {
+	*x = TransactResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transactions_v1_transactions_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactResponse) ProtoMessage() {}
+
+func (x *TransactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactResponse.ProtoReflect.Descriptor instead.
+func (*TransactResponse) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *TransactResponse) GetTransfer() *CompletedTransfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+type TransactBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transfers []*TransactRequest `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+}
+
+func (x *TransactBatchRequest) Reset() {
+	*x = TransactBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transactions_v1_transactions_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactBatchRequest) ProtoMessage() {}
+
+func (x *TransactBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactBatchRequest.ProtoReflect.Descriptor instead.
+func (*TransactBatchRequest) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *TransactBatchRequest) GetTransfers() []*TransactRequest {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
+type TransactBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transfers []*CompletedTransfer `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+}
+
+func (x *TransactBatchResponse) Reset() {
+	*x = TransactBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transactions_v1_transactions_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactBatchResponse) ProtoMessage() {}
+
+func (x *TransactBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactBatchResponse.ProtoReflect.Descriptor instead.
+func (*TransactBatchResponse) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *TransactBatchResponse) GetTransfers() []*CompletedTransfer {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
+type TransferReserveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreditUserId string       `protobuf:"bytes,1,opt,name=credit_user_id,json=creditUserId,proto3" json:"credit_user_id,omitempty"`
+	DebitUserId  string       `protobuf:"bytes,2,opt,name=debit_user_id,json=debitUserId,proto3" json:"debit_user_id,omitempty"`
+	Code         TransferCode `protobuf:"varint,3,opt,name=code,proto3,enum=transactions.v1.TransferCode" json:"code,omitempty"`
+	Ledger       Ledger       `protobuf:"varint,4,opt,name=ledger,proto3,enum=transactions.v1.Ledger" json:"ledger,omitempty"`
+	Amount       string       `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	// timeout_seconds is how long the funds are held, the server's default when 0 and at most 7 days
+	TimeoutSeconds int64            `protobuf:"varint,6,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	ReferenceId    string           `protobuf:"bytes,7,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	Metadata       *structpb.Struct `protobuf:"bytes,8,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *TransferReserveRequest) Reset() {
+	*x = TransferReserveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transactions_v1_transactions_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferReserveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferReserveRequest) ProtoMessage() {}
+
+func (x *TransferReserveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferReserveRequest.ProtoReflect.Descriptor instead.
+func (*TransferReserveRequest) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *TransferReserveRequest) GetCreditUserId() string {
+	if x != nil {
+		return x.CreditUserId
+	}
+	return ""
+}
+
+func (x *TransferReserveRequest) GetDebitUserId() string {
+	if x != nil {
+		return x.DebitUserId
+	}
+	return ""
+}
+
+func (x *TransferReserveRequest) GetCode() TransferCode {
+	if x != nil {
+		return x.Code
+	}
+	return TransferCode_UnusedTransferCode
+}
+
+func (x *TransferReserveRequest) GetLedger() Ledger {
+	if x != nil {
+		return x.Ledger
+	}
+	return Ledger_UnusedLedgerCode
+}
+
+func (x *TransferReserveRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *TransferReserveRequest) GetTimeoutSeconds() int64 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *TransferReserveRequest) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *TransferReserveRequest) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type TransferReserveResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PendingTransfer *PendingTransfer `protobuf:"bytes,1,opt,name=pending_transfer,json=pendingTransfer,proto3" json:"pending_transfer,omitempty"`
+}
+
+func (x *TransferReserveResponse) Reset() {
+	*x = TransferReserveResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transactions_v1_transactions_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferReserveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferReserveResponse) ProtoMessage() {}
+
+func (x *TransferReserveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferReserveResponse.ProtoReflect.Descriptor instead.
+func (*TransferReserveResponse) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *TransferReserveResponse) GetPendingTransfer() *PendingTransfer {
+	if x != nil {
+		return x.PendingTransfer
+	}
+	return nil
+}
+
+type TransferPostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReserveId string `protobuf:"bytes,1,opt,name=reserve_id,json=reserveId,proto3" json:"reserve_id,omitempty"`
+}
+
+func (x *TransferPostRequest) Reset() {
+	*x = TransferPostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transactions_v1_transactions_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferPostRequest) ProtoMessage() {}
+
+func (x *TransferPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferPostRequest.ProtoReflect.Descriptor instead.
+func (*TransferPostRequest) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *TransferPostRequest) GetReserveId() string {
+	if x != nil {
+		return x.ReserveId
+	}
+	return ""
+}
+
+type TransferPostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transfer        *CompletedTransfer `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	PendingTransfer *PendingTransfer   `protobuf:"bytes,2,opt,name=pending_transfer,json=pendingTransfer,proto3" json:"pending_transfer,omitempty"`
+}
+
+func (x *TransferPostResponse) Reset() {
+	*x = TransferPostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transactions_v1_transactions_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferPostResponse) ProtoMessage() {}
+
+func (x *TransferPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferPostResponse.ProtoReflect.Descriptor instead.
+func (*TransferPostResponse) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *TransferPostResponse) GetTransfer() *CompletedTransfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+func (x *TransferPostResponse) GetPendingTransfer() *PendingTransfer {
+	if x != nil {
+		return x.PendingTransfer
+	}
+	return nil
+}
+
+type TransferVoidRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReserveId string `protobuf:"bytes,1,opt,name=reserve_id,json=reserveId,proto3" json:"reserve_id,omitempty"`
+}
+
+func (x *TransferVoidRequest) Reset() {
+	*x = TransferVoidRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transactions_v1_transactions_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferVoidRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferVoidRequest) ProtoMessage() {}
+
+func (x *TransferVoidRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferVoidRequest.ProtoReflect.Descriptor instead.
+func (*TransferVoidRequest) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *TransferVoidRequest) GetReserveId() string {
+	if x != nil {
+		return x.ReserveId
+	}
+	return ""
+}
+
+type TransferVoidResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PendingTransfer *PendingTransfer `protobuf:"bytes,1,opt,name=pending_transfer,json=pendingTransfer,proto3" json:"pending_transfer,omitempty"`
+}
+
+func (x *TransferVoidResponse) Reset() {
+	*x = TransferVoidResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transactions_v1_transactions_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferVoidResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferVoidResponse) ProtoMessage() {}
+
+func (x *TransferVoidResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferVoidResponse.ProtoReflect.Descriptor instead.
+func (*TransferVoidResponse) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *TransferVoidResponse) GetPendingTransfer() *PendingTransfer {
+	if x != nil {
+		return x.PendingTransfer
+	}
+	return nil
+}
+
+type RefundRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TransactionId string           `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Amount        string           `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	ReferenceId   string           `protobuf:"bytes,3,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	Metadata      *structpb.Struct `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *RefundRequest) Reset() {
+	*x = RefundRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transactions_v1_transactions_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefundRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundRequest) ProtoMessage() {}
+
+func (x *RefundRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundRequest.ProtoReflect.Descriptor instead.
+func (*RefundRequest) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RefundRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *RefundRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *RefundRequest) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *RefundRequest) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type RefundResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transfer *CompletedTransfer `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+}
+
+func (x *RefundResponse) Reset() {
+	*x = RefundResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transactions_v1_transactions_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefundResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundResponse) ProtoMessage() {}
+
+func (x *RefundResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundResponse.ProtoReflect.Descriptor instead.
+func (*RefundResponse) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RefundResponse) GetTransfer() *CompletedTransfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+// TransferCompleteSubscribeRequest filters are matched against the account of each update,
+// an empty filter matches everything and every non empty filter has to match
+type TransferCompleteSubscribeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserIds    []string       `protobuf:"bytes,2,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	AccountIds []string       `protobuf:"bytes,3,rep,name=account_ids,json=accountIds,proto3" json:"account_ids,omitempty"`
+	Ledgers    []Ledger       `protobuf:"varint,4,rep,packed,name=ledgers,proto3,enum=transactions.v1.Ledger" json:"ledgers,omitempty"`
+	Codes      []TransferCode `protobuf:"varint,5,rep,packed,name=codes,proto3,enum=transactions.v1.TransferCode" json:"codes,omitempty"`
+	// resume_after replays the transfers with a greater sequence before the live updates,
+	// replayed accounts carry the posted totals after the transfer and balance is the posted balance
+	ResumeAfter int64 `protobuf:"varint,6,opt,name=resume_after,json=resumeAfter,proto3" json:"resume_after,omitempty"`
+}
+
+func (x *TransferCompleteSubscribeRequest) Reset() {
+	*x = TransferCompleteSubscribeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transactions_v1_transactions_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferCompleteSubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferCompleteSubscribeRequest) ProtoMessage() {}
+
+func (x *TransferCompleteSubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferCompleteSubscribeRequest.ProtoReflect.Descriptor instead.
+func (*TransferCompleteSubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *TransferCompleteSubscribeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TransferCompleteSubscribeRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *TransferCompleteSubscribeRequest) GetAccountIds() []string {
+	if x != nil {
+		return x.AccountIds
+	}
+	return nil
+}
+
+func (x *TransferCompleteSubscribeRequest) GetLedgers() []Ledger {
+	if x != nil {
+		return x.Ledgers
+	}
+	return nil
+}
+
+func (x *TransferCompleteSubscribeRequest) GetCodes() []TransferCode {
+	if x != nil {
+		return x.Codes
+	}
+	return nil
+}
+
+func (x *TransferCompleteSubscribeRequest) GetResumeAfter() int64 {
+	if x != nil {
+		return x.ResumeAfter
+	}
+	return 0
+}
+
+type TransferCompleteSubscribeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Account         *Account           `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Transaction     *CompletedTransfer `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	PendingTransfer *PendingTransfer   `protobuf:"bytes,3,opt,name=pending_transfer,json=pendingTransfer,proto3" json:"pending_transfer,omitempty"`
+}
+
+func (x *TransferCompleteSubscribeResponse) Reset() {
+	*x = TransferCompleteSubscribeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transactions_v1_transactions_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferCompleteSubscribeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferCompleteSubscribeResponse) ProtoMessage() {}
+
+func (x *TransferCompleteSubscribeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferCompleteSubscribeResponse.ProtoReflect.Descriptor instead.
+func (*TransferCompleteSubscribeResponse) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *TransferCompleteSubscribeResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *TransferCompleteSubscribeResponse) GetTransaction() *CompletedTransfer {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *TransferCompleteSubscribeResponse) GetPendingTransfer() *PendingTransfer {
+	if x != nil {
+		return x.PendingTransfer
+	}
+	return nil
+}
+
+type WebhookEndpoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url       string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	CreatedAt int64  `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *WebhookEndpoint) Reset() {
+	*x = WebhookEndpoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transactions_v1_transactions_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookEndpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookEndpoint) ProtoMessage() {}
+
+func (x *WebhookEndpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookEndpoint.ProtoReflect.Descriptor instead.
+func (*WebhookEndpoint) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *WebhookEndpoint) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookEndpoint) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookEndpoint) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type WebhookDelivery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string                `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId       int64                 `protobuf:"varint,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EndpointId    string                `protobuf:"bytes,3,opt,name=endpoint_id,json=endpointId,proto3" json:"endpoint_id,omitempty"`
+	TransactionId string                `protobuf:"bytes,4,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Status        WebhookDeliveryStatus `protobuf:"varint,5,opt,name=status,proto3,enum=transactions.v1.WebhookDeliveryStatus" json:"status,omitempty"`
+	Attempts      int32                 `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	NextAttemptAt int64                 `protobuf:"varint,7,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	LastError     string                `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	DeliveredAt   int64                 `protobuf:"varint,9,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	CreatedAt     int64                 `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transactions_v1_transactions_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *WebhookDelivery) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventId() int64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetEndpointId() string {
+	if x != nil {
+		return x.EndpointId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetStatus() WebhookDeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WebhookDeliveryStatus_WebhookDeliveryStatusUnknown
+}
+
+func (x *WebhookDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetNextAttemptAt() int64 {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetDeliveredAt() int64 {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// WebhookEvent is the body posted to webhook endpoints
+type WebhookEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64              `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type      string             `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	CreatedAt int64              `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Transfer  *CompletedTransfer `protobuf:"bytes,4,opt,name=transfer,proto3" json:"transfer,omitempty"`
+}
+
+func (x *WebhookEvent) Reset() {
+	*x = WebhookEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transactions_v1_transactions_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookEvent) ProtoMessage() {}
+
+func (x *WebhookEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookEvent.ProtoReflect.Descriptor instead.
+func (*WebhookEvent) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *WebhookEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WebhookEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *WebhookEvent) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *WebhookEvent) GetTransfer() *CompletedTransfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+// WebhookEndpointCreateRequest generates a secret when none is given
+type WebhookEndpointCreateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url    string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Secret string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (x *WebhookEndpointCreateRequest) Reset() {
+	*x = WebhookEndpointCreateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transactions_v1_transactions_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookEndpointCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookEndpointCreateRequest) ProtoMessage() {}
+
+func (x *WebhookEndpointCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookEndpointCreateRequest.ProtoReflect.Descriptor instead.
+func (*WebhookEndpointCreateRequest) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *WebhookEndpointCreateRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookEndpointCreateRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type WebhookEndpointCreateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Endpoint *WebhookEndpoint `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Secret   string           `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (x *WebhookEndpointCreateResponse) Reset() {
+	*x = WebhookEndpointCreateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transactions_v1_transactions_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookEndpointCreateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookEndpointCreateResponse) ProtoMessage() {}
+
+func (x *WebhookEndpointCreateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookEndpointCreateResponse.ProtoReflect.Descriptor instead.
+func (*WebhookEndpointCreateResponse) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *WebhookEndpointCreateResponse) GetEndpoint() *WebhookEndpoint {
+	if x != nil {
+		return x.Endpoint
+	}
+	return nil
+}
+
+func (x *WebhookEndpointCreateResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type WebhookEndpointsListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *WebhookEndpointsListRequest) Reset() {
+	*x = WebhookEndpointsListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transactions_v1_transactions_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookEndpointsListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookEndpointsListRequest) ProtoMessage() {}
+
+func (x *WebhookEndpointsListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookEndpointsListRequest.ProtoReflect.Descriptor instead.
+func (*WebhookEndpointsListRequest) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{51}
+}
+
+type WebhookEndpointsListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Endpoints []*WebhookEndpoint `protobuf:"bytes,1,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+}
+
+func (x *WebhookEndpointsListResponse) Reset() {
+	*x = WebhookEndpointsListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transactions_v1_transactions_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookEndpointsListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookEndpointsListResponse) ProtoMessage() {}
+
+func (x *WebhookEndpointsListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookEndpointsListResponse.ProtoReflect.Descriptor instead.
+func (*WebhookEndpointsListResponse) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *WebhookEndpointsListResponse) GetEndpoints() []*WebhookEndpoint {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+type WebhookEndpointDeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *WebhookEndpointDeleteRequest) Reset() {
+	*x = WebhookEndpointDeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transactions_v1_transactions_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookEndpointDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookEndpointDeleteRequest) ProtoMessage() {}
+
+func (x *WebhookEndpointDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookEndpointDeleteRequest.ProtoReflect.Descriptor instead.
+func (*WebhookEndpointDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *WebhookEndpointDeleteRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type WebhookEndpointDeleteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *WebhookEndpointDeleteResponse) Reset() {
+	*x = WebhookEndpointDeleteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transactions_v1_transactions_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookEndpointDeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookEndpointDeleteResponse) ProtoMessage() {}
+
+func (x *WebhookEndpointDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookEndpointDeleteResponse.ProtoReflect.Descriptor instead.
+func (*WebhookEndpointDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{54}
+}
+
+type WebhookDeliveriesListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EndpointId string                `protobuf:"bytes,1,opt,name=endpoint_id,json=endpointId,proto3" json:"endpoint_id,omitempty"`
+	Status     WebhookDeliveryStatus `protobuf:"varint,2,opt,name=status,proto3,enum=transactions.v1.WebhookDeliveryStatus" json:"status,omitempty"`
+	Limit      int32                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *WebhookDeliveriesListRequest) Reset() {
+	*x = WebhookDeliveriesListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transactions_v1_transactions_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookDeliveriesListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDeliveriesListRequest) ProtoMessage() {}
+
+func (x *WebhookDeliveriesListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDeliveriesListRequest.ProtoReflect.Descriptor instead.
+func (*WebhookDeliveriesListRequest) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *WebhookDeliveriesListRequest) GetEndpointId() string {
+	if x != nil {
+		return x.EndpointId
+	}
+	return ""
+}
+
+func (x *WebhookDeliveriesListRequest) GetStatus() WebhookDeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WebhookDeliveryStatus_WebhookDeliveryStatusUnknown
+}
+
+func (x *WebhookDeliveriesListRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type WebhookDeliveriesListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deliveries []*WebhookDelivery `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+}
+
+func (x *WebhookDeliveriesListResponse) Reset() {
+	*x = WebhookDeliveriesListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transactions_v1_transactions_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookDeliveriesListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDeliveriesListResponse) ProtoMessage() {}
+
+func (x *WebhookDeliveriesListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDeliveriesListResponse.ProtoReflect.Descriptor instead.
+func (*WebhookDeliveriesListResponse) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *WebhookDeliveriesListResponse) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+// WebhookDeliveryRetryRequest puts a dead delivery back to pending
+type WebhookDeliveryRetryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *WebhookDeliveryRetryRequest) Reset() {
+	*x = WebhookDeliveryRetryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transactions_v1_transactions_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookDeliveryRetryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDeliveryRetryRequest) ProtoMessage() {}
+
+func (x *WebhookDeliveryRetryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDeliveryRetryRequest.ProtoReflect.Descriptor instead.
+func (*WebhookDeliveryRetryRequest) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *WebhookDeliveryRetryRequest) GetId() string {
 	if x != nil {
-		return x.Transaction
+		return x.Id
+	}
+	return ""
+}
+
+type WebhookDeliveryRetryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Delivery *WebhookDelivery `protobuf:"bytes,1,opt,name=delivery,proto3" json:"delivery,omitempty"`
+}
+
+func (x *WebhookDeliveryRetryResponse) Reset() {
+	*x = WebhookDeliveryRetryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transactions_v1_transactions_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookDeliveryRetryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDeliveryRetryResponse) ProtoMessage() {}
+
+func (x *WebhookDeliveryRetryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDeliveryRetryResponse.ProtoReflect.Descriptor instead.
+func (*WebhookDeliveryRetryResponse) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *WebhookDeliveryRetryResponse) GetDelivery() *WebhookDelivery {
+	if x != nil {
+		return x.Delivery
+	}
+	return nil
+}
+
+// ErrorDetail is attached to the error of a failed request
+type ErrorDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reason ErrorReason `protobuf:"varint,1,opt,name=reason,proto3,enum=transactions.v1.ErrorReason" json:"reason,omitempty"`
+	// violations lists every invalid field of a request that failed validation
+	Violations []*FieldViolation `protobuf:"bytes,2,rep,name=violations,proto3" json:"violations,omitempty"`
+}
+
+func (x *ErrorDetail) Reset() {
+	*x = ErrorDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transactions_v1_transactions_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ErrorDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorDetail) ProtoMessage() {}
+
+func (x *ErrorDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorDetail.ProtoReflect.Descriptor instead.
+func (*ErrorDetail) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ErrorDetail) GetReason() ErrorReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ErrorReason_ErrorReasonUnknown
+}
+
+func (x *ErrorDetail) GetViolations() []*FieldViolation {
+	if x != nil {
+		return x.Violations
 	}
 	return nil
 }
 
+// FieldViolation is an invalid field of a request, field is the path to it e.g. transfers[1].amount
+type FieldViolation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field       string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *FieldViolation) Reset() {
+	*x = FieldViolation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_transactions_v1_transactions_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldViolation) ProtoMessage() {}
+
+func (x *FieldViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_transactions_v1_transactions_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldViolation.ProtoReflect.Descriptor instead.
+func (*FieldViolation) Descriptor() ([]byte, []int) {
+	return file_transactions_v1_transactions_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *FieldViolation) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldViolation) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
 var File_transactions_v1_transactions_proto protoreflect.FileDescriptor
 
 var file_transactions_v1_transactions_proto_rawDesc = []byte{
 	0x0a, 0x22, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x76,
 	0x31, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x2e, 0x76, 0x31, 0x22, 0x9a, 0x02, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x6c, 0x65,
-	0x64, 0x67, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64,
-	0x67, 0x65, 0x72, 0x52, 0x06, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a,
-	0x0d, 0x64, 0x65, 0x62, 0x69, 0x74, 0x73, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x64, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x62, 0x69, 0x74, 0x73, 0x50, 0x6f, 0x73, 0x74,
-	0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x5f, 0x70, 0x6f,
-	0x73, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x64,
-	0x69, 0x74, 0x73, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x22, 0xdd, 0x02, 0x0a, 0x11, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x64,
-	0x69, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a,
-	0x0a, 0x11, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x64, 0x69,
-	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x64, 0x65,
-	0x62, 0x69, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x64, 0x65, 0x62, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x28,
-	0x0a, 0x10, 0x64, 0x65, 0x62, 0x69, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x65, 0x62, 0x69, 0x74, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x2f, 0x0a, 0x06, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x17, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x06, 0x6c, 0x65, 0x64, 0x67, 0x65,
-	0x72, 0x12, 0x31, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x1d, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04,
-	0x63, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x22, 0xdd, 0x02, 0x0a, 0x11, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x64,
-	0x69, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a,
-	0x0a, 0x11, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x64, 0x69,
-	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x64, 0x65,
-	0x62, 0x69, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x64, 0x65, 0x62, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x28,
-	0x0a, 0x10, 0x64, 0x65, 0x62, 0x69, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x65, 0x62, 0x69, 0x74, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x2f, 0x0a, 0x06, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x17, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x06, 0x6c, 0x65, 0x64, 0x67, 0x65,
-	0x72, 0x12, 0x31, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x1d, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04,
-	0x63, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x22, 0x95, 0x01, 0x0a, 0x18, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x47, 0x65,
-	0x74, 0x56, 0x69, 0x61, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x6e, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xdc, 0x04, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x6c, 0x65, 0x64, 0x67,
-	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65,
-	0x72, 0x52, 0x06, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x14, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x5f, 0x69, 0x66, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49,
-	0x66, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x22, 0x4f, 0x0a, 0x19, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x47, 0x65, 0x74, 0x56, 0x69, 0x61, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x76, 0x0a, 0x13, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x13, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x66, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x65, 0x78, 0x69,
-	0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73,
 	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65,
-	0x72, 0x52, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x66, 0x4e, 0x6f, 0x74, 0x45, 0x78,
-	0x69, 0x73, 0x74, 0x22, 0x4c, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x72, 0x52, 0x06, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64,
+	0x65, 0x62, 0x69, 0x74, 0x73, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x62, 0x69, 0x74, 0x73, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x64,
+	0x12, 0x25, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x5f, 0x70, 0x6f, 0x73, 0x74,
+	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x73, 0x50, 0x6f, 0x73, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x62, 0x69, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x62, 0x69, 0x74, 0x73,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x73, 0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x12, 0x34, 0x0a, 0x16, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6e, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4e, 0x65, 0x67, 0x61, 0x74, 0x69, 0x76, 0x65, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x76, 0x65, 0x72, 0x64, 0x72,
+	0x61, 0x66, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x6f, 0x76, 0x65, 0x72, 0x64, 0x72, 0x61, 0x66, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x27, 0x0a, 0x0f, 0x64, 0x65, 0x62, 0x69, 0x74, 0x73, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x64, 0x65, 0x62, 0x69, 0x74, 0x73,
+	0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x73, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x44, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x66,
+	0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0d, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x73, 0x22, 0xca, 0x01, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x72,
+	0x65, 0x65, 0x7a, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x66,
+	0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0d, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0xdd, 0x02, 0x0a, 0x11, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x63,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x64, 0x65, 0x62, 0x69, 0x74,
+	0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x62, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x64,
+	0x65, 0x62, 0x69, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x65, 0x62, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2f, 0x0a,
+	0x06, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x06, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x31,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22,
+	0xa8, 0x04, 0x0a, 0x11, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x63,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x64, 0x65, 0x62, 0x69, 0x74,
+	0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x62, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x64,
+	0x65, 0x62, 0x69, 0x74, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x65, 0x62, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2f, 0x0a,
+	0x06, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e,
 	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x73, 0x22, 0x8e, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x06, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x31,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x32, 0x0a, 0x15, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xdd, 0x04, 0x0a, 0x0f, 0x50,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24,
+	0x0a, 0x0e, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x22, 0x0a, 0x0d, 0x64, 0x65, 0x62, 0x69, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x62, 0x69, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x64, 0x65, 0x62, 0x69, 0x74, 0x5f, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x64, 0x65, 0x62, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52,
+	0x06, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3e, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x95, 0x01, 0x0a, 0x18, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x47, 0x65, 0x74, 0x56, 0x69, 0x61, 0x55, 0x73, 0x65, 0x72,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
 	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
 	0x12, 0x2f, 0x0a, 0x06, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
@@ -1610,40 +4971,315 @@ var file_transactions_v1_transactions_proto_rawDesc = []byte{
 	0x72, 0x12, 0x2f, 0x0a, 0x14, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x66, 0x5f, 0x6e,
 	0x6f, 0x74, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
 	0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x66, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73,
-	0x74, 0x73, 0x22, 0x2e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x22, 0x42, 0x0a, 0x19, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x62, 0x0a, 0x1a, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70,
-	0x6c, 0x65, 0x74, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x0b, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xab, 0x01, 0x0a, 0x21, 0x54,
+	0x74, 0x73, 0x22, 0x4f, 0x0a, 0x19, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x47, 0x65, 0x74,
+	0x56, 0x69, 0x61, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x32, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x76, 0x0a, 0x13, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x13, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x66,
+	0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x49, 0x66, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x22, 0x4c, 0x0a, 0x14, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0x8e, 0x01, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65,
+	0x72, 0x52, 0x06, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x14, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x5f, 0x69, 0x66, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49,
+	0x66, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x22, 0x2e, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x7d, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x52, 0x06, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x30, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x42, 0x0a, 0x19, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49,
+	0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22,
+	0xa0, 0x01, 0x0a, 0x1a, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x47,
+	0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44,
+	0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x07, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x07, 0x72, 0x65, 0x66, 0x75, 0x6e,
+	0x64, 0x73, 0x22, 0xf5, 0x04, 0x0a, 0x21, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x47, 0x65, 0x74, 0x42, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
+	0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x69,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x6b, 0x69, 0x70, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x12,
+	0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x74, 0x6f, 0x12, 0x33, 0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x0e, 0x32, 0x1d, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x17, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x69, 0x6e,
+	0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x12,
+	0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0xaa, 0x01, 0x0a, 0x22, 0x54,
 	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x47, 0x65, 0x74, 0x42, 0x79,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12,
-	0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f,
-	0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65,
-	0x53, 0x69, 0x7a, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x19, 0x0a,
-	0x08, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x73, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x22, 0x82, 0x01, 0x0a, 0x22, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x47, 0x65, 0x74, 0x42, 0x79, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x46, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x72,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x46, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x83, 0x01, 0x0a, 0x13, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65,
+	0x72, 0x52, 0x06, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a,
+	0x02, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x50, 0x0a,
+	0x14, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22,
+	0xe2, 0x02, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x06,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6c, 0x6f, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6c,
+	0x6f, 0x73, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x34, 0x0a, 0x05,
+	0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x05, 0x6c, 0x69, 0x6e,
+	0x65, 0x73, 0x12, 0x40, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x53, 0x75, 0x62, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x09, 0x73, 0x75, 0x62, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x73, 0x22, 0xd3, 0x01, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x08, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x75, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xa0, 0x01, 0x0a, 0x11, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x62, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x31, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d,
+	0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x64, 0x65, 0x62, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64,
+	0x65, 0x62, 0x69, 0x74, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6e, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xf8, 0x02,
+	0x0a, 0x16, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x53, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x2f, 0x0a, 0x06, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x17, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x06, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x72, 0x12, 0x2f, 0x0a, 0x14, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x66, 0x5f,
+	0x6e, 0x6f, 0x74, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x66, 0x4e, 0x6f, 0x74, 0x45, 0x78, 0x69,
+	0x73, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1c, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6e,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4e, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x76, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6f,
+	0x76, 0x65, 0x72, 0x64, 0x72, 0x61, 0x66, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x76, 0x65, 0x72, 0x64, 0x72, 0x61, 0x66, 0x74, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65, 0x62, 0x69, 0x74, 0x73, 0x5f, 0x64,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x64,
+	0x65, 0x62, 0x69, 0x74, 0x73, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x29, 0x0a,
+	0x10, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73,
+	0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x4d, 0x0a, 0x17, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xb5, 0x01, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64, 0x67,
+	0x65, 0x72, 0x52, 0x06, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x72,
+	0x65, 0x65, 0x7a, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0d, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x22,
+	0x83, 0x01, 0x0a, 0x15, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x72, 0x65, 0x65, 0x7a,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a,
+	0x06, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x52, 0x06, 0x66,
+	0x72, 0x65, 0x65, 0x7a, 0x65, 0x22, 0x90, 0x01, 0x0a, 0x16, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64, 0x67,
+	0x65, 0x72, 0x52, 0x06, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x85, 0x01, 0x0a, 0x17, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x06, 0x66, 0x72, 0x65, 0x65,
+	0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x52, 0x06, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65,
+	0x22, 0x67, 0x0a, 0x1b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x72, 0x65, 0x65, 0x7a,
+	0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65,
+	0x72, 0x52, 0x06, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x22, 0x58, 0x0a, 0x1c, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x66, 0x72, 0x65,
+	0x65, 0x7a, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x52, 0x07, 0x66, 0x72, 0x65, 0x65,
+	0x7a, 0x65, 0x73, 0x22, 0xca, 0x02, 0x0a, 0x15, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x57, 0x69, 0x74, 0x68, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a,
+	0x0e, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x64, 0x65, 0x62, 0x69, 0x74, 0x5f, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x62, 0x69,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x52, 0x06, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0x58, 0x0a, 0x16, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x57, 0x69, 0x74, 0x68,
+	0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x52, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x22, 0xd8, 0x02, 0x0a, 0x0f, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24,
+	0x0a, 0x0e, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x64, 0x65, 0x62, 0x69, 0x74, 0x5f, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x62,
+	0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x52, 0x06, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69,
+	0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a,
+	0x0c, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64,
+	0x12, 0x33, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x52, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x72,
 	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
 	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52,
-	0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xf2, 0x01,
-	0x0a, 0x15, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x57, 0x69, 0x74, 0x68, 0x49, 0x44,
+	0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x22, 0x56, 0x0a, 0x14, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x3e, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x73, 0x22, 0x59, 0x0a, 0x15, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x09, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x22, 0xdf, 0x02, 0x0a,
+	0x16, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x64, 0x69,
 	0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x0c, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a,
@@ -1656,172 +5292,501 @@ var file_transactions_v1_transactions_proto_rawDesc = []byte{
 	0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
 	0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x06, 0x6c,
 	0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x13, 0x0a,
-	0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78,
-	0x49, 0x64, 0x22, 0x58, 0x0a, 0x16, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x57, 0x69,
-	0x74, 0x68, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x08,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a,
+	0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x66,
+	0x0a, 0x17, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x10, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x0f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x22, 0x34, 0x0a, 0x13, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x49, 0x64, 0x22, 0xa3, 0x01, 0x0a,
+	0x14, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x08, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x10, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x52, 0x0f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x22, 0x34, 0x0a, 0x13, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x56, 0x6f,
+	0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x49, 0x64, 0x22, 0x63, 0x0a, 0x14, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x56, 0x6f, 0x69, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4b, 0x0a, 0x10, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x0f, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x22, 0xa6, 0x01,
+	0x0a, 0x0d, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49,
+	0x64, 0x12, 0x33, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x50, 0x0a, 0x0e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x08,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x22, 0xf9, 0x01, 0x0a, 0x20, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x31, 0x0a, 0x07, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x52, 0x07, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x73, 0x12, 0x33, 0x0a, 0x05,
+	0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x63, 0x6f, 0x64, 0x65,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x41,
+	0x66, 0x74, 0x65, 0x72, 0x22, 0xea, 0x01, 0x0a, 0x21, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x44,
+	0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4b, 0x0a, 0x10, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
 	0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x65, 0x72, 0x52, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x22, 0xd7, 0x01, 0x0a,
-	0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x24, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x64, 0x65, 0x62, 0x69, 0x74, 0x5f,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
-	0x65, 0x62, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x04, 0x63, 0x6f,
-	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x66, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x2f, 0x0a,
-	0x06, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x06, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x16,
-	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x52, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
-	0x52, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x22, 0x32, 0x0a, 0x20, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x9d,
-	0x01, 0x0a, 0x21, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
-	0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
-	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x44, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
-	0x72, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2a, 0x94,
-	0x07, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12,
-	0x16, 0x0a, 0x12, 0x55, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
-	0x72, 0x43, 0x6f, 0x64, 0x65, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x6e, 0x6b, 0x6e, 0x6f,
-	0x77, 0x6e, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x10, 0x02,
-	0x12, 0x0b, 0x0a, 0x07, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x10, 0x03, 0x12, 0x11, 0x0a,
-	0x0d, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x10, 0x04,
-	0x12, 0x0c, 0x0a, 0x08, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x10, 0x05, 0x12, 0x12,
-	0x0a, 0x0e, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64,
-	0x10, 0x06, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x75, 0x70, 0x73, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61,
-	0x73, 0x65, 0x10, 0x07, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x75, 0x70, 0x73, 0x50, 0x75, 0x72, 0x63,
-	0x68, 0x61, 0x73, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x10, 0x08, 0x12, 0x11, 0x0a, 0x0d,
-	0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x10, 0x09, 0x12,
-	0x17, 0x0a, 0x13, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65,
-	0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x10, 0x0a, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x73, 0x73, 0x65,
-	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x46, 0x65, 0x65, 0x10, 0x0b, 0x12, 0x1a,
-	0x0a, 0x16, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x46,
-	0x65, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x10, 0x0c, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x75,
-	0x70, 0x72, 0x65, 0x6d, 0x61, 0x63, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x75, 0x72, 0x63,
-	0x68, 0x61, 0x73, 0x65, 0x10, 0x0d, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x75, 0x70, 0x72, 0x65, 0x6d,
-	0x61, 0x63, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x10, 0x0e,
-	0x12, 0x18, 0x0a, 0x14, 0x53, 0x75, 0x70, 0x72, 0x65, 0x6d, 0x61, 0x63, 0x79, 0x4d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x10, 0x0f, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x75,
-	0x70, 0x72, 0x65, 0x6d, 0x61, 0x63, 0x79, 0x53, 0x79, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65,
-	0x10, 0x10, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x75, 0x70, 0x72, 0x65, 0x6d, 0x61, 0x63, 0x79, 0x53,
-	0x79, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x10, 0x11,
-	0x12, 0x13, 0x0a, 0x0f, 0x53, 0x75, 0x70, 0x72, 0x65, 0x6d, 0x61, 0x63, 0x79, 0x42, 0x61, 0x74,
-	0x74, 0x6c, 0x65, 0x10, 0x12, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x75, 0x70, 0x72, 0x65, 0x6d, 0x61,
-	0x63, 0x79, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x10, 0x13,
+	0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x52, 0x0f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x22, 0x52, 0x0a, 0x0f, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xe9, 0x02, 0x0a, 0x0f, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x41, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x21, 0x0a, 0x0c, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x91, 0x01, 0x0a, 0x0c, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3e, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x08, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x22, 0x48, 0x0a, 0x1c, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22,
+	0x75, 0x0a, 0x1d, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3c, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x1d, 0x0a, 0x1b, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5e, 0x0a, 0x1c, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x2e, 0x0a, 0x1c, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1f, 0x0a, 0x1d, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x95, 0x01, 0x0a, 0x1c, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x61,
+	0x0a, 0x1d, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x40, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x22, 0x2d, 0x0a, 0x1b, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x5c, 0x0a, 0x1c, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3c, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x22, 0x84,
+	0x01, 0x0a, 0x0b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x34,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c,
+	0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x76, 0x69, 0x6f, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x48, 0x0a, 0x0e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x69,
+	0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2a,
+	0x97, 0x01, 0x0a, 0x15, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77,
+	0x6e, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x10, 0x01, 0x12, 0x17, 0x0a,
+	0x13, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x50, 0x6f,
+	0x73, 0x74, 0x65, 0x64, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x56, 0x6f, 0x69, 0x64, 0x65, 0x64, 0x10, 0x03, 0x12,
+	0x18, 0x0a, 0x14, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x10, 0x04, 0x2a, 0x94, 0x07, 0x0a, 0x0c, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x55, 0x6e,
+	0x75, 0x73, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x01, 0x12,
+	0x0a, 0x0a, 0x06, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x57,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x10, 0x05, 0x12, 0x12, 0x0a, 0x0e, 0x57, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x10, 0x06, 0x12, 0x10, 0x0a,
+	0x0c, 0x53, 0x75, 0x70, 0x73, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x10, 0x07, 0x12,
+	0x16, 0x0a, 0x12, 0x53, 0x75, 0x70, 0x73, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x52,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x10, 0x08, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x10, 0x09, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e,
+	0x64, 0x10, 0x0a, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x73, 0x73, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x46, 0x65, 0x65, 0x10, 0x0b, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x46, 0x65, 0x65, 0x52, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x10, 0x0c, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x75, 0x70, 0x72, 0x65, 0x6d, 0x61,
+	0x63, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x10,
+	0x0d, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x75, 0x70, 0x72, 0x65, 0x6d, 0x61, 0x63, 0x79, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x10, 0x0e, 0x12, 0x18, 0x0a, 0x14, 0x53,
+	0x75, 0x70, 0x72, 0x65, 0x6d, 0x61, 0x63, 0x79, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x10, 0x0f, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x75, 0x70, 0x72, 0x65, 0x6d, 0x61,
+	0x63, 0x79, 0x53, 0x79, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x10, 0x10, 0x12, 0x1c, 0x0a,
+	0x18, 0x53, 0x75, 0x70, 0x72, 0x65, 0x6d, 0x61, 0x63, 0x79, 0x53, 0x79, 0x6e, 0x64, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x10, 0x11, 0x12, 0x13, 0x0a, 0x0f, 0x53,
+	0x75, 0x70, 0x72, 0x65, 0x6d, 0x61, 0x63, 0x79, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x10, 0x12,
 	0x12, 0x19, 0x0a, 0x15, 0x53, 0x75, 0x70, 0x72, 0x65, 0x6d, 0x61, 0x63, 0x79, 0x42, 0x61, 0x74,
-	0x74, 0x6c, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x10, 0x14, 0x12, 0x1b, 0x0a, 0x17, 0x53,
-	0x75, 0x70, 0x72, 0x65, 0x6d, 0x61, 0x63, 0x79, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x4c, 0x6f,
-	0x62, 0x62, 0x79, 0x46, 0x65, 0x65, 0x10, 0x15, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x75, 0x70, 0x72,
-	0x65, 0x6d, 0x61, 0x63, 0x79, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79,
-	0x46, 0x65, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x10, 0x16, 0x12, 0x1c, 0x0a, 0x18, 0x53,
-	0x75, 0x70, 0x72, 0x65, 0x6d, 0x61, 0x63, 0x79, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x4c, 0x6f,
-	0x62, 0x62, 0x79, 0x4a, 0x6f, 0x69, 0x6e, 0x10, 0x17, 0x12, 0x22, 0x0a, 0x1e, 0x53, 0x75, 0x70,
-	0x72, 0x65, 0x6d, 0x61, 0x63, 0x79, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x4c, 0x6f, 0x62, 0x62,
-	0x79, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x10, 0x18, 0x12, 0x0a, 0x0a,
-	0x06, 0x43, 0x6f, 0x75, 0x70, 0x6f, 0x6e, 0x10, 0x19, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x75, 0x70,
-	0x72, 0x65, 0x6d, 0x61, 0x63, 0x79, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63,
-	0x65, 0x42, 0x75, 0x79, 0x10, 0x1a, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x75, 0x70, 0x72, 0x65, 0x6d,
-	0x61, 0x63, 0x79, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x42, 0x75,
-	0x79, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x10, 0x1b, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x75, 0x70,
-	0x72, 0x65, 0x6d, 0x61, 0x63, 0x79, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63,
-	0x65, 0x42, 0x69, 0x64, 0x10, 0x1c, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x75, 0x70, 0x72, 0x65, 0x6d,
-	0x61, 0x63, 0x79, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x42, 0x69,
-	0x64, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x10, 0x1d, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x75, 0x70,
-	0x72, 0x65, 0x6d, 0x61, 0x63, 0x79, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x10, 0x1e, 0x12, 0x19,
-	0x0a, 0x15, 0x53, 0x75, 0x70, 0x72, 0x65, 0x6d, 0x61, 0x63, 0x79, 0x52, 0x65, 0x70, 0x61, 0x69,
-	0x72, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x10, 0x1f, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x75, 0x70,
-	0x72, 0x65, 0x6d, 0x61, 0x63, 0x79, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x10, 0x20, 0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x75, 0x70, 0x72, 0x65, 0x6d, 0x61, 0x63,
-	0x79, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66,
-	0x75, 0x6e, 0x64, 0x10, 0x21, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x75, 0x70, 0x72, 0x65, 0x6d, 0x61,
-	0x63, 0x79, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x46, 0x65, 0x65,
-	0x10, 0x22, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x75, 0x70, 0x72, 0x65, 0x6d, 0x61, 0x63, 0x79, 0x4d,
-	0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x66,
-	0x75, 0x6e, 0x64, 0x10, 0x23, 0x2a, 0x28, 0x0a, 0x06, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12,
-	0x14, 0x0a, 0x10, 0x55, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x43,
-	0x6f, 0x64, 0x65, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x55, 0x50, 0x53, 0x10, 0x01, 0x2a,
-	0x69, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x12,
-	0x0a, 0x0e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e,
-	0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x6f, 0x74, 0x10, 0x04, 0x32, 0xa1, 0x04, 0x0a, 0x08, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x6a, 0x0a, 0x11, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x47, 0x65, 0x74, 0x56, 0x69, 0x61, 0x55, 0x73, 0x65, 0x72, 0x12, 0x29, 0x2e, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x47, 0x65, 0x74, 0x56, 0x69, 0x61, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x47, 0x65, 0x74, 0x56, 0x69, 0x61, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x55,
-	0x73, 0x65, 0x72, 0x12, 0x24, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x73, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x55, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x22,
+	0x74, 0x6c, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x10, 0x13, 0x12, 0x19, 0x0a, 0x15, 0x53,
+	0x75, 0x70, 0x72, 0x65, 0x6d, 0x61, 0x63, 0x79, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x10, 0x14, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x75, 0x70, 0x72, 0x65, 0x6d,
+	0x61, 0x63, 0x79, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x46, 0x65,
+	0x65, 0x10, 0x15, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x75, 0x70, 0x72, 0x65, 0x6d, 0x61, 0x63, 0x79,
+	0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x46, 0x65, 0x65, 0x52, 0x65,
+	0x66, 0x75, 0x6e, 0x64, 0x10, 0x16, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x75, 0x70, 0x72, 0x65, 0x6d,
+	0x61, 0x63, 0x79, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4a, 0x6f,
+	0x69, 0x6e, 0x10, 0x17, 0x12, 0x22, 0x0a, 0x1e, 0x53, 0x75, 0x70, 0x72, 0x65, 0x6d, 0x61, 0x63,
+	0x79, 0x42, 0x61, 0x74, 0x74, 0x6c, 0x65, 0x4c, 0x6f, 0x62, 0x62, 0x79, 0x4a, 0x6f, 0x69, 0x6e,
+	0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x10, 0x18, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x6f, 0x75, 0x70,
+	0x6f, 0x6e, 0x10, 0x19, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x75, 0x70, 0x72, 0x65, 0x6d, 0x61, 0x63,
+	0x79, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x42, 0x75, 0x79, 0x10,
+	0x1a, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x75, 0x70, 0x72, 0x65, 0x6d, 0x61, 0x63, 0x79, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x42, 0x75, 0x79, 0x52, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x10, 0x1b, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x75, 0x70, 0x72, 0x65, 0x6d, 0x61, 0x63,
+	0x79, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x42, 0x69, 0x64, 0x10,
+	0x1c, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x75, 0x70, 0x72, 0x65, 0x6d, 0x61, 0x63, 0x79, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x42, 0x69, 0x64, 0x52, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x10, 0x1d, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x75, 0x70, 0x72, 0x65, 0x6d, 0x61, 0x63,
+	0x79, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x10, 0x1e, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x75, 0x70,
+	0x72, 0x65, 0x6d, 0x61, 0x63, 0x79, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x52, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x10, 0x1f, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x75, 0x70, 0x72, 0x65, 0x6d, 0x61, 0x63,
+	0x79, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x20, 0x12,
+	0x1f, 0x0a, 0x1b, 0x53, 0x75, 0x70, 0x72, 0x65, 0x6d, 0x61, 0x63, 0x79, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x10, 0x21,
+	0x12, 0x1b, 0x0a, 0x17, 0x53, 0x75, 0x70, 0x72, 0x65, 0x6d, 0x61, 0x63, 0x79, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x46, 0x65, 0x65, 0x10, 0x22, 0x12, 0x21, 0x0a,
+	0x1d, 0x53, 0x75, 0x70, 0x72, 0x65, 0x6d, 0x61, 0x63, 0x79, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x46, 0x65, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x10, 0x23,
+	0x2a, 0x28, 0x0a, 0x06, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x6e,
+	0x75, 0x73, 0x65, 0x64, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x10, 0x00,
+	0x12, 0x08, 0x0a, 0x04, 0x53, 0x55, 0x50, 0x53, 0x10, 0x01, 0x2a, 0x69, 0x0a, 0x0b, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x12, 0x0a,
+	0x0e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x10,
+	0x01, 0x12, 0x11, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x42, 0x6f, 0x74, 0x10, 0x04, 0x2a, 0x60, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x14, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x6e, 0x79, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x10, 0x01, 0x12, 0x18, 0x0a,
+	0x14, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x10, 0x02, 0x2a, 0x6e, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a,
+	0x19, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x65, 0x62, 0x69, 0x74, 0x10, 0x02, 0x2a, 0x9e, 0x01, 0x0a, 0x15, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x20, 0x0a, 0x1c, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77,
+	0x6e, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x50, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x44, 0x65, 0x61, 0x64, 0x10, 0x03, 0x2a, 0xeb, 0x05, 0x0a, 0x0b, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00,
+	0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x49,
+	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x10, 0x01,
+	0x12, 0x1c, 0x0a, 0x18, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x49,
+	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x10, 0x02, 0x12, 0x18,
+	0x0a, 0x14, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x49, 0x6e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x49, 0x44, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x10,
+	0x04, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x10,
+	0x05, 0x12, 0x22, 0x0a, 0x1e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x74, 0x46, 0x6f,
+	0x75, 0x6e, 0x64, 0x10, 0x06, 0x12, 0x26, 0x0a, 0x22, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x10, 0x07, 0x12, 0x1d, 0x0a,
+	0x19, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x54, 0x6f, 0x53, 0x65, 0x6c, 0x66, 0x10, 0x08, 0x12, 0x1d, 0x0a, 0x19,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x4c, 0x65, 0x64, 0x67, 0x65,
+	0x72, 0x4d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x10, 0x09, 0x12, 0x20, 0x0a, 0x1c, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x75, 0x66, 0x66,
+	0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x64, 0x73, 0x10, 0x0a, 0x12, 0x21, 0x0a,
+	0x1d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x44, 0x65, 0x62, 0x69,
+	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x10, 0x0b,
+	0x12, 0x22, 0x0a, 0x1e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x43,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x72, 0x6f, 0x7a,
+	0x65, 0x6e, 0x10, 0x0c, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x44, 0x65, 0x62, 0x69, 0x74, 0x73, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x10, 0x0d, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x10, 0x0e, 0x12, 0x24, 0x0a, 0x20, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x45, 0x78, 0x63, 0x65, 0x65, 0x64, 0x73, 0x4f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x10, 0x0f, 0x12, 0x28, 0x0a, 0x24, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x4e, 0x6f, 0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x61, 0x62, 0x6c,
+	0x65, 0x10, 0x10, 0x12, 0x26, 0x0a, 0x22, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x10, 0x11, 0x12, 0x23, 0x0a, 0x1f, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x65, 0x6d, 0x70, 0x6f,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x75, 0x73, 0x65, 0x64, 0x10, 0x12,
+	0x12, 0x1c, 0x0a, 0x18, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x41,
+	0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x10, 0x13, 0x12, 0x1f,
+	0x0a, 0x1b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x50, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x10, 0x14, 0x12,
+	0x25, 0x0a, 0x21, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x50, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x45, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x64, 0x10, 0x15, 0x32, 0xfc, 0x08, 0x0a, 0x08, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x12, 0x6a, 0x0a, 0x11, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x47, 0x65,
+	0x74, 0x56, 0x69, 0x61, 0x55, 0x73, 0x65, 0x72, 0x12, 0x29, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x47, 0x65, 0x74, 0x56, 0x69, 0x61, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x47, 0x65, 0x74,
+	0x56, 0x69, 0x61, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5b, 0x0a, 0x0c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x24, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0a,
+	0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x22, 0x2e, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x41, 0x74, 0x12, 0x24, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x41, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x6d, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x47,
+	0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x12, 0x2a, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x85, 0x01, 0x0a, 0x1a, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x47, 0x65, 0x74, 0x42, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x32,
 	0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x12, 0x2a, 0x2e,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x47, 0x65, 0x74,
+	0x42, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x33, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x47, 0x65, 0x74, 0x42, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x47, 0x65, 0x74, 0x12, 0x24, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
 	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x65, 0x74, 0x42, 0x79,
-	0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x65, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x1a, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x47, 0x65, 0x74, 0x42, 0x79, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x32, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x0f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46,
+	0x6c, 0x61, 0x67, 0x73, 0x53, 0x65, 0x74, 0x12, 0x27, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x28, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x53,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x0d, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x12, 0x25, 0x2e, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x72, 0x65, 0x65,
+	0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x0f, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x12, 0x27, 0x2e,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x73, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x46, 0x72, 0x65, 0x65, 0x7a,
+	0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x2c, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x46, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xdc, 0x04, 0x0a, 0x08, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x73, 0x12, 0x76, 0x0a, 0x15, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x2e, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x14, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x2c, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2d, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x76, 0x0a, 0x15, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x2d, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x15, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x2d, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2e, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x73, 0x0a, 0x14, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x52, 0x65, 0x74, 0x72, 0x79, 0x12, 0x2c, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x32, 0x94, 0x06, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x12, 0x61, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x57,
+	0x69, 0x74, 0x68, 0x49, 0x44, 0x12, 0x26, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
 	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x47, 0x65, 0x74, 0x42, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x47, 0x65, 0x74, 0x42, 0x79, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xc9,
-	0x02, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x61, 0x0a,
-	0x0e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x57, 0x69, 0x74, 0x68, 0x49, 0x44, 0x12,
+	0x57, 0x69, 0x74, 0x68, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x57, 0x69, 0x74, 0x68, 0x49, 0x44, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x08, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x12, 0x20, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x25, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
 	0x26, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x57, 0x69, 0x74, 0x68, 0x49, 0x44,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x57, 0x69, 0x74, 0x68, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x4f, 0x0a, 0x08, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x12, 0x20, 0x2e, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
-	0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x86, 0x01, 0x0a, 0x19, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x43, 0x6f,
-	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12,
-	0x31, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76,
-	0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
-	0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x32, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x43, 0x6f, 0x6d,
-	0x70, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42, 0x36, 0x5a, 0x34, 0x78, 0x73,
-	0x79, 0x6e, 0x2d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
-	0x67, 0x65, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x2f, 0x76, 0x31, 0x3b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x12, 0x27, 0x2e, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a,
+	0x0c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x24, 0x2e,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0c, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x56, 0x6f, 0x69, 0x64, 0x12, 0x24, 0x2e, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x56, 0x6f, 0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x56, 0x6f, 0x69, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x06, 0x52, 0x65, 0x66, 0x75, 0x6e,
+	0x64, 0x12, 0x1e, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1f, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x86, 0x01, 0x0a, 0x19, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x12, 0x31, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42, 0x36, 0x5a, 0x34, 0x78,
+	0x73, 0x79, 0x6e, 0x2d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2f, 0x76, 0x31, 0x3b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1836,75 +5801,209 @@ func file_transactions_v1_transactions_proto_rawDescGZIP() []byte {
 	return file_transactions_v1_transactions_proto_rawDescData
 }
 
-var file_transactions_v1_transactions_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_transactions_v1_transactions_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_transactions_v1_transactions_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_transactions_v1_transactions_proto_msgTypes = make([]protoimpl.MessageInfo, 61)
 var file_transactions_v1_transactions_proto_goTypes = []interface{}{
-	(TransferCode)(0),                          // 0: transactions.v1.TransferCode
-	(Ledger)(0),                                // 1: transactions.v1.Ledger
-	(AccountCode)(0),                           // 2: transactions.v1.AccountCode
-	(*Account)(nil),                            // 3: transactions.v1.Account
-	(*MigrationTransfer)(nil),                  // 4: transactions.v1.MigrationTransfer
-	(*CompletedTransfer)(nil),                  // 5: transactions.v1.CompletedTransfer
-	(*AccountGetViaUserRequest)(nil),           // 6: transactions.v1.AccountGetViaUserRequest
-	(*AccountGetViaUserResponse)(nil),          // 7: transactions.v1.AccountGetViaUserResponse
-	(*AccountsUserRequest)(nil),                // 8: transactions.v1.AccountsUserRequest
-	(*AccountsUserResponse)(nil),               // 9: transactions.v1.AccountsUserResponse
-	(*GetBalanceRequest)(nil),                  // 10: transactions.v1.GetBalanceRequest
-	(*GetBalanceResponse)(nil),                 // 11: transactions.v1.GetBalanceResponse
-	(*TransactionGetByIDRequest)(nil),          // 12: transactions.v1.TransactionGetByIDRequest
-	(*TransactionGetByIDResponse)(nil),         // 13: transactions.v1.TransactionGetByIDResponse
-	(*TransactionsGetByAccountIDRequest)(nil),  // 14: transactions.v1.TransactionsGetByAccountIDRequest
-	(*TransactionsGetByAccountIDResponse)(nil), // 15: transactions.v1.TransactionsGetByAccountIDResponse
-	(*TransactWithIDRequest)(nil),              // 16: transactions.v1.TransactWithIDRequest
-	(*TransactWithIDResponse)(nil),             // 17: transactions.v1.TransactWithIDResponse
-	(*TransactRequest)(nil),                    // 18: transactions.v1.TransactRequest
-	(*TransactResponse)(nil),                   // 19: transactions.v1.TransactResponse
-	(*TransferCompleteSubscribeRequest)(nil),   // 20: transactions.v1.TransferCompleteSubscribeRequest
-	(*TransferCompleteSubscribeResponse)(nil),  // 21: transactions.v1.TransferCompleteSubscribeResponse
+	(PendingTransferStatus)(0),                 // 0: transactions.v1.PendingTransferStatus
+	(TransferCode)(0),                          // 1: transactions.v1.TransferCode
+	(Ledger)(0),                                // 2: transactions.v1.Ledger
+	(AccountCode)(0),                           // 3: transactions.v1.AccountCode
+	(TransferDirection)(0),                     // 4: transactions.v1.TransferDirection
+	(StatementDirection)(0),                    // 5: transactions.v1.StatementDirection
+	(WebhookDeliveryStatus)(0),                 // 6: transactions.v1.WebhookDeliveryStatus
+	(ErrorReason)(0),                           // 7: transactions.v1.ErrorReason
+	(*Account)(nil),                            // 8: transactions.v1.Account
+	(*AccountFreeze)(nil),                      // 9: transactions.v1.AccountFreeze
+	(*MigrationTransfer)(nil),                  // 10: transactions.v1.MigrationTransfer
+	(*CompletedTransfer)(nil),                  // 11: transactions.v1.CompletedTransfer
+	(*PendingTransfer)(nil),                    // 12: transactions.v1.PendingTransfer
+	(*AccountGetViaUserRequest)(nil),           // 13: transactions.v1.AccountGetViaUserRequest
+	(*AccountGetViaUserResponse)(nil),          // 14: transactions.v1.AccountGetViaUserResponse
+	(*AccountsUserRequest)(nil),                // 15: transactions.v1.AccountsUserRequest
+	(*AccountsUserResponse)(nil),               // 16: transactions.v1.AccountsUserResponse
+	(*GetBalanceRequest)(nil),                  // 17: transactions.v1.GetBalanceRequest
+	(*GetBalanceResponse)(nil),                 // 18: transactions.v1.GetBalanceResponse
+	(*GetBalanceAtRequest)(nil),                // 19: transactions.v1.GetBalanceAtRequest
+	(*GetBalanceAtResponse)(nil),               // 20: transactions.v1.GetBalanceAtResponse
+	(*TransactionGetByIDRequest)(nil),          // 21: transactions.v1.TransactionGetByIDRequest
+	(*TransactionGetByIDResponse)(nil),         // 22: transactions.v1.TransactionGetByIDResponse
+	(*TransactionsGetByAccountIDRequest)(nil),  // 23: transactions.v1.TransactionsGetByAccountIDRequest
+	(*TransactionsGetByAccountIDResponse)(nil), // 24: transactions.v1.TransactionsGetByAccountIDResponse
+	(*StatementGetRequest)(nil),                // 25: transactions.v1.StatementGetRequest
+	(*StatementGetResponse)(nil),               // 26: transactions.v1.StatementGetResponse
+	(*Statement)(nil),                          // 27: transactions.v1.Statement
+	(*StatementLine)(nil),                      // 28: transactions.v1.StatementLine
+	(*StatementSubtotal)(nil),                  // 29: transactions.v1.StatementSubtotal
+	(*AccountFlagsSetRequest)(nil),             // 30: transactions.v1.AccountFlagsSetRequest
+	(*AccountFlagsSetResponse)(nil),            // 31: transactions.v1.AccountFlagsSetResponse
+	(*AccountFreezeRequest)(nil),               // 32: transactions.v1.AccountFreezeRequest
+	(*AccountFreezeResponse)(nil),              // 33: transactions.v1.AccountFreezeResponse
+	(*AccountUnfreezeRequest)(nil),             // 34: transactions.v1.AccountUnfreezeRequest
+	(*AccountUnfreezeResponse)(nil),            // 35: transactions.v1.AccountUnfreezeResponse
+	(*AccountFreezeHistoryRequest)(nil),        // 36: transactions.v1.AccountFreezeHistoryRequest
+	(*AccountFreezeHistoryResponse)(nil),       // 37: transactions.v1.AccountFreezeHistoryResponse
+	(*TransactWithIDRequest)(nil),              // 38: transactions.v1.TransactWithIDRequest
+	(*TransactWithIDResponse)(nil),             // 39: transactions.v1.TransactWithIDResponse
+	(*TransactRequest)(nil),                    // 40: transactions.v1.TransactRequest
+	(*TransactResponse)(nil),                   // 41: transactions.v1.TransactResponse
+	(*TransactBatchRequest)(nil),               // 42: transactions.v1.TransactBatchRequest
+	(*TransactBatchResponse)(nil),              // 43: transactions.v1.TransactBatchResponse
+	(*TransferReserveRequest)(nil),             // 44: transactions.v1.TransferReserveRequest
+	(*TransferReserveResponse)(nil),            // 45: transactions.v1.TransferReserveResponse
+	(*TransferPostRequest)(nil),                // 46: transactions.v1.TransferPostRequest
+	(*TransferPostResponse)(nil),               // 47: transactions.v1.TransferPostResponse
+	(*TransferVoidRequest)(nil),                // 48: transactions.v1.TransferVoidRequest
+	(*TransferVoidResponse)(nil),               // 49: transactions.v1.TransferVoidResponse
+	(*RefundRequest)(nil),                      // 50: transactions.v1.RefundRequest
+	(*RefundResponse)(nil),                     // 51: transactions.v1.RefundResponse
+	(*TransferCompleteSubscribeRequest)(nil),   // 52: transactions.v1.TransferCompleteSubscribeRequest
+	(*TransferCompleteSubscribeResponse)(nil),  // 53: transactions.v1.TransferCompleteSubscribeResponse
+	(*WebhookEndpoint)(nil),                    // 54: transactions.v1.WebhookEndpoint
+	(*WebhookDelivery)(nil),                    // 55: transactions.v1.WebhookDelivery
+	(*WebhookEvent)(nil),                       // 56: transactions.v1.WebhookEvent
+	(*WebhookEndpointCreateRequest)(nil),       // 57: transactions.v1.WebhookEndpointCreateRequest
+	(*WebhookEndpointCreateResponse)(nil),      // 58: transactions.v1.WebhookEndpointCreateResponse
+	(*WebhookEndpointsListRequest)(nil),        // 59: transactions.v1.WebhookEndpointsListRequest
+	(*WebhookEndpointsListResponse)(nil),       // 60: transactions.v1.WebhookEndpointsListResponse
+	(*WebhookEndpointDeleteRequest)(nil),       // 61: transactions.v1.WebhookEndpointDeleteRequest
+	(*WebhookEndpointDeleteResponse)(nil),      // 62: transactions.v1.WebhookEndpointDeleteResponse
+	(*WebhookDeliveriesListRequest)(nil),       // 63: transactions.v1.WebhookDeliveriesListRequest
+	(*WebhookDeliveriesListResponse)(nil),      // 64: transactions.v1.WebhookDeliveriesListResponse
+	(*WebhookDeliveryRetryRequest)(nil),        // 65: transactions.v1.WebhookDeliveryRetryRequest
+	(*WebhookDeliveryRetryResponse)(nil),       // 66: transactions.v1.WebhookDeliveryRetryResponse
+	(*ErrorDetail)(nil),                        // 67: transactions.v1.ErrorDetail
+	(*FieldViolation)(nil),                     // 68: transactions.v1.FieldViolation
+	(*structpb.Struct)(nil),                    // 69: google.protobuf.Struct
 }
 var file_transactions_v1_transactions_proto_depIdxs = []int32{
-	1,  // 0: transactions.v1.Account.ledger:type_name -> transactions.v1.Ledger
-	2,  // 1: transactions.v1.Account.code:type_name -> transactions.v1.AccountCode
-	1,  // 2: transactions.v1.MigrationTransfer.ledger:type_name -> transactions.v1.Ledger
-	0,  // 3: transactions.v1.MigrationTransfer.code:type_name -> transactions.v1.TransferCode
-	1,  // 4: transactions.v1.CompletedTransfer.ledger:type_name -> transactions.v1.Ledger
-	0,  // 5: transactions.v1.CompletedTransfer.code:type_name -> transactions.v1.TransferCode
-	1,  // 6: transactions.v1.AccountGetViaUserRequest.ledger:type_name -> transactions.v1.Ledger
-	3,  // 7: transactions.v1.AccountGetViaUserResponse.account:type_name -> transactions.v1.Account
-	1,  // 8: transactions.v1.AccountsUserRequest.create_if_not_exist:type_name -> transactions.v1.Ledger
-	3,  // 9: transactions.v1.AccountsUserResponse.accounts:type_name -> transactions.v1.Account
-	1,  // 10: transactions.v1.GetBalanceRequest.ledger:type_name -> transactions.v1.Ledger
-	5,  // 11: transactions.v1.TransactionGetByIDResponse.transaction:type_name -> transactions.v1.CompletedTransfer
-	5,  // 12: transactions.v1.TransactionsGetByAccountIDResponse.transactions:type_name -> transactions.v1.CompletedTransfer
-	0,  // 13: transactions.v1.TransactWithIDRequest.code:type_name -> transactions.v1.TransferCode
-	1,  // 14: transactions.v1.TransactWithIDRequest.ledger:type_name -> transactions.v1.Ledger
-	5,  // 15: transactions.v1.TransactWithIDResponse.transfer:type_name -> transactions.v1.CompletedTransfer
-	0,  // 16: transactions.v1.TransactRequest.code:type_name -> transactions.v1.TransferCode
-	1,  // 17: transactions.v1.TransactRequest.ledger:type_name -> transactions.v1.Ledger
-	5,  // 18: transactions.v1.TransactResponse.transfer:type_name -> transactions.v1.CompletedTransfer
-	3,  // 19: transactions.v1.TransferCompleteSubscribeResponse.account:type_name -> transactions.v1.Account
-	5,  // 20: transactions.v1.TransferCompleteSubscribeResponse.transaction:type_name -> transactions.v1.CompletedTransfer
-	6,  // 21: transactions.v1.Accounts.AccountGetViaUser:input_type -> transactions.v1.AccountGetViaUserRequest
-	8,  // 22: transactions.v1.Accounts.AccountsUser:input_type -> transactions.v1.AccountsUserRequest
-	10, // 23: transactions.v1.Accounts.GetBalance:input_type -> transactions.v1.GetBalanceRequest
-	12, // 24: transactions.v1.Accounts.TransactionGetByID:input_type -> transactions.v1.TransactionGetByIDRequest
-	14, // 25: transactions.v1.Accounts.TransactionsGetByAccountID:input_type -> transactions.v1.TransactionsGetByAccountIDRequest
-	16, // 26: transactions.v1.Transactor.TransactWithID:input_type -> transactions.v1.TransactWithIDRequest
-	18, // 27: transactions.v1.Transactor.Transact:input_type -> transactions.v1.TransactRequest
-	20, // 28: transactions.v1.Transactor.TransferCompleteSubscribe:input_type -> transactions.v1.TransferCompleteSubscribeRequest
-	7,  // 29: transactions.v1.Accounts.AccountGetViaUser:output_type -> transactions.v1.AccountGetViaUserResponse
-	9,  // 30: transactions.v1.Accounts.AccountsUser:output_type -> transactions.v1.AccountsUserResponse
-	11, // 31: transactions.v1.Accounts.GetBalance:output_type -> transactions.v1.GetBalanceResponse
-	13, // 32: transactions.v1.Accounts.TransactionGetByID:output_type -> transactions.v1.TransactionGetByIDResponse
-	15, // 33: transactions.v1.Accounts.TransactionsGetByAccountID:output_type -> transactions.v1.TransactionsGetByAccountIDResponse
-	17, // 34: transactions.v1.Transactor.TransactWithID:output_type -> transactions.v1.TransactWithIDResponse
-	19, // 35: transactions.v1.Transactor.Transact:output_type -> transactions.v1.TransactResponse
-	21, // 36: transactions.v1.Transactor.TransferCompleteSubscribe:output_type -> transactions.v1.TransferCompleteSubscribeResponse
-	29, // [29:37] is the sub-list for method output_type
-	21, // [21:29] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	2,  // 0: transactions.v1.Account.ledger:type_name -> transactions.v1.Ledger
+	3,  // 1: transactions.v1.Account.code:type_name -> transactions.v1.AccountCode
+	2,  // 2: transactions.v1.MigrationTransfer.ledger:type_name -> transactions.v1.Ledger
+	1,  // 3: transactions.v1.MigrationTransfer.code:type_name -> transactions.v1.TransferCode
+	2,  // 4: transactions.v1.CompletedTransfer.ledger:type_name -> transactions.v1.Ledger
+	1,  // 5: transactions.v1.CompletedTransfer.code:type_name -> transactions.v1.TransferCode
+	69, // 6: transactions.v1.CompletedTransfer.metadata:type_name -> google.protobuf.Struct
+	2,  // 7: transactions.v1.PendingTransfer.ledger:type_name -> transactions.v1.Ledger
+	1,  // 8: transactions.v1.PendingTransfer.code:type_name -> transactions.v1.TransferCode
+	0,  // 9: transactions.v1.PendingTransfer.status:type_name -> transactions.v1.PendingTransferStatus
+	69, // 10: transactions.v1.PendingTransfer.metadata:type_name -> google.protobuf.Struct
+	2,  // 11: transactions.v1.AccountGetViaUserRequest.ledger:type_name -> transactions.v1.Ledger
+	8,  // 12: transactions.v1.AccountGetViaUserResponse.account:type_name -> transactions.v1.Account
+	2,  // 13: transactions.v1.AccountsUserRequest.create_if_not_exist:type_name -> transactions.v1.Ledger
+	8,  // 14: transactions.v1.AccountsUserResponse.accounts:type_name -> transactions.v1.Account
+	2,  // 15: transactions.v1.GetBalanceRequest.ledger:type_name -> transactions.v1.Ledger
+	2,  // 16: transactions.v1.GetBalanceAtRequest.ledger:type_name -> transactions.v1.Ledger
+	11, // 17: transactions.v1.TransactionGetByIDResponse.transaction:type_name -> transactions.v1.CompletedTransfer
+	11, // 18: transactions.v1.TransactionGetByIDResponse.refunds:type_name -> transactions.v1.CompletedTransfer
+	1,  // 19: transactions.v1.TransactionsGetByAccountIDRequest.codes:type_name -> transactions.v1.TransferCode
+	4,  // 20: transactions.v1.TransactionsGetByAccountIDRequest.direction:type_name -> transactions.v1.TransferDirection
+	69, // 21: transactions.v1.TransactionsGetByAccountIDRequest.metadata:type_name -> google.protobuf.Struct
+	11, // 22: transactions.v1.TransactionsGetByAccountIDResponse.transactions:type_name -> transactions.v1.CompletedTransfer
+	2,  // 23: transactions.v1.StatementGetRequest.ledger:type_name -> transactions.v1.Ledger
+	27, // 24: transactions.v1.StatementGetResponse.statement:type_name -> transactions.v1.Statement
+	2,  // 25: transactions.v1.Statement.ledger:type_name -> transactions.v1.Ledger
+	28, // 26: transactions.v1.Statement.lines:type_name -> transactions.v1.StatementLine
+	29, // 27: transactions.v1.Statement.subtotals:type_name -> transactions.v1.StatementSubtotal
+	11, // 28: transactions.v1.StatementLine.transfer:type_name -> transactions.v1.CompletedTransfer
+	5,  // 29: transactions.v1.StatementLine.direction:type_name -> transactions.v1.StatementDirection
+	1,  // 30: transactions.v1.StatementSubtotal.code:type_name -> transactions.v1.TransferCode
+	2,  // 31: transactions.v1.AccountFlagsSetRequest.ledger:type_name -> transactions.v1.Ledger
+	3,  // 32: transactions.v1.AccountFlagsSetRequest.code:type_name -> transactions.v1.AccountCode
+	8,  // 33: transactions.v1.AccountFlagsSetResponse.account:type_name -> transactions.v1.Account
+	2,  // 34: transactions.v1.AccountFreezeRequest.ledger:type_name -> transactions.v1.Ledger
+	8,  // 35: transactions.v1.AccountFreezeResponse.account:type_name -> transactions.v1.Account
+	9,  // 36: transactions.v1.AccountFreezeResponse.freeze:type_name -> transactions.v1.AccountFreeze
+	2,  // 37: transactions.v1.AccountUnfreezeRequest.ledger:type_name -> transactions.v1.Ledger
+	8,  // 38: transactions.v1.AccountUnfreezeResponse.account:type_name -> transactions.v1.Account
+	9,  // 39: transactions.v1.AccountUnfreezeResponse.freeze:type_name -> transactions.v1.AccountFreeze
+	2,  // 40: transactions.v1.AccountFreezeHistoryRequest.ledger:type_name -> transactions.v1.Ledger
+	9,  // 41: transactions.v1.AccountFreezeHistoryResponse.freezes:type_name -> transactions.v1.AccountFreeze
+	1,  // 42: transactions.v1.TransactWithIDRequest.code:type_name -> transactions.v1.TransferCode
+	2,  // 43: transactions.v1.TransactWithIDRequest.ledger:type_name -> transactions.v1.Ledger
+	69, // 44: transactions.v1.TransactWithIDRequest.metadata:type_name -> google.protobuf.Struct
+	11, // 45: transactions.v1.TransactWithIDResponse.transfer:type_name -> transactions.v1.CompletedTransfer
+	1,  // 46: transactions.v1.TransactRequest.code:type_name -> transactions.v1.TransferCode
+	2,  // 47: transactions.v1.TransactRequest.ledger:type_name -> transactions.v1.Ledger
+	69, // 48: transactions.v1.TransactRequest.metadata:type_name -> google.protobuf.Struct
+	11, // 49: transactions.v1.TransactResponse.transfer:type_name -> transactions.v1.CompletedTransfer
+	40, // 50: transactions.v1.TransactBatchRequest.transfers:type_name -> transactions.v1.TransactRequest
+	11, // 51: transactions.v1.TransactBatchResponse.transfers:type_name -> transactions.v1.CompletedTransfer
+	1,  // 52: transactions.v1.TransferReserveRequest.code:type_name -> transactions.v1.TransferCode
+	2,  // 53: transactions.v1.TransferReserveRequest.ledger:type_name -> transactions.v1.Ledger
+	69, // 54: transactions.v1.TransferReserveRequest.metadata:type_name -> google.protobuf.Struct
+	12, // 55: transactions.v1.TransferReserveResponse.pending_transfer:type_name -> transactions.v1.PendingTransfer
+	11, // 56: transactions.v1.TransferPostResponse.transfer:type_name -> transactions.v1.CompletedTransfer
+	12, // 57: transactions.v1.TransferPostResponse.pending_transfer:type_name -> transactions.v1.PendingTransfer
+	12, // 58: transactions.v1.TransferVoidResponse.pending_transfer:type_name -> transactions.v1.PendingTransfer
+	69, // 59: transactions.v1.RefundRequest.metadata:type_name -> google.protobuf.Struct
+	11, // 60: transactions.v1.RefundResponse.transfer:type_name -> transactions.v1.CompletedTransfer
+	2,  // 61: transactions.v1.TransferCompleteSubscribeRequest.ledgers:type_name -> transactions.v1.Ledger
+	1,  // 62: transactions.v1.TransferCompleteSubscribeRequest.codes:type_name -> transactions.v1.TransferCode
+	8,  // 63: transactions.v1.TransferCompleteSubscribeResponse.account:type_name -> transactions.v1.Account
+	11, // 64: transactions.v1.TransferCompleteSubscribeResponse.transaction:type_name -> transactions.v1.CompletedTransfer
+	12, // 65: transactions.v1.TransferCompleteSubscribeResponse.pending_transfer:type_name -> transactions.v1.PendingTransfer
+	6,  // 66: transactions.v1.WebhookDelivery.status:type_name -> transactions.v1.WebhookDeliveryStatus
+	11, // 67: transactions.v1.WebhookEvent.transfer:type_name -> transactions.v1.CompletedTransfer
+	54, // 68: transactions.v1.WebhookEndpointCreateResponse.endpoint:type_name -> transactions.v1.WebhookEndpoint
+	54, // 69: transactions.v1.WebhookEndpointsListResponse.endpoints:type_name -> transactions.v1.WebhookEndpoint
+	6,  // 70: transactions.v1.WebhookDeliveriesListRequest.status:type_name -> transactions.v1.WebhookDeliveryStatus
+	55, // 71: transactions.v1.WebhookDeliveriesListResponse.deliveries:type_name -> transactions.v1.WebhookDelivery
+	55, // 72: transactions.v1.WebhookDeliveryRetryResponse.delivery:type_name -> transactions.v1.WebhookDelivery
+	7,  // 73: transactions.v1.ErrorDetail.reason:type_name -> transactions.v1.ErrorReason
+	68, // 74: transactions.v1.ErrorDetail.violations:type_name -> transactions.v1.FieldViolation
+	13, // 75: transactions.v1.Accounts.AccountGetViaUser:input_type -> transactions.v1.AccountGetViaUserRequest
+	15, // 76: transactions.v1.Accounts.AccountsUser:input_type -> transactions.v1.AccountsUserRequest
+	17, // 77: transactions.v1.Accounts.GetBalance:input_type -> transactions.v1.GetBalanceRequest
+	19, // 78: transactions.v1.Accounts.GetBalanceAt:input_type -> transactions.v1.GetBalanceAtRequest
+	21, // 79: transactions.v1.Accounts.TransactionGetByID:input_type -> transactions.v1.TransactionGetByIDRequest
+	23, // 80: transactions.v1.Accounts.TransactionsGetByAccountID:input_type -> transactions.v1.TransactionsGetByAccountIDRequest
+	25, // 81: transactions.v1.Accounts.StatementGet:input_type -> transactions.v1.StatementGetRequest
+	30, // 82: transactions.v1.Accounts.AccountFlagsSet:input_type -> transactions.v1.AccountFlagsSetRequest
+	32, // 83: transactions.v1.Accounts.AccountFreeze:input_type -> transactions.v1.AccountFreezeRequest
+	34, // 84: transactions.v1.Accounts.AccountUnfreeze:input_type -> transactions.v1.AccountUnfreezeRequest
+	36, // 85: transactions.v1.Accounts.AccountFreezeHistory:input_type -> transactions.v1.AccountFreezeHistoryRequest
+	57, // 86: transactions.v1.Webhooks.WebhookEndpointCreate:input_type -> transactions.v1.WebhookEndpointCreateRequest
+	59, // 87: transactions.v1.Webhooks.WebhookEndpointsList:input_type -> transactions.v1.WebhookEndpointsListRequest
+	61, // 88: transactions.v1.Webhooks.WebhookEndpointDelete:input_type -> transactions.v1.WebhookEndpointDeleteRequest
+	63, // 89: transactions.v1.Webhooks.WebhookDeliveriesList:input_type -> transactions.v1.WebhookDeliveriesListRequest
+	65, // 90: transactions.v1.Webhooks.WebhookDeliveryRetry:input_type -> transactions.v1.WebhookDeliveryRetryRequest
+	38, // 91: transactions.v1.Transactor.TransactWithID:input_type -> transactions.v1.TransactWithIDRequest
+	40, // 92: transactions.v1.Transactor.Transact:input_type -> transactions.v1.TransactRequest
+	42, // 93: transactions.v1.Transactor.TransactBatch:input_type -> transactions.v1.TransactBatchRequest
+	44, // 94: transactions.v1.Transactor.TransferReserve:input_type -> transactions.v1.TransferReserveRequest
+	46, // 95: transactions.v1.Transactor.TransferPost:input_type -> transactions.v1.TransferPostRequest
+	48, // 96: transactions.v1.Transactor.TransferVoid:input_type -> transactions.v1.TransferVoidRequest
+	50, // 97: transactions.v1.Transactor.Refund:input_type -> transactions.v1.RefundRequest
+	52, // 98: transactions.v1.Transactor.TransferCompleteSubscribe:input_type -> transactions.v1.TransferCompleteSubscribeRequest
+	14, // 99: transactions.v1.Accounts.AccountGetViaUser:output_type -> transactions.v1.AccountGetViaUserResponse
+	16, // 100: transactions.v1.Accounts.AccountsUser:output_type -> transactions.v1.AccountsUserResponse
+	18, // 101: transactions.v1.Accounts.GetBalance:output_type -> transactions.v1.GetBalanceResponse
+	20, // 102: transactions.v1.Accounts.GetBalanceAt:output_type -> transactions.v1.GetBalanceAtResponse
+	22, // 103: transactions.v1.Accounts.TransactionGetByID:output_type -> transactions.v1.TransactionGetByIDResponse
+	24, // 104: transactions.v1.Accounts.TransactionsGetByAccountID:output_type -> transactions.v1.TransactionsGetByAccountIDResponse
+	26, // 105: transactions.v1.Accounts.StatementGet:output_type -> transactions.v1.StatementGetResponse
+	31, // 106: transactions.v1.Accounts.AccountFlagsSet:output_type -> transactions.v1.AccountFlagsSetResponse
+	33, // 107: transactions.v1.Accounts.AccountFreeze:output_type -> transactions.v1.AccountFreezeResponse
+	35, // 108: transactions.v1.Accounts.AccountUnfreeze:output_type -> transactions.v1.AccountUnfreezeResponse
+	37, // 109: transactions.v1.Accounts.AccountFreezeHistory:output_type -> transactions.v1.AccountFreezeHistoryResponse
+	58, // 110: transactions.v1.Webhooks.WebhookEndpointCreate:output_type -> transactions.v1.WebhookEndpointCreateResponse
+	60, // 111: transactions.v1.Webhooks.WebhookEndpointsList:output_type -> transactions.v1.WebhookEndpointsListResponse
+	62, // 112: transactions.v1.Webhooks.WebhookEndpointDelete:output_type -> transactions.v1.WebhookEndpointDeleteResponse
+	64, // 113: transactions.v1.Webhooks.WebhookDeliveriesList:output_type -> transactions.v1.WebhookDeliveriesListResponse
+	66, // 114: transactions.v1.Webhooks.WebhookDeliveryRetry:output_type -> transactions.v1.WebhookDeliveryRetryResponse
+	39, // 115: transactions.v1.Transactor.TransactWithID:output_type -> transactions.v1.TransactWithIDResponse
+	41, // 116: transactions.v1.Transactor.Transact:output_type -> transactions.v1.TransactResponse
+	43, // 117: transactions.v1.Transactor.TransactBatch:output_type -> transactions.v1.TransactBatchResponse
+	45, // 118: transactions.v1.Transactor.TransferReserve:output_type -> transactions.v1.TransferReserveResponse
+	47, // 119: transactions.v1.Transactor.TransferPost:output_type -> transactions.v1.TransferPostResponse
+	49, // 120: transactions.v1.Transactor.TransferVoid:output_type -> transactions.v1.TransferVoidResponse
+	51, // 121: transactions.v1.Transactor.Refund:output_type -> transactions.v1.RefundResponse
+	53, // 122: transactions.v1.Transactor.TransferCompleteSubscribe:output_type -> transactions.v1.TransferCompleteSubscribeResponse
+	99, // [99:123] is the sub-list for method output_type
+	75, // [75:99] is the sub-list for method input_type
+	75, // [75:75] is the sub-list for extension type_name
+	75, // [75:75] is the sub-list for extension extendee
+	0,  // [0:75] is the sub-list for field type_name
 }
 
 func init() { file_transactions_v1_transactions_proto_init() }
@@ -1925,8 +6024,260 @@ func file_transactions_v1_transactions_proto_init() {
 				return nil
 			}
 		}
-		file_transactions_v1_transactions_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MigrationTransfer); i {
+		file_transactions_v1_transactions_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountFreeze); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transactions_v1_transactions_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MigrationTransfer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transactions_v1_transactions_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompletedTransfer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transactions_v1_transactions_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingTransfer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transactions_v1_transactions_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountGetViaUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transactions_v1_transactions_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountGetViaUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transactions_v1_transactions_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountsUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transactions_v1_transactions_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountsUserResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transactions_v1_transactions_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBalanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transactions_v1_transactions_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBalanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transactions_v1_transactions_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBalanceAtRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transactions_v1_transactions_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBalanceAtResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transactions_v1_transactions_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionGetByIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transactions_v1_transactions_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionGetByIDResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transactions_v1_transactions_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionsGetByAccountIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transactions_v1_transactions_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionsGetByAccountIDResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transactions_v1_transactions_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatementGetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transactions_v1_transactions_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatementGetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transactions_v1_transactions_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Statement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transactions_v1_transactions_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatementLine); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transactions_v1_transactions_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatementSubtotal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transactions_v1_transactions_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountFlagsSetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1937,8 +6288,8 @@ func file_transactions_v1_transactions_proto_init() {
 				return nil
 			}
 		}
-		file_transactions_v1_transactions_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CompletedTransfer); i {
+		file_transactions_v1_transactions_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountFlagsSetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1949,8 +6300,8 @@ func file_transactions_v1_transactions_proto_init() {
 				return nil
 			}
 		}
-		file_transactions_v1_transactions_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccountGetViaUserRequest); i {
+		file_transactions_v1_transactions_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountFreezeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1961,8 +6312,8 @@ func file_transactions_v1_transactions_proto_init() {
 				return nil
 			}
 		}
-		file_transactions_v1_transactions_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccountGetViaUserResponse); i {
+		file_transactions_v1_transactions_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountFreezeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1973,8 +6324,8 @@ func file_transactions_v1_transactions_proto_init() {
 				return nil
 			}
 		}
-		file_transactions_v1_transactions_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccountsUserRequest); i {
+		file_transactions_v1_transactions_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountUnfreezeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1985,8 +6336,8 @@ func file_transactions_v1_transactions_proto_init() {
 				return nil
 			}
 		}
-		file_transactions_v1_transactions_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccountsUserResponse); i {
+		file_transactions_v1_transactions_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountUnfreezeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1997,8 +6348,8 @@ func file_transactions_v1_transactions_proto_init() {
 				return nil
 			}
 		}
-		file_transactions_v1_transactions_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBalanceRequest); i {
+		file_transactions_v1_transactions_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountFreezeHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2009,8 +6360,8 @@ func file_transactions_v1_transactions_proto_init() {
 				return nil
 			}
 		}
-		file_transactions_v1_transactions_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBalanceResponse); i {
+		file_transactions_v1_transactions_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountFreezeHistoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2021,8 +6372,8 @@ func file_transactions_v1_transactions_proto_init() {
 				return nil
 			}
 		}
-		file_transactions_v1_transactions_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransactionGetByIDRequest); i {
+		file_transactions_v1_transactions_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactWithIDRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2033,8 +6384,8 @@ func file_transactions_v1_transactions_proto_init() {
 				return nil
 			}
 		}
-		file_transactions_v1_transactions_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransactionGetByIDResponse); i {
+		file_transactions_v1_transactions_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactWithIDResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2045,8 +6396,8 @@ func file_transactions_v1_transactions_proto_init() {
 				return nil
 			}
 		}
-		file_transactions_v1_transactions_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransactionsGetByAccountIDRequest); i {
+		file_transactions_v1_transactions_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2057,8 +6408,8 @@ func file_transactions_v1_transactions_proto_init() {
 				return nil
 			}
 		}
-		file_transactions_v1_transactions_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransactionsGetByAccountIDResponse); i {
+		file_transactions_v1_transactions_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2069,8 +6420,8 @@ func file_transactions_v1_transactions_proto_init() {
 				return nil
 			}
 		}
-		file_transactions_v1_transactions_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransactWithIDRequest); i {
+		file_transactions_v1_transactions_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactBatchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2081,8 +6432,8 @@ func file_transactions_v1_transactions_proto_init() {
 				return nil
 			}
 		}
-		file_transactions_v1_transactions_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransactWithIDResponse); i {
+		file_transactions_v1_transactions_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactBatchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2093,8 +6444,8 @@ func file_transactions_v1_transactions_proto_init() {
 				return nil
 			}
 		}
-		file_transactions_v1_transactions_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransactRequest); i {
+		file_transactions_v1_transactions_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferReserveRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2105,8 +6456,8 @@ func file_transactions_v1_transactions_proto_init() {
 				return nil
 			}
 		}
-		file_transactions_v1_transactions_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TransactResponse); i {
+		file_transactions_v1_transactions_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferReserveResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2117,7 +6468,79 @@ func file_transactions_v1_transactions_proto_init() {
 				return nil
 			}
 		}
-		file_transactions_v1_transactions_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_transactions_v1_transactions_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferPostRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transactions_v1_transactions_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferPostResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transactions_v1_transactions_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferVoidRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transactions_v1_transactions_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransferVoidResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transactions_v1_transactions_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefundRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transactions_v1_transactions_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefundResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transactions_v1_transactions_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TransferCompleteSubscribeRequest); i {
 			case 0:
 				return &v.state
@@ -2129,7 +6552,7 @@ func file_transactions_v1_transactions_proto_init() {
 				return nil
 			}
 		}
-		file_transactions_v1_transactions_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_transactions_v1_transactions_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TransferCompleteSubscribeResponse); i {
 			case 0:
 				return &v.state
@@ -2141,16 +6564,196 @@ func file_transactions_v1_transactions_proto_init() {
 				return nil
 			}
 		}
+		file_transactions_v1_transactions_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookEndpoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transactions_v1_transactions_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookDelivery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transactions_v1_transactions_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transactions_v1_transactions_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookEndpointCreateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transactions_v1_transactions_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookEndpointCreateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transactions_v1_transactions_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookEndpointsListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transactions_v1_transactions_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookEndpointsListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transactions_v1_transactions_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookEndpointDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transactions_v1_transactions_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookEndpointDeleteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transactions_v1_transactions_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookDeliveriesListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transactions_v1_transactions_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookDeliveriesListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transactions_v1_transactions_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookDeliveryRetryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transactions_v1_transactions_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookDeliveryRetryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transactions_v1_transactions_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErrorDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_transactions_v1_transactions_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldViolation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_transactions_v1_transactions_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   19,
+			NumEnums:      8,
+			NumMessages:   61,
 			NumExtensions: 0,
-			NumServices:   2,
+			NumServices:   3,
 		},
 		GoTypes:           file_transactions_v1_transactions_proto_goTypes,
 		DependencyIndexes: file_transactions_v1_transactions_proto_depIdxs,
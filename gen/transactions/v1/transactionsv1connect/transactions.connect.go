@@ -23,6 +23,8 @@ const _ = connect_go.IsAtLeastVersion0_1_0
 const (
 	// AccountsName is the fully-qualified name of the Accounts service.
 	AccountsName = "transactions.v1.Accounts"
+	// WebhooksName is the fully-qualified name of the Webhooks service.
+	WebhooksName = "transactions.v1.Webhooks"
 	// TransactorName is the fully-qualified name of the Transactor service.
 	TransactorName = "transactions.v1.Transactor"
 )
@@ -32,8 +34,14 @@ type AccountsClient interface {
 	AccountGetViaUser(context.Context, *connect_go.Request[v1.AccountGetViaUserRequest]) (*connect_go.Response[v1.AccountGetViaUserResponse], error)
 	AccountsUser(context.Context, *connect_go.Request[v1.AccountsUserRequest]) (*connect_go.Response[v1.AccountsUserResponse], error)
 	GetBalance(context.Context, *connect_go.Request[v1.GetBalanceRequest]) (*connect_go.Response[v1.GetBalanceResponse], error)
+	GetBalanceAt(context.Context, *connect_go.Request[v1.GetBalanceAtRequest]) (*connect_go.Response[v1.GetBalanceAtResponse], error)
 	TransactionGetByID(context.Context, *connect_go.Request[v1.TransactionGetByIDRequest]) (*connect_go.Response[v1.TransactionGetByIDResponse], error)
 	TransactionsGetByAccountID(context.Context, *connect_go.Request[v1.TransactionsGetByAccountIDRequest]) (*connect_go.Response[v1.TransactionsGetByAccountIDResponse], error)
+	StatementGet(context.Context, *connect_go.Request[v1.StatementGetRequest]) (*connect_go.Response[v1.StatementGetResponse], error)
+	AccountFlagsSet(context.Context, *connect_go.Request[v1.AccountFlagsSetRequest]) (*connect_go.Response[v1.AccountFlagsSetResponse], error)
+	AccountFreeze(context.Context, *connect_go.Request[v1.AccountFreezeRequest]) (*connect_go.Response[v1.AccountFreezeResponse], error)
+	AccountUnfreeze(context.Context, *connect_go.Request[v1.AccountUnfreezeRequest]) (*connect_go.Response[v1.AccountUnfreezeResponse], error)
+	AccountFreezeHistory(context.Context, *connect_go.Request[v1.AccountFreezeHistoryRequest]) (*connect_go.Response[v1.AccountFreezeHistoryResponse], error)
 }
 
 // NewAccountsClient constructs a client for the transactions.v1.Accounts service. By default, it
@@ -61,6 +69,11 @@ func NewAccountsClient(httpClient connect_go.HTTPClient, baseURL string, opts ..
 			baseURL+"/transactions.v1.Accounts/GetBalance",
 			opts...,
 		),
+		getBalanceAt: connect_go.NewClient[v1.GetBalanceAtRequest, v1.GetBalanceAtResponse](
+			httpClient,
+			baseURL+"/transactions.v1.Accounts/GetBalanceAt",
+			opts...,
+		),
 		transactionGetByID: connect_go.NewClient[v1.TransactionGetByIDRequest, v1.TransactionGetByIDResponse](
 			httpClient,
 			baseURL+"/transactions.v1.Accounts/TransactionGetByID",
@@ -71,6 +84,31 @@ func NewAccountsClient(httpClient connect_go.HTTPClient, baseURL string, opts ..
 			baseURL+"/transactions.v1.Accounts/TransactionsGetByAccountID",
 			opts...,
 		),
+		statementGet: connect_go.NewClient[v1.StatementGetRequest, v1.StatementGetResponse](
+			httpClient,
+			baseURL+"/transactions.v1.Accounts/StatementGet",
+			opts...,
+		),
+		accountFlagsSet: connect_go.NewClient[v1.AccountFlagsSetRequest, v1.AccountFlagsSetResponse](
+			httpClient,
+			baseURL+"/transactions.v1.Accounts/AccountFlagsSet",
+			opts...,
+		),
+		accountFreeze: connect_go.NewClient[v1.AccountFreezeRequest, v1.AccountFreezeResponse](
+			httpClient,
+			baseURL+"/transactions.v1.Accounts/AccountFreeze",
+			opts...,
+		),
+		accountUnfreeze: connect_go.NewClient[v1.AccountUnfreezeRequest, v1.AccountUnfreezeResponse](
+			httpClient,
+			baseURL+"/transactions.v1.Accounts/AccountUnfreeze",
+			opts...,
+		),
+		accountFreezeHistory: connect_go.NewClient[v1.AccountFreezeHistoryRequest, v1.AccountFreezeHistoryResponse](
+			httpClient,
+			baseURL+"/transactions.v1.Accounts/AccountFreezeHistory",
+			opts...,
+		),
 	}
 }
 
@@ -79,8 +117,14 @@ type accountsClient struct {
 	accountGetViaUser          *connect_go.Client[v1.AccountGetViaUserRequest, v1.AccountGetViaUserResponse]
 	accountsUser               *connect_go.Client[v1.AccountsUserRequest, v1.AccountsUserResponse]
 	getBalance                 *connect_go.Client[v1.GetBalanceRequest, v1.GetBalanceResponse]
+	getBalanceAt               *connect_go.Client[v1.GetBalanceAtRequest, v1.GetBalanceAtResponse]
 	transactionGetByID         *connect_go.Client[v1.TransactionGetByIDRequest, v1.TransactionGetByIDResponse]
 	transactionsGetByAccountID *connect_go.Client[v1.TransactionsGetByAccountIDRequest, v1.TransactionsGetByAccountIDResponse]
+	statementGet               *connect_go.Client[v1.StatementGetRequest, v1.StatementGetResponse]
+	accountFlagsSet            *connect_go.Client[v1.AccountFlagsSetRequest, v1.AccountFlagsSetResponse]
+	accountFreeze              *connect_go.Client[v1.AccountFreezeRequest, v1.AccountFreezeResponse]
+	accountUnfreeze            *connect_go.Client[v1.AccountUnfreezeRequest, v1.AccountUnfreezeResponse]
+	accountFreezeHistory       *connect_go.Client[v1.AccountFreezeHistoryRequest, v1.AccountFreezeHistoryResponse]
 }
 
 // AccountGetViaUser calls transactions.v1.Accounts.AccountGetViaUser.
@@ -98,6 +142,11 @@ func (c *accountsClient) GetBalance(ctx context.Context, req *connect_go.Request
 	return c.getBalance.CallUnary(ctx, req)
 }
 
+// GetBalanceAt calls transactions.v1.Accounts.GetBalanceAt.
+func (c *accountsClient) GetBalanceAt(ctx context.Context, req *connect_go.Request[v1.GetBalanceAtRequest]) (*connect_go.Response[v1.GetBalanceAtResponse], error) {
+	return c.getBalanceAt.CallUnary(ctx, req)
+}
+
 // TransactionGetByID calls transactions.v1.Accounts.TransactionGetByID.
 func (c *accountsClient) TransactionGetByID(ctx context.Context, req *connect_go.Request[v1.TransactionGetByIDRequest]) (*connect_go.Response[v1.TransactionGetByIDResponse], error) {
 	return c.transactionGetByID.CallUnary(ctx, req)
@@ -108,13 +157,44 @@ func (c *accountsClient) TransactionsGetByAccountID(ctx context.Context, req *co
 	return c.transactionsGetByAccountID.CallUnary(ctx, req)
 }
 
+// StatementGet calls transactions.v1.Accounts.StatementGet.
+func (c *accountsClient) StatementGet(ctx context.Context, req *connect_go.Request[v1.StatementGetRequest]) (*connect_go.Response[v1.StatementGetResponse], error) {
+	return c.statementGet.CallUnary(ctx, req)
+}
+
+// AccountFlagsSet calls transactions.v1.Accounts.AccountFlagsSet.
+func (c *accountsClient) AccountFlagsSet(ctx context.Context, req *connect_go.Request[v1.AccountFlagsSetRequest]) (*connect_go.Response[v1.AccountFlagsSetResponse], error) {
+	return c.accountFlagsSet.CallUnary(ctx, req)
+}
+
+// AccountFreeze calls transactions.v1.Accounts.AccountFreeze.
+func (c *accountsClient) AccountFreeze(ctx context.Context, req *connect_go.Request[v1.AccountFreezeRequest]) (*connect_go.Response[v1.AccountFreezeResponse], error) {
+	return c.accountFreeze.CallUnary(ctx, req)
+}
+
+// AccountUnfreeze calls transactions.v1.Accounts.AccountUnfreeze.
+func (c *accountsClient) AccountUnfreeze(ctx context.Context, req *connect_go.Request[v1.AccountUnfreezeRequest]) (*connect_go.Response[v1.AccountUnfreezeResponse], error) {
+	return c.accountUnfreeze.CallUnary(ctx, req)
+}
+
+// AccountFreezeHistory calls transactions.v1.Accounts.AccountFreezeHistory.
+func (c *accountsClient) AccountFreezeHistory(ctx context.Context, req *connect_go.Request[v1.AccountFreezeHistoryRequest]) (*connect_go.Response[v1.AccountFreezeHistoryResponse], error) {
+	return c.accountFreezeHistory.CallUnary(ctx, req)
+}
+
 // AccountsHandler is an implementation of the transactions.v1.Accounts service.
 type AccountsHandler interface {
 	AccountGetViaUser(context.Context, *connect_go.Request[v1.AccountGetViaUserRequest]) (*connect_go.Response[v1.AccountGetViaUserResponse], error)
 	AccountsUser(context.Context, *connect_go.Request[v1.AccountsUserRequest]) (*connect_go.Response[v1.AccountsUserResponse], error)
 	GetBalance(context.Context, *connect_go.Request[v1.GetBalanceRequest]) (*connect_go.Response[v1.GetBalanceResponse], error)
+	GetBalanceAt(context.Context, *connect_go.Request[v1.GetBalanceAtRequest]) (*connect_go.Response[v1.GetBalanceAtResponse], error)
 	TransactionGetByID(context.Context, *connect_go.Request[v1.TransactionGetByIDRequest]) (*connect_go.Response[v1.TransactionGetByIDResponse], error)
 	TransactionsGetByAccountID(context.Context, *connect_go.Request[v1.TransactionsGetByAccountIDRequest]) (*connect_go.Response[v1.TransactionsGetByAccountIDResponse], error)
+	StatementGet(context.Context, *connect_go.Request[v1.StatementGetRequest]) (*connect_go.Response[v1.StatementGetResponse], error)
+	AccountFlagsSet(context.Context, *connect_go.Request[v1.AccountFlagsSetRequest]) (*connect_go.Response[v1.AccountFlagsSetResponse], error)
+	AccountFreeze(context.Context, *connect_go.Request[v1.AccountFreezeRequest]) (*connect_go.Response[v1.AccountFreezeResponse], error)
+	AccountUnfreeze(context.Context, *connect_go.Request[v1.AccountUnfreezeRequest]) (*connect_go.Response[v1.AccountUnfreezeResponse], error)
+	AccountFreezeHistory(context.Context, *connect_go.Request[v1.AccountFreezeHistoryRequest]) (*connect_go.Response[v1.AccountFreezeHistoryResponse], error)
 }
 
 // NewAccountsHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -139,6 +219,11 @@ func NewAccountsHandler(svc AccountsHandler, opts ...connect_go.HandlerOption) (
 		svc.GetBalance,
 		opts...,
 	))
+	mux.Handle("/transactions.v1.Accounts/GetBalanceAt", connect_go.NewUnaryHandler(
+		"/transactions.v1.Accounts/GetBalanceAt",
+		svc.GetBalanceAt,
+		opts...,
+	))
 	mux.Handle("/transactions.v1.Accounts/TransactionGetByID", connect_go.NewUnaryHandler(
 		"/transactions.v1.Accounts/TransactionGetByID",
 		svc.TransactionGetByID,
@@ -149,6 +234,31 @@ func NewAccountsHandler(svc AccountsHandler, opts ...connect_go.HandlerOption) (
 		svc.TransactionsGetByAccountID,
 		opts...,
 	))
+	mux.Handle("/transactions.v1.Accounts/StatementGet", connect_go.NewUnaryHandler(
+		"/transactions.v1.Accounts/StatementGet",
+		svc.StatementGet,
+		opts...,
+	))
+	mux.Handle("/transactions.v1.Accounts/AccountFlagsSet", connect_go.NewUnaryHandler(
+		"/transactions.v1.Accounts/AccountFlagsSet",
+		svc.AccountFlagsSet,
+		opts...,
+	))
+	mux.Handle("/transactions.v1.Accounts/AccountFreeze", connect_go.NewUnaryHandler(
+		"/transactions.v1.Accounts/AccountFreeze",
+		svc.AccountFreeze,
+		opts...,
+	))
+	mux.Handle("/transactions.v1.Accounts/AccountUnfreeze", connect_go.NewUnaryHandler(
+		"/transactions.v1.Accounts/AccountUnfreeze",
+		svc.AccountUnfreeze,
+		opts...,
+	))
+	mux.Handle("/transactions.v1.Accounts/AccountFreezeHistory", connect_go.NewUnaryHandler(
+		"/transactions.v1.Accounts/AccountFreezeHistory",
+		svc.AccountFreezeHistory,
+		opts...,
+	))
 	return "/transactions.v1.Accounts/", mux
 }
 
@@ -167,6 +277,10 @@ func (UnimplementedAccountsHandler) GetBalance(context.Context, *connect_go.Requ
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transactions.v1.Accounts.GetBalance is not implemented"))
 }
 
+func (UnimplementedAccountsHandler) GetBalanceAt(context.Context, *connect_go.Request[v1.GetBalanceAtRequest]) (*connect_go.Response[v1.GetBalanceAtResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transactions.v1.Accounts.GetBalanceAt is not implemented"))
+}
+
 func (UnimplementedAccountsHandler) TransactionGetByID(context.Context, *connect_go.Request[v1.TransactionGetByIDRequest]) (*connect_go.Response[v1.TransactionGetByIDResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transactions.v1.Accounts.TransactionGetByID is not implemented"))
 }
@@ -175,10 +289,183 @@ func (UnimplementedAccountsHandler) TransactionsGetByAccountID(context.Context,
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transactions.v1.Accounts.TransactionsGetByAccountID is not implemented"))
 }
 
+func (UnimplementedAccountsHandler) StatementGet(context.Context, *connect_go.Request[v1.StatementGetRequest]) (*connect_go.Response[v1.StatementGetResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transactions.v1.Accounts.StatementGet is not implemented"))
+}
+
+func (UnimplementedAccountsHandler) AccountFlagsSet(context.Context, *connect_go.Request[v1.AccountFlagsSetRequest]) (*connect_go.Response[v1.AccountFlagsSetResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transactions.v1.Accounts.AccountFlagsSet is not implemented"))
+}
+
+func (UnimplementedAccountsHandler) AccountFreeze(context.Context, *connect_go.Request[v1.AccountFreezeRequest]) (*connect_go.Response[v1.AccountFreezeResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transactions.v1.Accounts.AccountFreeze is not implemented"))
+}
+
+func (UnimplementedAccountsHandler) AccountUnfreeze(context.Context, *connect_go.Request[v1.AccountUnfreezeRequest]) (*connect_go.Response[v1.AccountUnfreezeResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transactions.v1.Accounts.AccountUnfreeze is not implemented"))
+}
+
+func (UnimplementedAccountsHandler) AccountFreezeHistory(context.Context, *connect_go.Request[v1.AccountFreezeHistoryRequest]) (*connect_go.Response[v1.AccountFreezeHistoryResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transactions.v1.Accounts.AccountFreezeHistory is not implemented"))
+}
+
+// WebhooksClient is a client for the transactions.v1.Webhooks service.
+type WebhooksClient interface {
+	WebhookEndpointCreate(context.Context, *connect_go.Request[v1.WebhookEndpointCreateRequest]) (*connect_go.Response[v1.WebhookEndpointCreateResponse], error)
+	WebhookEndpointsList(context.Context, *connect_go.Request[v1.WebhookEndpointsListRequest]) (*connect_go.Response[v1.WebhookEndpointsListResponse], error)
+	WebhookEndpointDelete(context.Context, *connect_go.Request[v1.WebhookEndpointDeleteRequest]) (*connect_go.Response[v1.WebhookEndpointDeleteResponse], error)
+	WebhookDeliveriesList(context.Context, *connect_go.Request[v1.WebhookDeliveriesListRequest]) (*connect_go.Response[v1.WebhookDeliveriesListResponse], error)
+	WebhookDeliveryRetry(context.Context, *connect_go.Request[v1.WebhookDeliveryRetryRequest]) (*connect_go.Response[v1.WebhookDeliveryRetryResponse], error)
+}
+
+// NewWebhooksClient constructs a client for the transactions.v1.Webhooks service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewWebhooksClient(httpClient connect_go.HTTPClient, baseURL string, opts ...connect_go.ClientOption) WebhooksClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &webhooksClient{
+		webhookEndpointCreate: connect_go.NewClient[v1.WebhookEndpointCreateRequest, v1.WebhookEndpointCreateResponse](
+			httpClient,
+			baseURL+"/transactions.v1.Webhooks/WebhookEndpointCreate",
+			opts...,
+		),
+		webhookEndpointsList: connect_go.NewClient[v1.WebhookEndpointsListRequest, v1.WebhookEndpointsListResponse](
+			httpClient,
+			baseURL+"/transactions.v1.Webhooks/WebhookEndpointsList",
+			opts...,
+		),
+		webhookEndpointDelete: connect_go.NewClient[v1.WebhookEndpointDeleteRequest, v1.WebhookEndpointDeleteResponse](
+			httpClient,
+			baseURL+"/transactions.v1.Webhooks/WebhookEndpointDelete",
+			opts...,
+		),
+		webhookDeliveriesList: connect_go.NewClient[v1.WebhookDeliveriesListRequest, v1.WebhookDeliveriesListResponse](
+			httpClient,
+			baseURL+"/transactions.v1.Webhooks/WebhookDeliveriesList",
+			opts...,
+		),
+		webhookDeliveryRetry: connect_go.NewClient[v1.WebhookDeliveryRetryRequest, v1.WebhookDeliveryRetryResponse](
+			httpClient,
+			baseURL+"/transactions.v1.Webhooks/WebhookDeliveryRetry",
+			opts...,
+		),
+	}
+}
+
+// webhooksClient implements WebhooksClient.
+type webhooksClient struct {
+	webhookEndpointCreate *connect_go.Client[v1.WebhookEndpointCreateRequest, v1.WebhookEndpointCreateResponse]
+	webhookEndpointsList  *connect_go.Client[v1.WebhookEndpointsListRequest, v1.WebhookEndpointsListResponse]
+	webhookEndpointDelete *connect_go.Client[v1.WebhookEndpointDeleteRequest, v1.WebhookEndpointDeleteResponse]
+	webhookDeliveriesList *connect_go.Client[v1.WebhookDeliveriesListRequest, v1.WebhookDeliveriesListResponse]
+	webhookDeliveryRetry  *connect_go.Client[v1.WebhookDeliveryRetryRequest, v1.WebhookDeliveryRetryResponse]
+}
+
+// WebhookEndpointCreate calls transactions.v1.Webhooks.WebhookEndpointCreate.
+func (c *webhooksClient) WebhookEndpointCreate(ctx context.Context, req *connect_go.Request[v1.WebhookEndpointCreateRequest]) (*connect_go.Response[v1.WebhookEndpointCreateResponse], error) {
+	return c.webhookEndpointCreate.CallUnary(ctx, req)
+}
+
+// WebhookEndpointsList calls transactions.v1.Webhooks.WebhookEndpointsList.
+func (c *webhooksClient) WebhookEndpointsList(ctx context.Context, req *connect_go.Request[v1.WebhookEndpointsListRequest]) (*connect_go.Response[v1.WebhookEndpointsListResponse], error) {
+	return c.webhookEndpointsList.CallUnary(ctx, req)
+}
+
+// WebhookEndpointDelete calls transactions.v1.Webhooks.WebhookEndpointDelete.
+func (c *webhooksClient) WebhookEndpointDelete(ctx context.Context, req *connect_go.Request[v1.WebhookEndpointDeleteRequest]) (*connect_go.Response[v1.WebhookEndpointDeleteResponse], error) {
+	return c.webhookEndpointDelete.CallUnary(ctx, req)
+}
+
+// WebhookDeliveriesList calls transactions.v1.Webhooks.WebhookDeliveriesList.
+func (c *webhooksClient) WebhookDeliveriesList(ctx context.Context, req *connect_go.Request[v1.WebhookDeliveriesListRequest]) (*connect_go.Response[v1.WebhookDeliveriesListResponse], error) {
+	return c.webhookDeliveriesList.CallUnary(ctx, req)
+}
+
+// WebhookDeliveryRetry calls transactions.v1.Webhooks.WebhookDeliveryRetry.
+func (c *webhooksClient) WebhookDeliveryRetry(ctx context.Context, req *connect_go.Request[v1.WebhookDeliveryRetryRequest]) (*connect_go.Response[v1.WebhookDeliveryRetryResponse], error) {
+	return c.webhookDeliveryRetry.CallUnary(ctx, req)
+}
+
+// WebhooksHandler is an implementation of the transactions.v1.Webhooks service.
+type WebhooksHandler interface {
+	WebhookEndpointCreate(context.Context, *connect_go.Request[v1.WebhookEndpointCreateRequest]) (*connect_go.Response[v1.WebhookEndpointCreateResponse], error)
+	WebhookEndpointsList(context.Context, *connect_go.Request[v1.WebhookEndpointsListRequest]) (*connect_go.Response[v1.WebhookEndpointsListResponse], error)
+	WebhookEndpointDelete(context.Context, *connect_go.Request[v1.WebhookEndpointDeleteRequest]) (*connect_go.Response[v1.WebhookEndpointDeleteResponse], error)
+	WebhookDeliveriesList(context.Context, *connect_go.Request[v1.WebhookDeliveriesListRequest]) (*connect_go.Response[v1.WebhookDeliveriesListResponse], error)
+	WebhookDeliveryRetry(context.Context, *connect_go.Request[v1.WebhookDeliveryRetryRequest]) (*connect_go.Response[v1.WebhookDeliveryRetryResponse], error)
+}
+
+// NewWebhooksHandler builds an HTTP handler from the service implementation. It returns the path on
+// which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewWebhooksHandler(svc WebhooksHandler, opts ...connect_go.HandlerOption) (string, http.Handler) {
+	mux := http.NewServeMux()
+	mux.Handle("/transactions.v1.Webhooks/WebhookEndpointCreate", connect_go.NewUnaryHandler(
+		"/transactions.v1.Webhooks/WebhookEndpointCreate",
+		svc.WebhookEndpointCreate,
+		opts...,
+	))
+	mux.Handle("/transactions.v1.Webhooks/WebhookEndpointsList", connect_go.NewUnaryHandler(
+		"/transactions.v1.Webhooks/WebhookEndpointsList",
+		svc.WebhookEndpointsList,
+		opts...,
+	))
+	mux.Handle("/transactions.v1.Webhooks/WebhookEndpointDelete", connect_go.NewUnaryHandler(
+		"/transactions.v1.Webhooks/WebhookEndpointDelete",
+		svc.WebhookEndpointDelete,
+		opts...,
+	))
+	mux.Handle("/transactions.v1.Webhooks/WebhookDeliveriesList", connect_go.NewUnaryHandler(
+		"/transactions.v1.Webhooks/WebhookDeliveriesList",
+		svc.WebhookDeliveriesList,
+		opts...,
+	))
+	mux.Handle("/transactions.v1.Webhooks/WebhookDeliveryRetry", connect_go.NewUnaryHandler(
+		"/transactions.v1.Webhooks/WebhookDeliveryRetry",
+		svc.WebhookDeliveryRetry,
+		opts...,
+	))
+	return "/transactions.v1.Webhooks/", mux
+}
+
+// UnimplementedWebhooksHandler returns CodeUnimplemented from all methods.
+type UnimplementedWebhooksHandler struct{}
+
+func (UnimplementedWebhooksHandler) WebhookEndpointCreate(context.Context, *connect_go.Request[v1.WebhookEndpointCreateRequest]) (*connect_go.Response[v1.WebhookEndpointCreateResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transactions.v1.Webhooks.WebhookEndpointCreate is not implemented"))
+}
+
+func (UnimplementedWebhooksHandler) WebhookEndpointsList(context.Context, *connect_go.Request[v1.WebhookEndpointsListRequest]) (*connect_go.Response[v1.WebhookEndpointsListResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transactions.v1.Webhooks.WebhookEndpointsList is not implemented"))
+}
+
+func (UnimplementedWebhooksHandler) WebhookEndpointDelete(context.Context, *connect_go.Request[v1.WebhookEndpointDeleteRequest]) (*connect_go.Response[v1.WebhookEndpointDeleteResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transactions.v1.Webhooks.WebhookEndpointDelete is not implemented"))
+}
+
+func (UnimplementedWebhooksHandler) WebhookDeliveriesList(context.Context, *connect_go.Request[v1.WebhookDeliveriesListRequest]) (*connect_go.Response[v1.WebhookDeliveriesListResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transactions.v1.Webhooks.WebhookDeliveriesList is not implemented"))
+}
+
+func (UnimplementedWebhooksHandler) WebhookDeliveryRetry(context.Context, *connect_go.Request[v1.WebhookDeliveryRetryRequest]) (*connect_go.Response[v1.WebhookDeliveryRetryResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transactions.v1.Webhooks.WebhookDeliveryRetry is not implemented"))
+}
+
 // TransactorClient is a client for the transactions.v1.Transactor service.
 type TransactorClient interface {
 	TransactWithID(context.Context, *connect_go.Request[v1.TransactWithIDRequest]) (*connect_go.Response[v1.TransactWithIDResponse], error)
 	Transact(context.Context, *connect_go.Request[v1.TransactRequest]) (*connect_go.Response[v1.TransactResponse], error)
+	TransactBatch(context.Context, *connect_go.Request[v1.TransactBatchRequest]) (*connect_go.Response[v1.TransactBatchResponse], error)
+	TransferReserve(context.Context, *connect_go.Request[v1.TransferReserveRequest]) (*connect_go.Response[v1.TransferReserveResponse], error)
+	TransferPost(context.Context, *connect_go.Request[v1.TransferPostRequest]) (*connect_go.Response[v1.TransferPostResponse], error)
+	TransferVoid(context.Context, *connect_go.Request[v1.TransferVoidRequest]) (*connect_go.Response[v1.TransferVoidResponse], error)
+	Refund(context.Context, *connect_go.Request[v1.RefundRequest]) (*connect_go.Response[v1.RefundResponse], error)
 	TransferCompleteSubscribe(context.Context, *connect_go.Request[v1.TransferCompleteSubscribeRequest]) (*connect_go.ServerStreamForClient[v1.TransferCompleteSubscribeResponse], error)
 }
 
@@ -202,6 +489,31 @@ func NewTransactorClient(httpClient connect_go.HTTPClient, baseURL string, opts
 			baseURL+"/transactions.v1.Transactor/Transact",
 			opts...,
 		),
+		transactBatch: connect_go.NewClient[v1.TransactBatchRequest, v1.TransactBatchResponse](
+			httpClient,
+			baseURL+"/transactions.v1.Transactor/TransactBatch",
+			opts...,
+		),
+		transferReserve: connect_go.NewClient[v1.TransferReserveRequest, v1.TransferReserveResponse](
+			httpClient,
+			baseURL+"/transactions.v1.Transactor/TransferReserve",
+			opts...,
+		),
+		transferPost: connect_go.NewClient[v1.TransferPostRequest, v1.TransferPostResponse](
+			httpClient,
+			baseURL+"/transactions.v1.Transactor/TransferPost",
+			opts...,
+		),
+		transferVoid: connect_go.NewClient[v1.TransferVoidRequest, v1.TransferVoidResponse](
+			httpClient,
+			baseURL+"/transactions.v1.Transactor/TransferVoid",
+			opts...,
+		),
+		refund: connect_go.NewClient[v1.RefundRequest, v1.RefundResponse](
+			httpClient,
+			baseURL+"/transactions.v1.Transactor/Refund",
+			opts...,
+		),
 		transferCompleteSubscribe: connect_go.NewClient[v1.TransferCompleteSubscribeRequest, v1.TransferCompleteSubscribeResponse](
 			httpClient,
 			baseURL+"/transactions.v1.Transactor/TransferCompleteSubscribe",
@@ -214,6 +526,11 @@ func NewTransactorClient(httpClient connect_go.HTTPClient, baseURL string, opts
 type transactorClient struct {
 	transactWithID            *connect_go.Client[v1.TransactWithIDRequest, v1.TransactWithIDResponse]
 	transact                  *connect_go.Client[v1.TransactRequest, v1.TransactResponse]
+	transactBatch             *connect_go.Client[v1.TransactBatchRequest, v1.TransactBatchResponse]
+	transferReserve           *connect_go.Client[v1.TransferReserveRequest, v1.TransferReserveResponse]
+	transferPost              *connect_go.Client[v1.TransferPostRequest, v1.TransferPostResponse]
+	transferVoid              *connect_go.Client[v1.TransferVoidRequest, v1.TransferVoidResponse]
+	refund                    *connect_go.Client[v1.RefundRequest, v1.RefundResponse]
 	transferCompleteSubscribe *connect_go.Client[v1.TransferCompleteSubscribeRequest, v1.TransferCompleteSubscribeResponse]
 }
 
@@ -227,6 +544,31 @@ func (c *transactorClient) Transact(ctx context.Context, req *connect_go.Request
 	return c.transact.CallUnary(ctx, req)
 }
 
+// TransactBatch calls transactions.v1.Transactor.TransactBatch.
+func (c *transactorClient) TransactBatch(ctx context.Context, req *connect_go.Request[v1.TransactBatchRequest]) (*connect_go.Response[v1.TransactBatchResponse], error) {
+	return c.transactBatch.CallUnary(ctx, req)
+}
+
+// TransferReserve calls transactions.v1.Transactor.TransferReserve.
+func (c *transactorClient) TransferReserve(ctx context.Context, req *connect_go.Request[v1.TransferReserveRequest]) (*connect_go.Response[v1.TransferReserveResponse], error) {
+	return c.transferReserve.CallUnary(ctx, req)
+}
+
+// TransferPost calls transactions.v1.Transactor.TransferPost.
+func (c *transactorClient) TransferPost(ctx context.Context, req *connect_go.Request[v1.TransferPostRequest]) (*connect_go.Response[v1.TransferPostResponse], error) {
+	return c.transferPost.CallUnary(ctx, req)
+}
+
+// TransferVoid calls transactions.v1.Transactor.TransferVoid.
+func (c *transactorClient) TransferVoid(ctx context.Context, req *connect_go.Request[v1.TransferVoidRequest]) (*connect_go.Response[v1.TransferVoidResponse], error) {
+	return c.transferVoid.CallUnary(ctx, req)
+}
+
+// Refund calls transactions.v1.Transactor.Refund.
+func (c *transactorClient) Refund(ctx context.Context, req *connect_go.Request[v1.RefundRequest]) (*connect_go.Response[v1.RefundResponse], error) {
+	return c.refund.CallUnary(ctx, req)
+}
+
 // TransferCompleteSubscribe calls transactions.v1.Transactor.TransferCompleteSubscribe.
 func (c *transactorClient) TransferCompleteSubscribe(ctx context.Context, req *connect_go.Request[v1.TransferCompleteSubscribeRequest]) (*connect_go.ServerStreamForClient[v1.TransferCompleteSubscribeResponse], error) {
 	return c.transferCompleteSubscribe.CallServerStream(ctx, req)
@@ -236,6 +578,11 @@ func (c *transactorClient) TransferCompleteSubscribe(ctx context.Context, req *c
 type TransactorHandler interface {
 	TransactWithID(context.Context, *connect_go.Request[v1.TransactWithIDRequest]) (*connect_go.Response[v1.TransactWithIDResponse], error)
 	Transact(context.Context, *connect_go.Request[v1.TransactRequest]) (*connect_go.Response[v1.TransactResponse], error)
+	TransactBatch(context.Context, *connect_go.Request[v1.TransactBatchRequest]) (*connect_go.Response[v1.TransactBatchResponse], error)
+	TransferReserve(context.Context, *connect_go.Request[v1.TransferReserveRequest]) (*connect_go.Response[v1.TransferReserveResponse], error)
+	TransferPost(context.Context, *connect_go.Request[v1.TransferPostRequest]) (*connect_go.Response[v1.TransferPostResponse], error)
+	TransferVoid(context.Context, *connect_go.Request[v1.TransferVoidRequest]) (*connect_go.Response[v1.TransferVoidResponse], error)
+	Refund(context.Context, *connect_go.Request[v1.RefundRequest]) (*connect_go.Response[v1.RefundResponse], error)
 	TransferCompleteSubscribe(context.Context, *connect_go.Request[v1.TransferCompleteSubscribeRequest], *connect_go.ServerStream[v1.TransferCompleteSubscribeResponse]) error
 }
 
@@ -256,6 +603,31 @@ func NewTransactorHandler(svc TransactorHandler, opts ...connect_go.HandlerOptio
 		svc.Transact,
 		opts...,
 	))
+	mux.Handle("/transactions.v1.Transactor/TransactBatch", connect_go.NewUnaryHandler(
+		"/transactions.v1.Transactor/TransactBatch",
+		svc.TransactBatch,
+		opts...,
+	))
+	mux.Handle("/transactions.v1.Transactor/TransferReserve", connect_go.NewUnaryHandler(
+		"/transactions.v1.Transactor/TransferReserve",
+		svc.TransferReserve,
+		opts...,
+	))
+	mux.Handle("/transactions.v1.Transactor/TransferPost", connect_go.NewUnaryHandler(
+		"/transactions.v1.Transactor/TransferPost",
+		svc.TransferPost,
+		opts...,
+	))
+	mux.Handle("/transactions.v1.Transactor/TransferVoid", connect_go.NewUnaryHandler(
+		"/transactions.v1.Transactor/TransferVoid",
+		svc.TransferVoid,
+		opts...,
+	))
+	mux.Handle("/transactions.v1.Transactor/Refund", connect_go.NewUnaryHandler(
+		"/transactions.v1.Transactor/Refund",
+		svc.Refund,
+		opts...,
+	))
 	mux.Handle("/transactions.v1.Transactor/TransferCompleteSubscribe", connect_go.NewServerStreamHandler(
 		"/transactions.v1.Transactor/TransferCompleteSubscribe",
 		svc.TransferCompleteSubscribe,
@@ -275,6 +647,26 @@ func (UnimplementedTransactorHandler) Transact(context.Context, *connect_go.Requ
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transactions.v1.Transactor.Transact is not implemented"))
 }
 
+func (UnimplementedTransactorHandler) TransactBatch(context.Context, *connect_go.Request[v1.TransactBatchRequest]) (*connect_go.Response[v1.TransactBatchResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transactions.v1.Transactor.TransactBatch is not implemented"))
+}
+
+func (UnimplementedTransactorHandler) TransferReserve(context.Context, *connect_go.Request[v1.TransferReserveRequest]) (*connect_go.Response[v1.TransferReserveResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transactions.v1.Transactor.TransferReserve is not implemented"))
+}
+
+func (UnimplementedTransactorHandler) TransferPost(context.Context, *connect_go.Request[v1.TransferPostRequest]) (*connect_go.Response[v1.TransferPostResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transactions.v1.Transactor.TransferPost is not implemented"))
+}
+
+func (UnimplementedTransactorHandler) TransferVoid(context.Context, *connect_go.Request[v1.TransferVoidRequest]) (*connect_go.Response[v1.TransferVoidResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transactions.v1.Transactor.TransferVoid is not implemented"))
+}
+
+func (UnimplementedTransactorHandler) Refund(context.Context, *connect_go.Request[v1.RefundRequest]) (*connect_go.Response[v1.RefundResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transactions.v1.Transactor.Refund is not implemented"))
+}
+
 func (UnimplementedTransactorHandler) TransferCompleteSubscribe(context.Context, *connect_go.Request[v1.TransferCompleteSubscribeRequest], *connect_go.ServerStream[v1.TransferCompleteSubscribeResponse]) error {
 	return connect_go.NewError(connect_go.CodeUnimplemented, errors.New("transactions.v1.Transactor.TransferCompleteSubscribe is not implemented"))
 }
@@ -0,0 +1,125 @@
+ALTER TABLE accounts
+    ADD COLUMN debits_pending  NUMERIC(28) DEFAULT 0 NOT NULL,
+    ADD COLUMN credits_pending NUMERIC(28) DEFAULT 0 NOT NULL;
+
+-- pending transfers hold funds on the debit account until they are posted, voided or expired
+CREATE TABLE pending_transfers
+(
+    id                UUID                     DEFAULT gen_random_uuid() NOT NULL PRIMARY KEY,
+    amount            NUMERIC(28)                                        NOT NULL,
+    debit_account_id  UUID                                               NOT NULL REFERENCES accounts (id),
+    credit_account_id UUID                                               NOT NULL REFERENCES accounts (id),
+    ledger            INTEGER                                            NOT NULL REFERENCES ledgers (id),
+    transfer_code     INTEGER                                            NOT NULL,
+    status            INTEGER                  DEFAULT 1                 NOT NULL,
+    transaction_id    UUID,
+    expires_at        TIMESTAMP WITH TIME ZONE                           NOT NULL,
+    resolved_at       TIMESTAMP WITH TIME ZONE,
+    created_at        TIMESTAMP WITH TIME ZONE DEFAULT NOW()             NOT NULL
+);
+
+CREATE INDEX pending_transfers_status_expires_at ON pending_transfers (status, expires_at);
+CREATE INDEX pending_transfers_debit ON pending_transfers (debit_account_id);
+CREATE INDEX pending_transfers_credit ON pending_transfers (credit_account_id);
+
+-- pending debits now count against the available balance
+CREATE OR REPLACE FUNCTION check_balances() RETURNS TRIGGER AS
+$check_balances$
+BEGIN
+    -- check its not a transaction to themselves
+    IF new.debit_account_id = new.credit_account_id THEN
+        RAISE EXCEPTION 'unable to transfer to self';
+    END IF;
+
+    -- check ledgers match accounts
+    IF NOT (SELECT ledger = new.ledger FROM accounts WHERE id = new.debit_account_id)
+    THEN
+        RAISE EXCEPTION 'debit account ledger does not match transaction ledger';
+    END IF;
+    IF NOT (SELECT ledger = new.ledger FROM accounts WHERE id = new.credit_account_id)
+    THEN
+        RAISE EXCEPTION 'credit account ledger does not match transaction ledger';
+    END IF;
+
+    -- checks if the debtor is the on chain / off world account since that is the only account allow to go negative.
+    IF ((SELECT xsyn_user_id != '2fa1a63e-a4fa-4618-921f-4b4d28132069' FROM accounts WHERE id = new.debit_account_id)
+        AND (SELECT (accounts.credits_posted - accounts.debits_posted - accounts.debits_pending - new.amount) < 0
+             FROM accounts
+             WHERE accounts.id = new.debit_account_id
+               AND accounts.ledger = new.ledger)) THEN
+        RAISE EXCEPTION 'not enough funds';
+    END IF;
+
+    -- update the balances
+    UPDATE accounts SET debits_posted = debits_posted + new.amount WHERE accounts.id = new.debit_account_id;
+    UPDATE accounts SET credits_posted = credits_posted + new.amount WHERE accounts.id = new.credit_account_id;
+    RETURN new;
+END
+$check_balances$
+    LANGUAGE plpgsql;
+
+-- status values match the PendingTransferStatus enum (1 = pending, 2 = posted, 3 = voided, 4 = expired)
+CREATE OR REPLACE FUNCTION check_pending_transfers() RETURNS TRIGGER AS
+$check_pending_transfers$
+BEGIN
+    IF tg_op = 'INSERT' THEN
+        -- check its not a transfer to themselves
+        IF new.debit_account_id = new.credit_account_id THEN
+            RAISE EXCEPTION 'unable to transfer to self';
+        END IF;
+
+        -- check ledgers match accounts
+        IF NOT (SELECT ledger = new.ledger FROM accounts WHERE id = new.debit_account_id)
+        THEN
+            RAISE EXCEPTION 'debit account ledger does not match transaction ledger';
+        END IF;
+        IF NOT (SELECT ledger = new.ledger FROM accounts WHERE id = new.credit_account_id)
+        THEN
+            RAISE EXCEPTION 'credit account ledger does not match transaction ledger';
+        END IF;
+
+        IF new.status != 1 THEN
+            RAISE EXCEPTION 'pending transfer must be created as pending';
+        END IF;
+
+        -- checks if the debtor is the on chain / off world account since that is the only account allow to go negative.
+        IF ((SELECT xsyn_user_id != '2fa1a63e-a4fa-4618-921f-4b4d28132069' FROM accounts WHERE id = new.debit_account_id)
+            AND (SELECT (accounts.credits_posted - accounts.debits_posted - accounts.debits_pending - new.amount) < 0
+                 FROM accounts
+                 WHERE accounts.id = new.debit_account_id
+                   AND accounts.ledger = new.ledger)) THEN
+            RAISE EXCEPTION 'not enough funds';
+        END IF;
+
+        -- hold the funds
+        UPDATE accounts SET debits_pending = debits_pending + new.amount WHERE accounts.id = new.debit_account_id;
+        UPDATE accounts SET credits_pending = credits_pending + new.amount WHERE accounts.id = new.credit_account_id;
+        RETURN new;
+    END IF;
+
+    -- only the status of a pending transfer can be changed
+    IF old.status != 1 THEN
+        RAISE EXCEPTION 'pending transfer is already resolved';
+    END IF;
+    IF new.amount != old.amount
+        OR new.debit_account_id != old.debit_account_id
+        OR new.credit_account_id != old.credit_account_id
+        OR new.ledger != old.ledger THEN
+        RAISE EXCEPTION 'pending transfer can not be modified';
+    END IF;
+
+    -- release the funds, a posted transfer inserts the real transaction after this
+    IF new.status != 1 THEN
+        UPDATE accounts SET debits_pending = debits_pending - new.amount WHERE accounts.id = new.debit_account_id;
+        UPDATE accounts SET credits_pending = credits_pending - new.amount WHERE accounts.id = new.credit_account_id;
+    END IF;
+    RETURN new;
+END
+$check_pending_transfers$
+    LANGUAGE plpgsql;
+
+CREATE TRIGGER trigger_check_pending_transfers
+    BEFORE INSERT OR UPDATE
+    ON pending_transfers
+    FOR EACH ROW
+EXECUTE PROCEDURE check_pending_transfers();
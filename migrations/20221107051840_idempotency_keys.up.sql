@@ -0,0 +1,8 @@
+-- stores the transaction made for each idempotency key, so a retried request returns the original transfer
+CREATE TABLE idempotency_keys
+(
+    key            TEXT                                   NOT NULL PRIMARY KEY,
+    request_hash   TEXT                                   NOT NULL,
+    transaction_id UUID                                   NOT NULL,
+    created_at     TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL
+);
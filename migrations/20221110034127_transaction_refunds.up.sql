@@ -0,0 +1,51 @@
+-- refunds reference the transaction they refund
+ALTER TABLE transactions
+    ADD COLUMN parent_transaction_id UUID;
+
+CREATE INDEX ts_transactions_parent_transaction_id ON transactions (parent_transaction_id);
+
+CREATE OR REPLACE FUNCTION check_balances() RETURNS TRIGGER AS
+$check_balances$
+BEGIN
+    -- check its not a transaction to themselves
+    IF new.debit_account_id = new.credit_account_id THEN
+        RAISE EXCEPTION 'unable to transfer to self';
+    END IF;
+
+    -- check ledgers match accounts
+    IF NOT (SELECT ledger = new.ledger FROM accounts WHERE id = new.debit_account_id)
+    THEN
+        RAISE EXCEPTION 'debit account ledger does not match transaction ledger';
+    END IF;
+    IF NOT (SELECT ledger = new.ledger FROM accounts WHERE id = new.credit_account_id)
+    THEN
+        RAISE EXCEPTION 'credit account ledger does not match transaction ledger';
+    END IF;
+
+    -- checks if the debtor is the on chain / off world account since that is the only account allow to go negative.
+    IF ((SELECT xsyn_user_id != '2fa1a63e-a4fa-4618-921f-4b4d28132069' FROM accounts WHERE id = new.debit_account_id)
+        AND (SELECT (accounts.credits_posted - accounts.debits_posted - accounts.debits_pending - new.amount) < 0
+             FROM accounts
+             WHERE accounts.id = new.debit_account_id
+               AND accounts.ledger = new.ledger)) THEN
+        RAISE EXCEPTION 'not enough funds';
+    END IF;
+
+    -- refunds can not add up to more than the transaction they refund
+    IF new.parent_transaction_id IS NOT NULL THEN
+        IF NOT EXISTS (SELECT 1 FROM transactions WHERE id = new.parent_transaction_id) THEN
+            RAISE EXCEPTION 'refunded transaction does not exist';
+        END IF;
+        IF ((SELECT COALESCE(SUM(amount), 0) + new.amount FROM transactions WHERE parent_transaction_id = new.parent_transaction_id)
+            > (SELECT amount FROM transactions WHERE id = new.parent_transaction_id)) THEN
+            RAISE EXCEPTION 'refund exceeds original amount';
+        END IF;
+    END IF;
+
+    -- update the balances
+    UPDATE accounts SET debits_posted = debits_posted + new.amount WHERE accounts.id = new.debit_account_id;
+    UPDATE accounts SET credits_posted = credits_posted + new.amount WHERE accounts.id = new.credit_account_id;
+    RETURN new;
+END
+$check_balances$
+    LANGUAGE plpgsql;
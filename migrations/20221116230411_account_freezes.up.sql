@@ -0,0 +1,154 @@
+ALTER TABLE accounts
+    ADD COLUMN frozen         BOOLEAN DEFAULT FALSE NOT NULL,
+    ADD COLUMN frozen_credits BOOLEAN DEFAULT FALSE NOT NULL;
+
+-- audit trail of every freeze and unfreeze of an account
+CREATE TABLE account_freezes
+(
+    id             UUID                     DEFAULT gen_random_uuid() NOT NULL PRIMARY KEY,
+    account_id     UUID                                               NOT NULL REFERENCES accounts (id),
+    frozen         BOOLEAN                                            NOT NULL,
+    frozen_credits BOOLEAN                                            NOT NULL,
+    reason         TEXT                                               NOT NULL,
+    actor          TEXT                                               NOT NULL,
+    created_at     TIMESTAMP WITH TIME ZONE DEFAULT NOW()             NOT NULL
+);
+
+CREATE INDEX account_freezes_account_id_created_at ON account_freezes (account_id, created_at DESC);
+
+CREATE OR REPLACE FUNCTION check_balances() RETURNS TRIGGER AS
+$check_balances$
+BEGIN
+    -- check its not a transaction to themselves
+    IF new.debit_account_id = new.credit_account_id THEN
+        RAISE EXCEPTION 'unable to transfer to self';
+    END IF;
+
+    -- check ledgers match accounts
+    IF NOT (SELECT ledger = new.ledger FROM accounts WHERE id = new.debit_account_id)
+    THEN
+        RAISE EXCEPTION 'debit account ledger does not match transaction ledger';
+    END IF;
+    IF NOT (SELECT ledger = new.ledger FROM accounts WHERE id = new.credit_account_id)
+    THEN
+        RAISE EXCEPTION 'credit account ledger does not match transaction ledger';
+    END IF;
+
+    -- frozen accounts can not be debited, and can not be credited if their credits are frozen too
+    IF (SELECT frozen FROM accounts WHERE id = new.debit_account_id) THEN
+        RAISE EXCEPTION 'debit account is frozen';
+    END IF;
+    IF (SELECT frozen AND frozen_credits FROM accounts WHERE id = new.credit_account_id) THEN
+        RAISE EXCEPTION 'credit account is frozen';
+    END IF;
+
+    -- check the accounts allow the transfer
+    IF (SELECT debits_disabled FROM accounts WHERE id = new.debit_account_id) THEN
+        RAISE EXCEPTION 'debit account has debits disabled';
+    END IF;
+    IF (SELECT credits_disabled FROM accounts WHERE id = new.credit_account_id) THEN
+        RAISE EXCEPTION 'credit account has credits disabled';
+    END IF;
+
+    -- checks the debtor has the funds, unless it is allowed to go negative. The overdraft limit is how far below zero it can go.
+    IF (SELECT (NOT accounts.allow_negative_balance)
+                   AND (accounts.credits_posted - accounts.debits_posted - accounts.debits_pending + accounts.overdraft_limit - new.amount) < 0
+        FROM accounts
+        WHERE accounts.id = new.debit_account_id
+          AND accounts.ledger = new.ledger) THEN
+        RAISE EXCEPTION 'not enough funds';
+    END IF;
+
+    -- refunds can not add up to more than the transaction they refund
+    IF new.parent_transaction_id IS NOT NULL THEN
+        IF NOT EXISTS (SELECT 1 FROM transactions WHERE id = new.parent_transaction_id) THEN
+            RAISE EXCEPTION 'refunded transaction does not exist';
+        END IF;
+        IF ((SELECT COALESCE(SUM(amount), 0) + new.amount FROM transactions WHERE parent_transaction_id = new.parent_transaction_id)
+            > (SELECT amount FROM transactions WHERE id = new.parent_transaction_id)) THEN
+            RAISE EXCEPTION 'refund exceeds original amount';
+        END IF;
+    END IF;
+
+    -- update the balances
+    UPDATE accounts SET debits_posted = debits_posted + new.amount WHERE accounts.id = new.debit_account_id;
+    UPDATE accounts SET credits_posted = credits_posted + new.amount WHERE accounts.id = new.credit_account_id;
+    RETURN new;
+END
+$check_balances$
+    LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION check_pending_transfers() RETURNS TRIGGER AS
+$check_pending_transfers$
+BEGIN
+    IF tg_op = 'INSERT' THEN
+        -- check its not a transfer to themselves
+        IF new.debit_account_id = new.credit_account_id THEN
+            RAISE EXCEPTION 'unable to transfer to self';
+        END IF;
+
+        -- check ledgers match accounts
+        IF NOT (SELECT ledger = new.ledger FROM accounts WHERE id = new.debit_account_id)
+        THEN
+            RAISE EXCEPTION 'debit account ledger does not match transaction ledger';
+        END IF;
+        IF NOT (SELECT ledger = new.ledger FROM accounts WHERE id = new.credit_account_id)
+        THEN
+            RAISE EXCEPTION 'credit account ledger does not match transaction ledger';
+        END IF;
+
+        IF new.status != 1 THEN
+            RAISE EXCEPTION 'pending transfer must be created as pending';
+        END IF;
+
+        -- frozen accounts can not be debited, and can not be credited if their credits are frozen too
+        IF (SELECT frozen FROM accounts WHERE id = new.debit_account_id) THEN
+            RAISE EXCEPTION 'debit account is frozen';
+        END IF;
+        IF (SELECT frozen AND frozen_credits FROM accounts WHERE id = new.credit_account_id) THEN
+            RAISE EXCEPTION 'credit account is frozen';
+        END IF;
+
+        -- check the accounts allow the transfer
+        IF (SELECT debits_disabled FROM accounts WHERE id = new.debit_account_id) THEN
+            RAISE EXCEPTION 'debit account has debits disabled';
+        END IF;
+        IF (SELECT credits_disabled FROM accounts WHERE id = new.credit_account_id) THEN
+            RAISE EXCEPTION 'credit account has credits disabled';
+        END IF;
+
+        -- checks the debtor has the funds, unless it is allowed to go negative. The overdraft limit is how far below zero it can go.
+        IF (SELECT (NOT accounts.allow_negative_balance)
+                       AND (accounts.credits_posted - accounts.debits_posted - accounts.debits_pending + accounts.overdraft_limit - new.amount) < 0
+            FROM accounts
+            WHERE accounts.id = new.debit_account_id
+              AND accounts.ledger = new.ledger) THEN
+            RAISE EXCEPTION 'not enough funds';
+        END IF;
+
+        -- hold the funds
+        UPDATE accounts SET debits_pending = debits_pending + new.amount WHERE accounts.id = new.debit_account_id;
+        UPDATE accounts SET credits_pending = credits_pending + new.amount WHERE accounts.id = new.credit_account_id;
+        RETURN new;
+    END IF;
+
+    -- only the status of a pending transfer can be changed
+    IF old.status != 1 THEN
+        RAISE EXCEPTION 'pending transfer is already resolved';
+    END IF;
+    IF new.amount != old.amount
+        OR new.debit_account_id != old.debit_account_id
+        OR new.credit_account_id != old.credit_account_id
+        OR new.ledger != old.ledger THEN
+        RAISE EXCEPTION 'pending transfer can not be modified';
+    END IF;
+
+    -- release the funds, a posted transfer inserts the real transaction after this
+    IF new.status != 1 THEN
+        UPDATE accounts SET debits_pending = debits_pending - new.amount WHERE accounts.id = new.debit_account_id;
+        UPDATE accounts SET credits_pending = credits_pending - new.amount WHERE accounts.id = new.credit_account_id;
+    END IF;
+    RETURN new;
+END
+$check_pending_transfers$
+    LANGUAGE plpgsql;
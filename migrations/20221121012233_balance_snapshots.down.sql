@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS ts_transactions_debit_created_at;
+DROP INDEX IF EXISTS ts_transactions_credit_created_at;
+
+DROP TABLE IF EXISTS balance_snapshots;
@@ -0,0 +1,12 @@
+-- snapshots of each account's posted totals, so a balance at a point in time only sums the transactions since the last snapshot
+CREATE TABLE balance_snapshots
+(
+    account_id     UUID                     NOT NULL REFERENCES accounts (id),
+    taken_at       TIMESTAMP WITH TIME ZONE NOT NULL,
+    debits_posted  NUMERIC(28)              NOT NULL,
+    credits_posted NUMERIC(28)              NOT NULL,
+    PRIMARY KEY (account_id, taken_at)
+);
+
+CREATE INDEX ts_transactions_debit_created_at ON transactions (debit_account_id, created_at);
+CREATE INDEX ts_transactions_credit_created_at ON transactions (credit_account_id, created_at);
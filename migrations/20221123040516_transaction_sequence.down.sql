@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS ts_transactions_seq;
+
+ALTER TABLE transactions
+    DROP COLUMN IF EXISTS seq;
+
+DROP SEQUENCE IF EXISTS transactions_seq;
@@ -0,0 +1,21 @@
+-- a sequence number for every transaction, the single writer inserts in order so it increases in commit order
+CREATE SEQUENCE transactions_seq;
+
+ALTER TABLE transactions
+    ADD COLUMN seq BIGINT;
+
+UPDATE transactions
+SET seq = ordered.seq
+FROM (SELECT id, created_at, ROW_NUMBER() OVER (ORDER BY created_at, id) AS seq FROM transactions) ordered
+WHERE transactions.id = ordered.id
+  AND transactions.created_at = ordered.created_at;
+
+SELECT SETVAL('transactions_seq', COALESCE((SELECT MAX(seq) FROM transactions), 0) + 1, FALSE);
+
+ALTER TABLE transactions
+    ALTER COLUMN seq SET DEFAULT NEXTVAL('transactions_seq'),
+    ALTER COLUMN seq SET NOT NULL;
+
+ALTER SEQUENCE transactions_seq OWNED BY transactions.seq;
+
+CREATE INDEX ts_transactions_seq ON transactions (seq);
@@ -0,0 +1,38 @@
+-- written in the same db transaction as each transaction, so an event survives a crash before it is broadcast
+CREATE TABLE outbox_events
+(
+    id             BIGSERIAL PRIMARY KEY,
+    transaction_id UUID                                           NOT NULL,
+    payload        JSONB                                          NOT NULL,
+    created_at     TIMESTAMP WITH TIME ZONE DEFAULT NOW()         NOT NULL,
+    dispatched_at  TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX outbox_events_undispatched ON outbox_events (id) WHERE dispatched_at IS NULL;
+
+CREATE TABLE webhook_endpoints
+(
+    id         UUID                     DEFAULT gen_random_uuid() NOT NULL PRIMARY KEY,
+    url        TEXT                                               NOT NULL,
+    secret     TEXT                                               NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()             NOT NULL,
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+-- one delivery of an outbox event to an endpoint, status 1 pending, 2 delivered, 3 dead
+CREATE TABLE webhook_deliveries
+(
+    id              UUID                     DEFAULT gen_random_uuid() NOT NULL PRIMARY KEY,
+    event_id        BIGINT                                             NOT NULL REFERENCES outbox_events (id),
+    endpoint_id     UUID                                               NOT NULL REFERENCES webhook_endpoints (id),
+    status          INTEGER                  DEFAULT 1                 NOT NULL,
+    attempts        INTEGER                  DEFAULT 0                 NOT NULL,
+    next_attempt_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()             NOT NULL,
+    last_error      TEXT,
+    delivered_at    TIMESTAMP WITH TIME ZONE,
+    created_at      TIMESTAMP WITH TIME ZONE DEFAULT NOW()             NOT NULL,
+    UNIQUE (event_id, endpoint_id)
+);
+
+CREATE INDEX webhook_deliveries_status_next_attempt_at ON webhook_deliveries (status, next_attempt_at);
+CREATE INDEX webhook_deliveries_endpoint_status ON webhook_deliveries (endpoint_id, status);
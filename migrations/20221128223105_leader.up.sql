@@ -0,0 +1,8 @@
+-- the replica holding the leader advisory lock records where followers forward writes to
+CREATE TABLE leader
+(
+    id          INTEGER                  DEFAULT 1     NOT NULL PRIMARY KEY CHECK (id = 1),
+    instance_id TEXT                                   NOT NULL,
+    address     TEXT                                   NOT NULL,
+    elected_at  TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL
+);
@@ -0,0 +1,17 @@
+-- the clients allowed to call the api, keys are only stored as sha256 hashes.
+-- scopes are read, subscribe, transact and admin, an empty debit_account_codes allows debiting any account
+CREATE TABLE api_clients
+(
+    id                       UUID                     DEFAULT gen_random_uuid() NOT NULL PRIMARY KEY,
+    name                     TEXT                                               NOT NULL UNIQUE,
+    key_hash                 TEXT                                               NOT NULL UNIQUE,
+    previous_key_hash        TEXT,
+    previous_key_expires_at  TIMESTAMP WITH TIME ZONE,
+    scopes                   TEXT[]                   DEFAULT '{}'              NOT NULL,
+    transfer_codes           INTEGER[]                DEFAULT '{}'              NOT NULL,
+    ledgers                  INTEGER[]                DEFAULT '{}'              NOT NULL,
+    debit_account_codes      INTEGER[]                DEFAULT '{}'              NOT NULL,
+    created_at               TIMESTAMP WITH TIME ZONE DEFAULT NOW()             NOT NULL,
+    rotated_at               TIMESTAMP WITH TIME ZONE,
+    revoked_at               TIMESTAMP WITH TIME ZONE
+);
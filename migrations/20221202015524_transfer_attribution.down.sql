@@ -0,0 +1,12 @@
+ALTER TABLE pending_transfers
+    DROP COLUMN IF EXISTS metadata,
+    DROP COLUMN IF EXISTS reference_id,
+    DROP COLUMN IF EXISTS initiator_id;
+
+DROP INDEX IF EXISTS ts_transactions_reference_id;
+DROP INDEX IF EXISTS ts_transactions_initiator_id;
+
+ALTER TABLE transactions
+    DROP COLUMN IF EXISTS metadata,
+    DROP COLUMN IF EXISTS reference_id,
+    DROP COLUMN IF EXISTS initiator_id;
@@ -0,0 +1,14 @@
+-- who made a transfer and what it is for, initiator_id is the api client, reference_id and metadata are the caller's
+ALTER TABLE transactions
+    ADD COLUMN initiator_id UUID,
+    ADD COLUMN reference_id TEXT,
+    ADD COLUMN metadata     JSONB DEFAULT '{}' NOT NULL;
+
+CREATE INDEX ts_transactions_initiator_id ON transactions (initiator_id, created_at DESC);
+CREATE INDEX ts_transactions_reference_id ON transactions (reference_id, created_at DESC);
+
+-- carried over to the transaction when the pending transfer is posted
+ALTER TABLE pending_transfers
+    ADD COLUMN initiator_id UUID,
+    ADD COLUMN reference_id TEXT,
+    ADD COLUMN metadata     JSONB DEFAULT '{}' NOT NULL;
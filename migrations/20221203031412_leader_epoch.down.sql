@@ -0,0 +1,2 @@
+ALTER TABLE leader
+    DROP COLUMN IF EXISTS epoch;
@@ -0,0 +1,3 @@
+-- bumped on every election, writes check it before committing so a leader that lost the lock can't keep writing
+ALTER TABLE leader
+    ADD COLUMN epoch BIGINT DEFAULT 0 NOT NULL;
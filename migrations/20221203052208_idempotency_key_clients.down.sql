@@ -0,0 +1,10 @@
+-- keys used by more than one client can't all be kept under a key only primary key
+DELETE
+FROM idempotency_keys
+WHERE client_id != '00000000-0000-0000-0000-000000000000'
+  AND key IN (SELECT key FROM idempotency_keys GROUP BY key HAVING COUNT(*) > 1);
+
+ALTER TABLE idempotency_keys
+    DROP CONSTRAINT idempotency_keys_pkey,
+    DROP COLUMN IF EXISTS client_id,
+    ADD PRIMARY KEY (key);
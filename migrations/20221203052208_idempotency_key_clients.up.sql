@@ -0,0 +1,9 @@
+-- idempotency keys belong to the client that used them, so one client can't replay another's transfer.
+-- Keys used before clients existed are kept under the nil uuid, no client has it so they can't be replayed.
+ALTER TABLE idempotency_keys
+    ADD COLUMN client_id UUID DEFAULT '00000000-0000-0000-0000-000000000000' NOT NULL;
+
+ALTER TABLE idempotency_keys
+    ALTER COLUMN client_id DROP DEFAULT,
+    DROP CONSTRAINT idempotency_keys_pkey,
+    ADD PRIMARY KEY (client_id, key);
@@ -0,0 +1,72 @@
+package storage
+
+import (
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"xsyn-transactions/boiler"
+	transactionsv1 "xsyn-transactions/gen/transactions/v1"
+)
+
+// AccountFreezeSet freezes or unfreezes an account and records it in the account's freeze history,
+// run it in a db transaction so the freeze and its history are written together
+func (s *Storage) AccountFreezeSet(exec boil.Executor, accountID string, frozen bool, frozenCredits bool, reason string, actor string) (*transactionsv1.Account, *transactionsv1.AccountFreeze, error) {
+	account, err := boiler.FindAccount(exec, accountID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	account.Frozen = frozen
+	account.FrozenCredits = frozen && frozenCredits
+	_, err = account.Update(exec, boil.Whitelist(
+		boiler.AccountColumns.Frozen,
+		boiler.AccountColumns.FrozenCredits,
+	))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	accountFreeze := &boiler.AccountFreeze{
+		AccountID:     accountID,
+		Frozen:        account.Frozen,
+		FrozenCredits: account.FrozenCredits,
+		Reason:        reason,
+		Actor:         actor,
+	}
+	err = accountFreeze.Insert(exec, boil.Infer())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return AccountToProto(account), AccountFreezeToProto(accountFreeze), nil
+}
+
+// AccountFreezesGet returns the freeze history of an account, newest first
+func (s *Storage) AccountFreezesGet(accountID string) ([]*transactionsv1.AccountFreeze, error) {
+	results := []*transactionsv1.AccountFreeze{}
+
+	accountFreezes, err := boiler.AccountFreezes(
+		boiler.AccountFreezeWhere.AccountID.EQ(accountID),
+		qm.OrderBy(boiler.AccountFreezeColumns.CreatedAt+" DESC"),
+	).All(s)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, accountFreeze := range accountFreezes {
+		results = append(results, AccountFreezeToProto(accountFreeze))
+	}
+
+	return results, nil
+}
+
+func AccountFreezeToProto(accountFreeze *boiler.AccountFreeze) *transactionsv1.AccountFreeze {
+	return &transactionsv1.AccountFreeze{
+		Id:            accountFreeze.ID,
+		AccountId:     accountFreeze.AccountID,
+		Frozen:        accountFreeze.Frozen,
+		FrozenCredits: accountFreeze.FrozenCredits,
+		Reason:        accountFreeze.Reason,
+		Actor:         accountFreeze.Actor,
+		CreatedAt:     accountFreeze.CreatedAt.Unix(),
+	}
+}
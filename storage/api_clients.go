@@ -0,0 +1,120 @@
+package storage
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"time"
+	"xsyn-transactions/boiler"
+)
+
+// APIClientsChannel is notified whenever a client is created, rotated or revoked, so every replica reloads its clients
+const APIClientsChannel = "xsyn_transactions_api_clients"
+
+var ErrAPIClientNotFound = fmt.Errorf("api client not found")
+
+// APIClientsActive returns the clients that haven't been revoked
+func (s *Storage) APIClientsActive() (boiler.APIClientSlice, error) {
+	return boiler.APIClients(
+		boiler.APIClientWhere.RevokedAt.IsNull(),
+		qm.OrderBy(boiler.APIClientColumns.Name),
+	).All(s)
+}
+
+// APIClientGet returns a client by name, revoked or not
+func (s *Storage) APIClientGet(name string) (*boiler.APIClient, error) {
+	client, err := boiler.APIClients(boiler.APIClientWhere.Name.EQ(name)).One(s)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrAPIClientNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+// APIClientCreate inserts a client and tells the replicas about it
+func (s *Storage) APIClientCreate(client *boiler.APIClient) error {
+	tx, err := s.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = client.Insert(tx, boil.Infer())
+	if err != nil {
+		return DomainError(err)
+	}
+
+	err = s.Notify(tx, APIClientsChannel, client.ID)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// APIClientRotate replaces a client's key hash, the previous key keeps working for the grace period
+func (s *Storage) APIClientRotate(name string, keyHash string, grace time.Duration) (*boiler.APIClient, error) {
+	return s.apiClientUpdate(name, func(client *boiler.APIClient) []string {
+		client.PreviousKeyHash = null.StringFrom(client.KeyHash)
+		client.PreviousKeyExpiresAt = null.TimeFrom(time.Now().Add(grace))
+		client.KeyHash = keyHash
+		client.RotatedAt = null.TimeFrom(time.Now())
+		return []string{
+			boiler.APIClientColumns.PreviousKeyHash,
+			boiler.APIClientColumns.PreviousKeyExpiresAt,
+			boiler.APIClientColumns.KeyHash,
+			boiler.APIClientColumns.RotatedAt,
+		}
+	})
+}
+
+// APIClientRevoke stops a client's keys from working
+func (s *Storage) APIClientRevoke(name string) (*boiler.APIClient, error) {
+	return s.apiClientUpdate(name, func(client *boiler.APIClient) []string {
+		client.RevokedAt = null.TimeFrom(time.Now())
+		return []string{boiler.APIClientColumns.RevokedAt}
+	})
+}
+
+// apiClientUpdate applies update to an active client and tells the replicas, update returns the columns it changed
+func (s *Storage) apiClientUpdate(name string, update func(client *boiler.APIClient) []string) (*boiler.APIClient, error) {
+	tx, err := s.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	client, err := boiler.APIClients(
+		boiler.APIClientWhere.Name.EQ(name),
+		boiler.APIClientWhere.RevokedAt.IsNull(),
+		qm.For("UPDATE"),
+	).One(tx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrAPIClientNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = client.Update(tx, boil.Whitelist(update(client)...))
+	if err != nil {
+		return nil, DomainError(err)
+	}
+
+	err = s.Notify(tx, APIClientsChannel, client.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
@@ -0,0 +1,101 @@
+package storage
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"time"
+	"xsyn-transactions/boiler"
+)
+
+// BalanceSnapshotsCreate snapshots the posted totals of every account that has had transactions since its last snapshot.
+// A snapshot covers every transaction created before takenAt.
+func (s *Storage) BalanceSnapshotsCreate(takenAt time.Time) (int64, error) {
+	result, err := s.Exec(`
+		INSERT INTO balance_snapshots (account_id, taken_at, debits_posted, credits_posted)
+		SELECT a.id,
+		       $1,
+		       COALESCE(bs.debits_posted, 0) + (SELECT COALESCE(SUM(t.amount), 0)
+		                                        FROM transactions t
+		                                        WHERE t.debit_account_id = a.id
+		                                          AND t.created_at >= COALESCE(bs.taken_at, '-infinity')
+		                                          AND t.created_at < $1),
+		       COALESCE(bs.credits_posted, 0) + (SELECT COALESCE(SUM(t.amount), 0)
+		                                         FROM transactions t
+		                                         WHERE t.credit_account_id = a.id
+		                                           AND t.created_at >= COALESCE(bs.taken_at, '-infinity')
+		                                           AND t.created_at < $1)
+		FROM accounts a
+		         LEFT JOIN LATERAL (SELECT *
+		                            FROM balance_snapshots
+		                            WHERE balance_snapshots.account_id = a.id
+		                            ORDER BY balance_snapshots.taken_at DESC
+		                            LIMIT 1) bs ON TRUE
+		WHERE COALESCE(bs.taken_at, '-infinity') < $1
+		  AND EXISTS(SELECT 1
+		             FROM transactions t
+		             WHERE (t.debit_account_id = a.id OR t.credit_account_id = a.id)
+		               AND t.created_at >= COALESCE(bs.taken_at, '-infinity')
+		               AND t.created_at < $1)`,
+		takenAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+// BalanceAt returns the posted balance of an account at a point in time, summing transactions from the latest snapshot before it
+func (s *Storage) BalanceAt(accountID string, at time.Time) (decimal.Decimal, error) {
+	return balanceAt(s, accountID, at)
+}
+
+func balanceAt(exec boil.Executor, accountID string, at time.Time) (decimal.Decimal, error) {
+	debitsPosted := decimal.Zero
+	creditsPosted := decimal.Zero
+	from := time.Time{}
+
+	snapshot, err := boiler.BalanceSnapshots(
+		boiler.BalanceSnapshotWhere.AccountID.EQ(accountID),
+		boiler.BalanceSnapshotWhere.TakenAt.LTE(at),
+		qm.OrderBy(fmt.Sprintf("%s DESC", boiler.BalanceSnapshotColumns.TakenAt)),
+	).One(exec)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return decimal.Zero, err
+	}
+	if snapshot != nil {
+		debitsPosted = snapshot.DebitsPosted
+		creditsPosted = snapshot.CreditsPosted
+		from = snapshot.TakenAt
+	}
+
+	var debits, credits decimal.Decimal
+	err = exec.QueryRow(`
+		SELECT COALESCE(SUM(amount), 0)
+		FROM transactions
+		WHERE debit_account_id = $1
+		  AND created_at >= $2
+		  AND created_at <= $3`,
+		accountID, from, at,
+	).Scan(&debits)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	err = exec.QueryRow(`
+		SELECT COALESCE(SUM(amount), 0)
+		FROM transactions
+		WHERE credit_account_id = $1
+		  AND created_at >= $2
+		  AND created_at <= $3`,
+		accountID, from, at,
+	).Scan(&credits)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return creditsPosted.Add(credits).Sub(debitsPosted).Sub(debits), nil
+}
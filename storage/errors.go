@@ -0,0 +1,59 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"github.com/jackc/pgconn"
+)
+
+// the errors the balance triggers raise, see the error_codes migration for their SQLSTATEs
+var (
+	ErrTransferToSelf             = fmt.Errorf("unable to transfer to self")
+	ErrLedgerMismatch             = fmt.Errorf("account ledger does not match transaction ledger")
+	ErrNotEnoughFunds             = fmt.Errorf("not enough funds")
+	ErrDebitAccountFrozen         = fmt.Errorf("debit account is frozen")
+	ErrCreditAccountFrozen        = fmt.Errorf("credit account is frozen")
+	ErrDebitsDisabled             = fmt.Errorf("debit account has debits disabled")
+	ErrCreditsDisabled            = fmt.Errorf("credit account has credits disabled")
+	ErrRefundedTransactionMissing = fmt.Errorf("refunded transaction does not exist")
+	ErrRefundExceedsOriginal      = fmt.Errorf("refund exceeds original amount")
+	ErrPendingTransferResolved    = fmt.Errorf("pending transfer is already resolved")
+	ErrPendingTransferInvalid     = fmt.Errorf("invalid pending transfer change")
+)
+
+// ErrAlreadyExists is returned when a row with the same key exists
+var ErrAlreadyExists = fmt.Errorf("already exists")
+
+var triggerErrors = map[string]error{
+	"XT001": ErrTransferToSelf,
+	"XT002": ErrLedgerMismatch,
+	"XT003": ErrNotEnoughFunds,
+	"XT004": ErrDebitAccountFrozen,
+	"XT005": ErrCreditAccountFrozen,
+	"XT006": ErrDebitsDisabled,
+	"XT007": ErrCreditsDisabled,
+	"XT008": ErrRefundedTransactionMissing,
+	"XT009": ErrRefundExceedsOriginal,
+	"XT010": ErrPendingTransferResolved,
+	"XT011": ErrPendingTransferInvalid,
+}
+
+// the SQLSTATE of a unique constraint violation
+const uniqueViolation = "23505"
+
+// DomainError translates a postgres error into the storage error it stands for, other errors are returned as they are
+func DomainError(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	if triggerErr, ok := triggerErrors[pgErr.Code]; ok {
+		return fmt.Errorf("%w: %s", triggerErr, pgErr.Message)
+	}
+	if pgErr.Code == uniqueViolation {
+		return fmt.Errorf("%w: %s", ErrAlreadyExists, pgErr.ConstraintName)
+	}
+
+	return err
+}
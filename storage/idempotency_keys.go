@@ -0,0 +1,10 @@
+package storage
+
+import (
+	"xsyn-transactions/boiler"
+)
+
+// IdempotencyKeyGet returns the idempotency key the client stored, sql.ErrNoRows is returned if the client hasn't used the key
+func (s *Storage) IdempotencyKeyGet(clientID string, key string) (*boiler.IdempotencyKey, error) {
+	return boiler.FindIdempotencyKey(s, clientID, key)
+}
@@ -0,0 +1,84 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx/v4"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"time"
+	"xsyn-transactions/boiler"
+)
+
+// SessionLock is a postgres session advisory lock on its own connection, it is released when the connection closes
+type SessionLock struct {
+	conn *pgx.Conn
+	key  int64
+}
+
+func (s *Storage) NewSessionLock(ctx context.Context, key int64) (*SessionLock, error) {
+	conn, err := pgx.ConnectConfig(ctx, s.connConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &SessionLock{conn: conn, key: key}, nil
+}
+
+// TryAcquire takes the lock if no other session holds it
+func (l *SessionLock) TryAcquire(ctx context.Context) (bool, error) {
+	acquired := false
+	err := l.conn.QueryRow(ctx, "SELECT pg_try_advisory_lock($1)", l.key).Scan(&acquired)
+	return acquired, err
+}
+
+// Ping checks the connection, and so the lock, is still alive
+func (l *SessionLock) Ping(ctx context.Context) error {
+	return l.conn.Ping(ctx)
+}
+
+func (l *SessionLock) Close() {
+	_ = l.conn.Close(context.Background())
+}
+
+var ErrLeaderFenced = fmt.Errorf("another replica was elected leader")
+
+// LeaderSet records the replica that holds the leader lock and starts a new epoch, returning it.
+// Bumping the epoch waits for the writes that passed LeaderFence on the previous one to commit.
+func (s *Storage) LeaderSet(instanceID string, address string) (int64, error) {
+	var epoch int64
+	err := s.QueryRow(`
+		INSERT INTO leader (id, instance_id, address, elected_at, epoch)
+		VALUES (1, $1, $2, $3, 1)
+		ON CONFLICT (id) DO UPDATE SET
+			instance_id = excluded.instance_id,
+			address = excluded.address,
+			elected_at = excluded.elected_at,
+			epoch = leader.epoch + 1
+		RETURNING epoch`,
+		instanceID, address, time.Now(),
+	).Scan(&epoch)
+	return epoch, err
+}
+
+// LeaderFence checks epoch is still the leader's within tx, call it last thing before committing a write.
+// The leader row stays share locked until tx ends, so a new leader's epoch waits for tx to commit,
+// and a write checked after the new epoch fails with ErrLeaderFenced instead of racing the new leader.
+func LeaderFence(tx boil.Executor, epoch int64) error {
+	var current int64
+	err := tx.QueryRow(`SELECT epoch FROM leader WHERE id = 1 FOR SHARE`).Scan(&current)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrLeaderFenced
+	}
+	if err != nil {
+		return err
+	}
+	if current != epoch {
+		return fmt.Errorf("%w: epoch %d is now %d", ErrLeaderFenced, epoch, current)
+	}
+	return nil
+}
+
+func (s *Storage) LeaderGet() (*boiler.Leader, error) {
+	return boiler.FindLeader(s, 1)
+}
@@ -0,0 +1,29 @@
+package storage
+
+import (
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// MetadataToJSON converts transfer metadata for a jsonb column, no metadata is stored as an empty object
+func MetadataToJSON(metadata *structpb.Struct) (types.JSON, error) {
+	if metadata == nil {
+		return types.JSON("{}"), nil
+	}
+	b, err := protojson.Marshal(metadata)
+	if err != nil {
+		return nil, err
+	}
+	return types.JSON(b), nil
+}
+
+// MetadataFromJSON converts a jsonb column to transfer metadata, an empty object is returned as nil
+func MetadataFromJSON(metadata types.JSON) *structpb.Struct {
+	s := &structpb.Struct{}
+	err := protojson.Unmarshal(metadata, s)
+	if err != nil || len(s.Fields) == 0 {
+		return nil
+	}
+	return s
+}
@@ -0,0 +1,38 @@
+package storage
+
+import (
+	"context"
+	"github.com/jackc/pgx/v4"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// Notify sends a postgres notification, in a db transaction it is only delivered on commit
+func (s *Storage) Notify(exec boil.Executor, channel string, payload string) error {
+	_, err := exec.Exec("SELECT pg_notify($1, $2)", channel, payload)
+	return err
+}
+
+// Listen holds a connection listening on a channel and calls handle with each payload until the context ends or the connection fails.
+// onListen is called once the connection is listening.
+func (s *Storage) Listen(ctx context.Context, channel string, onListen func(), handle func(payload string)) error {
+	conn, err := pgx.ConnectConfig(ctx, s.connConfig)
+	if err != nil {
+		return err
+	}
+	defer conn.Close(context.Background())
+
+	_, err = conn.Exec(ctx, "LISTEN "+pgx.Identifier{channel}.Sanitize())
+	if err != nil {
+		return err
+	}
+
+	onListen()
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+		handle(notification.Payload)
+	}
+}
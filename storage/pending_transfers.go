@@ -0,0 +1,62 @@
+package storage
+
+import (
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"time"
+	"xsyn-transactions/boiler"
+	transactionsv1 "xsyn-transactions/gen/transactions/v1"
+)
+
+func (s *Storage) PendingTransferGetByID(pendingTransferID string) (*transactionsv1.PendingTransfer, error) {
+	pendingTransfer, err := boiler.PendingTransfers(
+		boiler.PendingTransferWhere.ID.EQ(pendingTransferID),
+		qm.Load(boiler.PendingTransferRels.CreditAccount),
+		qm.Load(boiler.PendingTransferRels.DebitAccount),
+	).One(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return PendingTransferToProto(pendingTransfer), nil
+}
+
+// PendingTransfersExpired returns the ids of the pending transfers that have passed their expiry
+func (s *Storage) PendingTransfersExpired(now time.Time) ([]string, error) {
+	pendingTransfers, err := boiler.PendingTransfers(
+		qm.Select(boiler.PendingTransferColumns.ID),
+		boiler.PendingTransferWhere.Status.EQ(int(transactionsv1.PendingTransferStatus_PendingStatusPending)),
+		boiler.PendingTransferWhere.ExpiresAt.LTE(now),
+		qm.OrderBy(boiler.PendingTransferColumns.ExpiresAt),
+	).All(s)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []string{}
+	for _, pendingTransfer := range pendingTransfers {
+		ids = append(ids, pendingTransfer.ID)
+	}
+
+	return ids, nil
+}
+
+// PendingTransferToProto converts a pending transfer row, the credit and debit accounts need to be loaded
+func PendingTransferToProto(pendingTransfer *boiler.PendingTransfer) *transactionsv1.PendingTransfer {
+	return &transactionsv1.PendingTransfer{
+		Id:              pendingTransfer.ID,
+		CreditUserId:    pendingTransfer.R.CreditAccount.XsynUserID,
+		CreditAccountId: pendingTransfer.CreditAccountID,
+		DebitUserId:     pendingTransfer.R.DebitAccount.XsynUserID,
+		DebitAccountId:  pendingTransfer.DebitAccountID,
+		Amount:          pendingTransfer.Amount.String(),
+		Ledger:          transactionsv1.Ledger(pendingTransfer.Ledger),
+		Code:            transactionsv1.TransferCode(pendingTransfer.TransferCode),
+		Status:          transactionsv1.PendingTransferStatus(pendingTransfer.Status),
+		TransactionId:   pendingTransfer.TransactionID.String,
+		ExpiresAt:       pendingTransfer.ExpiresAt.Unix(),
+		CreatedAt:       pendingTransfer.CreatedAt.Unix(),
+		InitiatorId:     pendingTransfer.InitiatorID.String,
+		ReferenceId:     pendingTransfer.ReferenceID.String,
+		Metadata:        MetadataFromJSON(pendingTransfer.Metadata),
+	}
+}
@@ -0,0 +1,151 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	transactionsv1 "xsyn-transactions/gen/transactions/v1"
+)
+
+// AccountTotals holds an account's balance columns next to the sums they are derived from
+type AccountTotals struct {
+	AccountID      string
+	UserID         string
+	Ledger         transactionsv1.Ledger
+	DebitsPosted   decimal.Decimal
+	CreditsPosted  decimal.Decimal
+	DebitsPending  decimal.Decimal
+	CreditsPending decimal.Decimal
+
+	TransactionDebits      decimal.Decimal
+	TransactionCredits     decimal.Decimal
+	PendingTransferDebits  decimal.Decimal
+	PendingTransferCredits decimal.Decimal
+}
+
+// Balance returns the available balance from the account columns, matching AccountToProto
+func (a *AccountTotals) Balance() decimal.Decimal {
+	return AccountBalance(a.CreditsPosted, a.DebitsPosted, a.DebitsPending)
+}
+
+// Drift is a value that disagrees with what it should be derived from
+type Drift struct {
+	Field    string
+	Expected string
+	Actual   string
+}
+
+// Drift compares the account columns against the transaction and pending transfer sums,
+// and the cached account against the columns when one is given
+func (a *AccountTotals) Drift(cached *transactionsv1.Account) []*Drift {
+	drift := []*Drift{}
+
+	check := func(field string, expected decimal.Decimal, actual decimal.Decimal) {
+		if !expected.Equal(actual) {
+			drift = append(drift, &Drift{Field: field, Expected: expected.String(), Actual: actual.String()})
+		}
+	}
+	checkString := func(field string, expected decimal.Decimal, actual string) {
+		d, err := decimal.NewFromString(actual)
+		if err != nil {
+			drift = append(drift, &Drift{Field: field, Expected: expected.String(), Actual: actual})
+			return
+		}
+		check(field, expected, d)
+	}
+
+	check("accounts.debits_posted", a.TransactionDebits, a.DebitsPosted)
+	check("accounts.credits_posted", a.TransactionCredits, a.CreditsPosted)
+	check("accounts.debits_pending", a.PendingTransferDebits, a.DebitsPending)
+	check("accounts.credits_pending", a.PendingTransferCredits, a.CreditsPending)
+
+	if cached != nil {
+		checkString("cache.balance", a.Balance(), cached.Balance)
+		checkString("cache.debits_pending", a.DebitsPending, cached.DebitsPending)
+		checkString("cache.credits_pending", a.CreditsPending, cached.CreditsPending)
+	}
+
+	return drift
+}
+
+// BeginSnapshot starts a read only transaction and pins its snapshot, so later reads see the database as it was when this returns
+func (s *Storage) BeginSnapshot() (*sql.Tx, error) {
+	tx, err := s.BeginTx(context.Background(), &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+
+	// postgres takes the snapshot on the first statement, not on begin
+	_, err = tx.Exec("SELECT 1")
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// AccountTotalsGet returns the totals of every account, summing the whole transactions table
+func (s *Storage) AccountTotalsGet(exec boil.Executor) ([]*AccountTotals, error) {
+	rows, err := exec.Query(`
+		SELECT a.id,
+		       a.xsyn_user_id,
+		       a.ledger,
+		       a.debits_posted,
+		       a.credits_posted,
+		       a.debits_pending,
+		       a.credits_pending,
+		       COALESCE(td.amount, 0),
+		       COALESCE(tc.amount, 0),
+		       COALESCE(pd.amount, 0),
+		       COALESCE(pc.amount, 0)
+		FROM accounts a
+		         LEFT JOIN (SELECT debit_account_id, SUM(amount) AS amount
+		                    FROM transactions
+		                    GROUP BY debit_account_id) td ON td.debit_account_id = a.id
+		         LEFT JOIN (SELECT credit_account_id, SUM(amount) AS amount
+		                    FROM transactions
+		                    GROUP BY credit_account_id) tc ON tc.credit_account_id = a.id
+		         LEFT JOIN (SELECT debit_account_id, SUM(amount) AS amount
+		                    FROM pending_transfers
+		                    WHERE status = $1
+		                    GROUP BY debit_account_id) pd ON pd.debit_account_id = a.id
+		         LEFT JOIN (SELECT credit_account_id, SUM(amount) AS amount
+		                    FROM pending_transfers
+		                    WHERE status = $1
+		                    GROUP BY credit_account_id) pc ON pc.credit_account_id = a.id
+		ORDER BY a.id`,
+		int(transactionsv1.PendingTransferStatus_PendingStatusPending),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	results := []*AccountTotals{}
+	for rows.Next() {
+		totals := &AccountTotals{}
+		var ledger int
+		err = rows.Scan(
+			&totals.AccountID,
+			&totals.UserID,
+			&ledger,
+			&totals.DebitsPosted,
+			&totals.CreditsPosted,
+			&totals.DebitsPending,
+			&totals.CreditsPending,
+			&totals.TransactionDebits,
+			&totals.TransactionCredits,
+			&totals.PendingTransferDebits,
+			&totals.PendingTransferCredits,
+		)
+		if err != nil {
+			return nil, err
+		}
+		totals.Ledger = transactionsv1.Ledger(ledger)
+		results = append(results, totals)
+	}
+
+	return results, rows.Err()
+}
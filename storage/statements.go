@@ -0,0 +1,117 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"sort"
+	"time"
+	"xsyn-transactions/boiler"
+	transactionsv1 "xsyn-transactions/gen/transactions/v1"
+)
+
+// ErrStatementTooLarge is returned when a statement would have more lines than allowed
+var ErrStatementTooLarge = fmt.Errorf("too many transfers for one statement, ask for a shorter range")
+
+// StatementGet builds the statement of an account for the transfers after from and up to and including to, at most limit of them.
+// The opening balance and the lines are read in one snapshot so the closing balance always adds up.
+func (s *Storage) StatementGet(account *transactionsv1.Account, from time.Time, to time.Time, limit int) (*transactionsv1.Statement, error) {
+	tx, err := s.BeginTx(context.Background(), &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	opening, err := balanceAt(tx, account.Id, from)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions, err := boiler.Transactions(
+		qm.Expr(
+			boiler.TransactionWhere.DebitAccountID.EQ(account.Id),
+			qm.Or2(boiler.TransactionWhere.CreditAccountID.EQ(account.Id)),
+		),
+		boiler.TransactionWhere.CreatedAt.GT(from),
+		boiler.TransactionWhere.CreatedAt.LTE(to),
+		qm.Load(boiler.TransactionRels.CreditAccount),
+		qm.Load(boiler.TransactionRels.DebitAccount),
+		qm.OrderBy(boiler.TransactionColumns.CreatedAt+", "+boiler.TransactionColumns.ID),
+		qm.Limit(limit+1),
+	).All(tx)
+	if err != nil {
+		return nil, err
+	}
+	if len(transactions) > limit {
+		return nil, ErrStatementTooLarge
+	}
+
+	statement := &transactionsv1.Statement{
+		AccountId:      account.Id,
+		UserId:         account.UserId,
+		Ledger:         account.Ledger,
+		From:           from.Unix(),
+		To:             to.Unix(),
+		OpeningBalance: opening.String(),
+		Lines:          []*transactionsv1.StatementLine{},
+		Subtotals:      []*transactionsv1.StatementSubtotal{},
+	}
+
+	type subtotal struct {
+		credits decimal.Decimal
+		debits  decimal.Decimal
+		count   int64
+	}
+	subtotals := map[transactionsv1.TransferCode]*subtotal{}
+
+	running := opening
+	for _, transaction := range transactions {
+		line := &transactionsv1.StatementLine{
+			Transfer: TransactionToProto(transaction),
+		}
+
+		code := transactionsv1.TransferCode(transaction.TransferCode)
+		st, ok := subtotals[code]
+		if !ok {
+			st = &subtotal{}
+			subtotals[code] = st
+		}
+		st.count++
+
+		amount := decimal.Zero
+		if transaction.CreditAccountID == account.Id {
+			line.Direction = transactionsv1.StatementDirection_StatementDirectionCredit
+			amount = amount.Add(transaction.Amount)
+			st.credits = st.credits.Add(transaction.Amount)
+		}
+		if transaction.DebitAccountID == account.Id {
+			line.Direction = transactionsv1.StatementDirection_StatementDirectionDebit
+			amount = amount.Sub(transaction.Amount)
+			st.debits = st.debits.Add(transaction.Amount)
+		}
+
+		running = running.Add(amount)
+		line.Amount = amount.String()
+		line.RunningBalance = running.String()
+		statement.Lines = append(statement.Lines, line)
+	}
+
+	for code, st := range subtotals {
+		statement.Subtotals = append(statement.Subtotals, &transactionsv1.StatementSubtotal{
+			Code:    code,
+			Credits: st.credits.String(),
+			Debits:  st.debits.String(),
+			Net:     st.credits.Sub(st.debits).String(),
+			Count:   st.count,
+		})
+	}
+	sort.Slice(statement.Subtotals, func(i, j int) bool {
+		return statement.Subtotals[i].Code < statement.Subtotals[j].Code
+	})
+
+	statement.ClosingBalance = running.String()
+
+	return statement, nil
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/stdlib"
 	"github.com/rs/zerolog"
+	"github.com/shopspring/decimal"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 	"net/url"
@@ -15,7 +16,8 @@ import (
 
 type Storage struct {
 	*sql.DB
-	log *zerolog.Logger
+	log        *zerolog.Logger
+	connConfig *pgx.ConnConfig
 }
 
 type Opts struct {
@@ -55,6 +57,7 @@ func NewStorage(
 		return nil, err
 	}
 	newStorage.DB = stdlib.OpenDB(*cfg)
+	newStorage.connConfig = cfg
 	if err != nil {
 		return nil, err
 	}
@@ -74,16 +77,7 @@ func (s *Storage) GetAllAccounts() ([]*transactionsv1.Account, error) {
 		return nil, err
 	}
 	for _, acc := range accounts {
-		results = append(results, &transactionsv1.Account{
-			Id:            acc.ID,
-			UserId:        acc.XsynUserID,
-			Ledger:        transactionsv1.Ledger(acc.Ledger),
-			Code:          transactionsv1.AccountCode(acc.AccountCode),
-			DebitsPosted:  acc.DebitsPosted.String(),
-			CreditsPosted: acc.CreditsPosted.String(),
-			Balance:       acc.CreditsPosted.Sub(acc.DebitsPosted).String(),
-			CreatedAt:     acc.CreatedAt.Unix(),
-		})
+		results = append(results, AccountToProto(acc))
 	}
 
 	return results, nil
@@ -101,21 +95,71 @@ func (s *Storage) GetAllUserAccounts(userID string) ([]*transactionsv1.Account,
 	}
 
 	for _, acc := range accounts {
-		results = append(results, &transactionsv1.Account{
-			Id:            acc.ID,
-			UserId:        acc.XsynUserID,
-			Ledger:        transactionsv1.Ledger(acc.Ledger),
-			Code:          transactionsv1.AccountCode(acc.AccountCode),
-			DebitsPosted:  acc.DebitsPosted.String(),
-			CreditsPosted: acc.CreditsPosted.String(),
-			Balance:       acc.CreditsPosted.Sub(acc.DebitsPosted).String(),
-			CreatedAt:     acc.CreatedAt.Unix(),
-		})
+		results = append(results, AccountToProto(acc))
 	}
 
 	return results, nil
 }
 
+// AccountBalance is what an account has available, its posted credits less its posted and pending debits
+func AccountBalance(creditsPosted decimal.Decimal, debitsPosted decimal.Decimal, debitsPending decimal.Decimal) decimal.Decimal {
+	return creditsPosted.Sub(debitsPosted).Sub(debitsPending)
+}
+
+// AccountToProto converts an account row, the balance is what is available after pending debits are held
+func AccountToProto(acc *boiler.Account) *transactionsv1.Account {
+	return &transactionsv1.Account{
+		Id:                   acc.ID,
+		UserId:               acc.XsynUserID,
+		Ledger:               transactionsv1.Ledger(acc.Ledger),
+		Code:                 transactionsv1.AccountCode(acc.AccountCode),
+		DebitsPosted:         acc.DebitsPosted.String(),
+		CreditsPosted:        acc.CreditsPosted.String(),
+		DebitsPending:        acc.DebitsPending.String(),
+		CreditsPending:       acc.CreditsPending.String(),
+		Balance:              AccountBalance(acc.CreditsPosted, acc.DebitsPosted, acc.DebitsPending).String(),
+		CreatedAt:            acc.CreatedAt.Unix(),
+		AllowNegativeBalance: acc.AllowNegativeBalance,
+		OverdraftLimit:       acc.OverdraftLimit.String(),
+		DebitsDisabled:       acc.DebitsDisabled,
+		CreditsDisabled:      acc.CreditsDisabled,
+		Frozen:               acc.Frozen,
+		FrozenCredits:        acc.FrozenCredits,
+	}
+}
+
+type AccountFlags struct {
+	AllowNegativeBalance bool
+	OverdraftLimit       decimal.Decimal
+	DebitsDisabled       bool
+	CreditsDisabled      bool
+}
+
+// AccountFlagsSet updates the flags the balance triggers check and returns the updated account
+func (s *Storage) AccountFlagsSet(exec boil.Executor, accountID string, flags *AccountFlags) (*transactionsv1.Account, error) {
+	account, err := boiler.FindAccount(exec, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	account.AllowNegativeBalance = flags.AllowNegativeBalance
+	account.OverdraftLimit = flags.OverdraftLimit
+	account.DebitsDisabled = flags.DebitsDisabled
+	account.CreditsDisabled = flags.CreditsDisabled
+
+	_, err = account.Update(exec, boil.Whitelist(
+		boiler.AccountColumns.AllowNegativeBalance,
+		boiler.AccountColumns.OverdraftLimit,
+		boiler.AccountColumns.DebitsDisabled,
+		boiler.AccountColumns.CreditsDisabled,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	return AccountToProto(account), nil
+}
+
 func (s *Storage) DataExists() (bool, error) {
 	// since we can't make this docker on run once, we check if data already exists. If so return nil
 	count, err := boiler.Accounts(qm.Limit(1)).Count(s)
@@ -1,8 +1,15 @@
 package storage
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"time"
 	"xsyn-transactions/boiler"
 	transactionsv1 "xsyn-transactions/gen/transactions/v1"
 )
@@ -17,18 +24,190 @@ func (s *Storage) TransactionGetByID(transactionID string) (*transactionsv1.Comp
 		return nil, err
 	}
 
+	return TransactionToProto(transaction), nil
+}
+
+// TransactionRefunds returns the refunds made against a transaction, oldest first
+func (s *Storage) TransactionRefunds(transactionID string) ([]*transactionsv1.CompletedTransfer, error) {
+	results := []*transactionsv1.CompletedTransfer{}
+
+	refunds, err := boiler.Transactions(
+		boiler.TransactionWhere.ParentTransactionID.EQ(null.StringFrom(transactionID)),
+		qm.Load(boiler.TransactionRels.CreditAccount),
+		qm.Load(boiler.TransactionRels.DebitAccount),
+		qm.OrderBy(boiler.TransactionColumns.CreatedAt),
+	).All(s)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, refund := range refunds {
+		results = append(results, TransactionToProto(refund))
+	}
+
+	return results, nil
+}
+
+// TransactionSequenceNext takes the next n sequence numbers, in order. It runs on the writer's db transaction,
+// a writer waiting on the pool for another connection while it holds one can starve it.
+func (s *Storage) TransactionSequenceNext(exec boil.Executor, n int) ([]int64, error) {
+	rows, err := exec.Query(`SELECT NEXTVAL('transactions_seq') FROM GENERATE_SERIES(1, $1)`, n)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	seqs := make([]int64, 0, n)
+	for rows.Next() {
+		var seq int64
+		err = rows.Scan(&seq)
+		if err != nil {
+			return nil, err
+		}
+		seqs = append(seqs, seq)
+	}
+	return seqs, rows.Err()
+}
+
+// TransactionSequenceLast returns the last sequence number taken, 0 if none have been
+func (s *Storage) TransactionSequenceLast() (int64, error) {
+	var last int64
+	err := s.QueryRow(`SELECT CASE WHEN is_called THEN last_value ELSE last_value - 1 END FROM transactions_seq`).Scan(&last)
+	return last, err
+}
+
+// TransactionSequence sets the sequence number of an inserted transaction.
+// Inserts take a number from the column default, the writer replaces it with one taken in the order it numbers transfers.
+func (s *Storage) TransactionSequence(exec boil.Executor, transaction *boiler.Transaction) error {
+	_, err := exec.Exec(
+		`UPDATE transactions SET seq = $3 WHERE id = $1 AND created_at = $2`,
+		transaction.ID,
+		transaction.CreatedAt,
+		transaction.Seq,
+	)
+	return err
+}
+
+// ErrReplayTooLarge is returned when there are more transfers to replay than allowed
+var ErrReplayTooLarge = fmt.Errorf("too many transfers to replay")
+
+// TransfersReplay returns the account updates of the transfers after a sequence up to another, oldest first.
+// The accounts carry their posted totals as they were after each transfer.
+func (s *Storage) TransfersReplay(after int64, upTo int64, limit int) ([]*transactionsv1.TransferCompleteSubscribeResponse, error) {
+	tx, err := s.BeginSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	transactions, err := boiler.Transactions(
+		boiler.TransactionWhere.Seq.GT(after),
+		boiler.TransactionWhere.Seq.LTE(upTo),
+		qm.Load(boiler.TransactionRels.CreditAccount),
+		qm.Load(boiler.TransactionRels.DebitAccount),
+		qm.OrderBy(boiler.TransactionColumns.Seq),
+		qm.Limit(limit+1),
+	).All(tx)
+	if err != nil {
+		return nil, err
+	}
+	if len(transactions) > limit {
+		return nil, ErrReplayTooLarge
+	}
+	if len(transactions) == 0 {
+		return []*transactionsv1.TransferCompleteSubscribeResponse{}, nil
+	}
+
+	accountIDs := []string{}
+	replayed := map[string]bool{}
+	for _, transaction := range transactions {
+		for _, accountID := range []string{transaction.DebitAccountID, transaction.CreditAccountID} {
+			if !replayed[accountID] {
+				replayed[accountID] = true
+				accountIDs = append(accountIDs, accountID)
+			}
+		}
+	}
+
+	// transfers after upTo that already committed are in the accounts' totals, so they're walked back first
+	later, err := boiler.Transactions(
+		qm.Select(
+			boiler.TransactionColumns.DebitAccountID,
+			boiler.TransactionColumns.CreditAccountID,
+			boiler.TransactionColumns.Amount,
+		),
+		boiler.TransactionWhere.Seq.GT(upTo),
+		qm.Expr(
+			boiler.TransactionWhere.DebitAccountID.IN(accountIDs),
+			qm.Or2(boiler.TransactionWhere.CreditAccountID.IN(accountIDs)),
+		),
+	).All(tx)
+	if err != nil {
+		return nil, err
+	}
+	laterDebits := map[string]decimal.Decimal{}
+	laterCredits := map[string]decimal.Decimal{}
+	for _, transaction := range later {
+		laterDebits[transaction.DebitAccountID] = laterDebits[transaction.DebitAccountID].Add(transaction.Amount)
+		laterCredits[transaction.CreditAccountID] = laterCredits[transaction.CreditAccountID].Add(transaction.Amount)
+	}
+
+	// the accounts are loaded in the same snapshot, so walking back from their totals gives the totals after each transfer.
+	// Holds aren't numbered, so the pending totals are the accounts' current ones.
+	debitsPosted := map[string]decimal.Decimal{}
+	creditsPosted := map[string]decimal.Decimal{}
+	accountAfter := func(account *boiler.Account) *transactionsv1.Account {
+		if _, ok := debitsPosted[account.ID]; !ok {
+			debitsPosted[account.ID] = account.DebitsPosted.Sub(laterDebits[account.ID])
+			creditsPosted[account.ID] = account.CreditsPosted.Sub(laterCredits[account.ID])
+		}
+		result := AccountToProto(account)
+		result.DebitsPosted = debitsPosted[account.ID].String()
+		result.CreditsPosted = creditsPosted[account.ID].String()
+		result.Balance = AccountBalance(creditsPosted[account.ID], debitsPosted[account.ID], account.DebitsPending).String()
+		return result
+	}
+
+	results := make([]*transactionsv1.TransferCompleteSubscribeResponse, len(transactions)*2)
+	for i := len(transactions) - 1; i >= 0; i-- {
+		transaction := transactions[i]
+		completedTx := TransactionToProto(transaction)
+
+		// debit then credit, the same order as live updates
+		results[i*2] = &transactionsv1.TransferCompleteSubscribeResponse{
+			Account:     accountAfter(transaction.R.DebitAccount),
+			Transaction: completedTx,
+		}
+		results[i*2+1] = &transactionsv1.TransferCompleteSubscribeResponse{
+			Account:     accountAfter(transaction.R.CreditAccount),
+			Transaction: completedTx,
+		}
+
+		debitsPosted[transaction.DebitAccountID] = debitsPosted[transaction.DebitAccountID].Sub(transaction.Amount)
+		creditsPosted[transaction.CreditAccountID] = creditsPosted[transaction.CreditAccountID].Sub(transaction.Amount)
+	}
+
+	return results, nil
+}
+
+// TransactionToProto converts a transaction row, the credit and debit accounts need to be loaded
+func TransactionToProto(transaction *boiler.Transaction) *transactionsv1.CompletedTransfer {
 	return &transactionsv1.CompletedTransfer{
-			Id:              transaction.ID,
-			CreditUserId:    transaction.R.CreditAccount.XsynUserID,
-			CreditAccountId: transaction.CreditAccountID,
-			DebitUserId:     transaction.R.DebitAccount.XsynUserID,
-			DebitAccountId:  transaction.DebitAccountID,
-			Amount:          transaction.Amount.String(),
-			Ledger:          transactionsv1.Ledger(transaction.Ledger),
-			Code:            transactionsv1.TransferCode(transaction.TransferCode),
-			Timestamp:       transaction.CreatedAt.Unix(),
-		},
-		nil
+		Id:                  transaction.ID,
+		CreditUserId:        transaction.R.CreditAccount.XsynUserID,
+		CreditAccountId:     transaction.CreditAccountID,
+		DebitUserId:         transaction.R.DebitAccount.XsynUserID,
+		DebitAccountId:      transaction.DebitAccountID,
+		Amount:              transaction.Amount.String(),
+		Ledger:              transactionsv1.Ledger(transaction.Ledger),
+		Code:                transactionsv1.TransferCode(transaction.TransferCode),
+		Timestamp:           transaction.CreatedAt.Unix(),
+		ParentTransactionId: transaction.ParentTransactionID.String,
+		Sequence:            transaction.Seq,
+		InitiatorId:         transaction.InitiatorID.String,
+		ReferenceId:         transaction.ReferenceID.String,
+		Metadata:            MetadataFromJSON(transaction.Metadata),
+	}
 }
 
 func ValidTransactionColumn(column string) bool {
@@ -39,76 +218,219 @@ func ValidTransactionColumn(column string) bool {
 		boiler.TransactionColumns.DebitAccountID,
 		boiler.TransactionColumns.CreditAccountID,
 		boiler.TransactionColumns.Ledger,
-		boiler.TransactionColumns.TransferCode:
+		boiler.TransactionColumns.TransferCode,
+		boiler.TransactionColumns.ParentTransactionID,
+		boiler.TransactionColumns.InitiatorID,
+		boiler.TransactionColumns.ReferenceID:
 		return true
 	default:
 		return false
 	}
 }
 
-func (s *Storage) TransactionsGetByAccountID(
-	accountID string,
-	offSet int,
-	pageSize int,
-	sortBy string,
-	sortDir string,
-) (int64, []*transactionsv1.CompletedTransfer, error) {
+// ErrInvalidPageToken is returned when a page token can't be decoded or doesn't match the requested sort direction
+var ErrInvalidPageToken = fmt.Errorf("invalid page token")
+
+// TransactionsQuery holds the filters and paging of an account's transactions, zero values leave a filter unset
+type TransactionsQuery struct {
+	AccountID             string
+	From                  time.Time
+	To                    time.Time
+	Codes                 []transactionsv1.TransferCode
+	Direction             transactionsv1.TransferDirection
+	CounterpartyAccountID string
+	AmountMin             decimal.NullDecimal
+	AmountMax             decimal.NullDecimal
+	InitiatorID           string
+	ReferenceID           string
+	// MetadataContains matches the transactions whose metadata contains it
+	MetadataContains types.JSON
+
+	PageToken string
+	Offset    int
+	// PageSize has to be set, every page is limited to it
+	PageSize  int
+	SortBy    string
+	SortDir   string
+	SkipTotal bool
+}
+
+// pageToken is the position of the last row of a page when paging on (created_at, id)
+type pageToken struct {
+	CreatedAt int64  `json:"c"`
+	ID        string `json:"i"`
+	Desc      bool   `json:"d"`
+}
+
+func encodePageToken(transaction *boiler.Transaction, desc bool) string {
+	b, _ := json.Marshal(&pageToken{
+		CreatedAt: transaction.CreatedAt.UnixMicro(),
+		ID:        transaction.ID,
+		Desc:      desc,
+	})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodePageToken(token string) (*pageToken, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	pt := &pageToken{}
+	err = json.Unmarshal(b, pt)
+	if err != nil || pt.ID == "" {
+		return nil, ErrInvalidPageToken
+	}
+	return pt, nil
+}
+
+// TransactionsGetByAccountID returns a page of an account's transactions, the total is -1 when skipped.
+// Sorting by created_at pages on (created_at, id) and returns a token for the next page, other sorts page by offset.
+func (s *Storage) TransactionsGetByAccountID(q *TransactionsQuery) (int64, []*transactionsv1.CompletedTransfer, string, error) {
 	results := []*transactionsv1.CompletedTransfer{}
 
-	queryMods := []qm.QueryMod{
-		boiler.TransactionWhere.DebitAccountID.EQ(accountID),
-		qm.Or2(
-			boiler.TransactionWhere.CreditAccountID.EQ(accountID),
-		),
+	queryMods := []qm.QueryMod{}
+
+	switch q.Direction {
+	case transactionsv1.TransferDirection_TransferDirectionIn:
+		queryMods = append(queryMods, boiler.TransactionWhere.CreditAccountID.EQ(q.AccountID))
+		if q.CounterpartyAccountID != "" {
+			queryMods = append(queryMods, boiler.TransactionWhere.DebitAccountID.EQ(q.CounterpartyAccountID))
+		}
+	case transactionsv1.TransferDirection_TransferDirectionOut:
+		queryMods = append(queryMods, boiler.TransactionWhere.DebitAccountID.EQ(q.AccountID))
+		if q.CounterpartyAccountID != "" {
+			queryMods = append(queryMods, boiler.TransactionWhere.CreditAccountID.EQ(q.CounterpartyAccountID))
+		}
+	default:
+		if q.CounterpartyAccountID != "" {
+			queryMods = append(queryMods, qm.Expr(
+				qm.Expr(
+					boiler.TransactionWhere.DebitAccountID.EQ(q.AccountID),
+					boiler.TransactionWhere.CreditAccountID.EQ(q.CounterpartyAccountID),
+				),
+				qm.Or2(qm.Expr(
+					boiler.TransactionWhere.CreditAccountID.EQ(q.AccountID),
+					boiler.TransactionWhere.DebitAccountID.EQ(q.CounterpartyAccountID),
+				)),
+			))
+		} else {
+			queryMods = append(queryMods, qm.Expr(
+				boiler.TransactionWhere.DebitAccountID.EQ(q.AccountID),
+				qm.Or2(boiler.TransactionWhere.CreditAccountID.EQ(q.AccountID)),
+			))
+		}
 	}
 
-	count, err := boiler.Transactions(queryMods...).Count(s)
-	if err != nil {
-		return 0, nil, err
+	if !q.From.IsZero() {
+		queryMods = append(queryMods, boiler.TransactionWhere.CreatedAt.GTE(q.From))
+	}
+	if !q.To.IsZero() {
+		queryMods = append(queryMods, boiler.TransactionWhere.CreatedAt.LT(q.To))
+	}
+	if len(q.Codes) > 0 {
+		codes := []int{}
+		for _, code := range q.Codes {
+			codes = append(codes, int(code))
+		}
+		queryMods = append(queryMods, boiler.TransactionWhere.TransferCode.IN(codes))
+	}
+	if q.AmountMin.Valid {
+		queryMods = append(queryMods, boiler.TransactionWhere.Amount.GTE(q.AmountMin.Decimal))
+	}
+	if q.AmountMax.Valid {
+		queryMods = append(queryMods, boiler.TransactionWhere.Amount.LTE(q.AmountMax.Decimal))
+	}
+	if q.InitiatorID != "" {
+		queryMods = append(queryMods, boiler.TransactionWhere.InitiatorID.EQ(null.StringFrom(q.InitiatorID)))
+	}
+	if q.ReferenceID != "" {
+		queryMods = append(queryMods, boiler.TransactionWhere.ReferenceID.EQ(null.StringFrom(q.ReferenceID)))
+	}
+	if len(q.MetadataContains) > 0 {
+		queryMods = append(queryMods, qm.Where(boiler.TransactionColumns.Metadata+" @> ?", q.MetadataContains))
 	}
-	if count == 0 {
-		return 0, results, nil
+
+	var count int64 = -1
+	if !q.SkipTotal {
+		var err error
+		count, err = boiler.Transactions(queryMods...).Count(s)
+		if err != nil {
+			return 0, nil, "", err
+		}
+		if count == 0 {
+			return 0, results, "", nil
+		}
 	}
 
 	queryMods = append(queryMods,
-		qm.Limit(pageSize),
 		qm.Load(boiler.TransactionRels.CreditAccount),
 		qm.Load(boiler.TransactionRels.DebitAccount),
 	)
 
-	if offSet > 0 {
-		queryMods = append(queryMods, qm.Offset(offSet))
-	}
-	if ValidTransactionColumn(sortBy) {
-		if sortDir == "desc" {
-			queryMods = append(queryMods, qm.OrderBy(fmt.Sprintf("%s DESC", sortBy)))
+	// unknown sort columns fall back to newest first
+	sortByCreatedAt := q.SortBy == boiler.TransactionColumns.CreatedAt
+	keyset := sortByCreatedAt || !ValidTransactionColumn(q.SortBy)
+	desc := !sortByCreatedAt || q.SortDir == "desc"
+
+	if keyset {
+		if q.PageToken != "" {
+			pt, err := decodePageToken(q.PageToken)
+			if err != nil {
+				return 0, nil, "", err
+			}
+			if pt.Desc != desc {
+				return 0, nil, "", ErrInvalidPageToken
+			}
+			comparison := ">"
+			if desc {
+				comparison = "<"
+			}
+			queryMods = append(queryMods, qm.Where(
+				fmt.Sprintf("(%s, %s) %s (?, ?)", boiler.TransactionColumns.CreatedAt, boiler.TransactionColumns.ID, comparison),
+				time.UnixMicro(pt.CreatedAt), pt.ID,
+			))
+		} else if q.Offset > 0 {
+			queryMods = append(queryMods, qm.Offset(q.Offset))
+		}
+		if desc {
+			queryMods = append(queryMods, qm.OrderBy(fmt.Sprintf("%s DESC, %s DESC", boiler.TransactionColumns.CreatedAt, boiler.TransactionColumns.ID)))
 		} else {
-			queryMods = append(queryMods, qm.OrderBy(sortBy))
+			queryMods = append(queryMods, qm.OrderBy(fmt.Sprintf("%s, %s", boiler.TransactionColumns.CreatedAt, boiler.TransactionColumns.ID)))
 		}
+		// fetch one extra row to know if there is a next page
+		queryMods = append(queryMods, qm.Limit(q.PageSize+1))
 	} else {
-		queryMods = append(queryMods, qm.OrderBy(fmt.Sprintf("%s DESC", boiler.TransactionColumns.CreatedAt)))
+		if q.PageToken != "" {
+			return 0, nil, "", ErrInvalidPageToken
+		}
+		if q.Offset > 0 {
+			queryMods = append(queryMods, qm.Offset(q.Offset))
+		}
+		if q.SortDir == "desc" {
+			queryMods = append(queryMods, qm.OrderBy(fmt.Sprintf("%s DESC", q.SortBy)))
+		} else {
+			queryMods = append(queryMods, qm.OrderBy(q.SortBy))
+		}
+		queryMods = append(queryMods, qm.Limit(q.PageSize))
 	}
 
-	transaction, err := boiler.Transactions(
+	transactions, err := boiler.Transactions(
 		queryMods...,
 	).All(s)
 	if err != nil {
-		return 0, nil, err
+		return 0, nil, "", err
+	}
+
+	nextPageToken := ""
+	if keyset && len(transactions) > q.PageSize {
+		transactions = transactions[:q.PageSize]
+		nextPageToken = encodePageToken(transactions[len(transactions)-1], desc)
 	}
 
-	for _, tx := range transaction {
-		results = append(results, &transactionsv1.CompletedTransfer{
-			Id:              tx.ID,
-			CreditUserId:    tx.R.CreditAccount.XsynUserID,
-			CreditAccountId: tx.CreditAccountID,
-			DebitUserId:     tx.R.DebitAccount.XsynUserID,
-			DebitAccountId:  tx.DebitAccountID,
-			Amount:          tx.Amount.String(),
-			Ledger:          transactionsv1.Ledger(tx.Ledger),
-			Code:            transactionsv1.TransferCode(tx.TransferCode),
-			Timestamp:       tx.CreatedAt.Unix(),
-		})
+	for _, tx := range transactions {
+		results = append(results, TransactionToProto(tx))
 	}
 
-	return count, results, nil
+	return count, results, nextPageToken, nil
 }
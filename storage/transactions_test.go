@@ -0,0 +1,65 @@
+package storage
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+	"xsyn-transactions/boiler"
+)
+
+func TestPageToken(t *testing.T) {
+	createdAt := time.Date(2022, 12, 1, 10, 30, 0, 123456000, time.UTC)
+	tx := &boiler.Transaction{ID: "0a8c1f6e-2d3b-4f5a-8e7d-1c2b3a4d5e6f", CreatedAt: createdAt}
+
+	for _, desc := range []bool{true, false} {
+		pt, err := decodePageToken(encodePageToken(tx, desc))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pt.ID != tx.ID || pt.CreatedAt != createdAt.UnixMicro() || pt.Desc != desc {
+			t.Fatalf("expected %s at %d desc %v, got %+v", tx.ID, createdAt.UnixMicro(), desc, pt)
+		}
+	}
+}
+
+func TestDecodePageTokenRejects(t *testing.T) {
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"not base64", "not a token!"},
+		{"not json", base64.RawURLEncoding.EncodeToString([]byte("created_at"))},
+		{"without an id", base64.RawURLEncoding.EncodeToString([]byte(`{"c":1,"d":true}`))},
+		{"padded base64", base64.URLEncoding.EncodeToString([]byte(`{"c":1,"i":"id"}`))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := decodePageToken(tt.token)
+			if !errors.Is(err, ErrInvalidPageToken) {
+				t.Fatalf("expected ErrInvalidPageToken, got %v", err)
+			}
+		})
+	}
+}
+
+func TestValidTransactionColumn(t *testing.T) {
+	tests := []struct {
+		column string
+		valid  bool
+	}{
+		{"created_at", true},
+		{"amount", true},
+		{"id", true},
+		{"", false},
+		{"amount DESC", false},
+		{"amount; DROP TABLE transactions", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.column, func(t *testing.T) {
+			if ValidTransactionColumn(tt.column) != tt.valid {
+				t.Fatalf("expected %q valid %v", tt.column, tt.valid)
+			}
+		})
+	}
+}
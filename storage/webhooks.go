@@ -0,0 +1,283 @@
+package storage
+
+import (
+	"fmt"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"google.golang.org/protobuf/encoding/protojson"
+	"time"
+	"xsyn-transactions/boiler"
+	transactionsv1 "xsyn-transactions/gen/transactions/v1"
+)
+
+var ErrWebhookDeliveryNotDead = fmt.Errorf("webhook delivery is not dead")
+
+// OutboxEventInsert writes the event of a completed transfer, it is meant to run in the db transaction that inserts the transfer
+func (s *Storage) OutboxEventInsert(exec boil.Executor, transfer *transactionsv1.CompletedTransfer) error {
+	payload, err := protojson.Marshal(transfer)
+	if err != nil {
+		return err
+	}
+
+	event := &boiler.OutboxEvent{
+		TransactionID: transfer.Id,
+		Payload:       types.JSON(payload),
+	}
+	return event.Insert(exec, boil.Infer())
+}
+
+// OutboxEventsFanOut creates a delivery to every webhook endpoint for the oldest undispatched events and returns how many events were dispatched
+func (s *Storage) OutboxEventsFanOut(limit int) (int64, error) {
+	result, err := s.Exec(`
+		WITH events AS (SELECT id
+		                FROM outbox_events
+		                WHERE dispatched_at IS NULL
+		                ORDER BY id
+		                LIMIT $1 FOR UPDATE SKIP LOCKED),
+		     deliveries AS (INSERT INTO webhook_deliveries (event_id, endpoint_id)
+		         SELECT events.id, webhook_endpoints.id
+		         FROM events
+		                  CROSS JOIN webhook_endpoints
+		         WHERE webhook_endpoints.deleted_at IS NULL
+		         ON CONFLICT DO NOTHING)
+		UPDATE outbox_events
+		SET dispatched_at = NOW()
+		WHERE id IN (SELECT id FROM events)`,
+		limit,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+// WebhookDeliveriesClaim leases the pending deliveries that are due, with their event and endpoint loaded.
+// Another dispatcher can claim them again once the lease is up, so it has to cover sending all of them.
+func (s *Storage) WebhookDeliveriesClaim(limit int, lease time.Duration) ([]*boiler.WebhookDelivery, error) {
+	rows, err := s.Query(`
+		UPDATE webhook_deliveries
+		SET next_attempt_at = NOW() + $3 * INTERVAL '1 second'
+		WHERE id IN (SELECT id
+		             FROM webhook_deliveries
+		             WHERE status = $1
+		               AND next_attempt_at <= NOW()
+		             ORDER BY next_attempt_at
+		             LIMIT $2 FOR UPDATE SKIP LOCKED)
+		RETURNING id`,
+		int(transactionsv1.WebhookDeliveryStatus_WebhookDeliveryStatusPending),
+		limit,
+		lease.Seconds(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := []interface{}{}
+	for rows.Next() {
+		var id string
+		err = rows.Scan(&id)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return []*boiler.WebhookDelivery{}, nil
+	}
+
+	return boiler.WebhookDeliveries(
+		qm.WhereIn(fmt.Sprintf("%s IN ?", boiler.WebhookDeliveryColumns.ID), ids...),
+		qm.Load(boiler.WebhookDeliveryRels.Event),
+		qm.Load(boiler.WebhookDeliveryRels.Endpoint, qm.WithDeleted()),
+		qm.OrderBy(boiler.WebhookDeliveryColumns.EventID),
+	).All(s)
+}
+
+// WebhookDeliveryDelivered marks a delivery as delivered
+func (s *Storage) WebhookDeliveryDelivered(delivery *boiler.WebhookDelivery) error {
+	delivery.Status = int(transactionsv1.WebhookDeliveryStatus_WebhookDeliveryStatusDelivered)
+	delivery.Attempts++
+	delivery.DeliveredAt = null.TimeFrom(time.Now())
+	delivery.LastError = null.String{}
+
+	_, err := delivery.Update(s, boil.Whitelist(
+		boiler.WebhookDeliveryColumns.Status,
+		boiler.WebhookDeliveryColumns.Attempts,
+		boiler.WebhookDeliveryColumns.DeliveredAt,
+		boiler.WebhookDeliveryColumns.LastError,
+	))
+	return err
+}
+
+// WebhookDeliveryFailed records a failed attempt, the delivery is dead when there is no next attempt
+func (s *Storage) WebhookDeliveryFailed(delivery *boiler.WebhookDelivery, deliveryErr error, nextAttemptAt null.Time) error {
+	delivery.Attempts++
+	delivery.LastError = null.StringFrom(deliveryErr.Error())
+	if nextAttemptAt.Valid {
+		delivery.NextAttemptAt = nextAttemptAt.Time
+	} else {
+		delivery.Status = int(transactionsv1.WebhookDeliveryStatus_WebhookDeliveryStatusDead)
+	}
+
+	_, err := delivery.Update(s, boil.Whitelist(
+		boiler.WebhookDeliveryColumns.Status,
+		boiler.WebhookDeliveryColumns.Attempts,
+		boiler.WebhookDeliveryColumns.NextAttemptAt,
+		boiler.WebhookDeliveryColumns.LastError,
+	))
+	return err
+}
+
+// WebhookDeliveryRetry puts a dead delivery back to pending with its attempts reset
+func (s *Storage) WebhookDeliveryRetry(deliveryID string) (*transactionsv1.WebhookDelivery, error) {
+	delivery, err := boiler.WebhookDeliveries(
+		boiler.WebhookDeliveryWhere.ID.EQ(deliveryID),
+		qm.Load(boiler.WebhookDeliveryRels.Event),
+	).One(s)
+	if err != nil {
+		return nil, err
+	}
+	if delivery.Status != int(transactionsv1.WebhookDeliveryStatus_WebhookDeliveryStatusDead) {
+		return nil, ErrWebhookDeliveryNotDead
+	}
+
+	delivery.Status = int(transactionsv1.WebhookDeliveryStatus_WebhookDeliveryStatusPending)
+	delivery.Attempts = 0
+	delivery.NextAttemptAt = time.Now()
+
+	_, err = delivery.Update(s, boil.Whitelist(
+		boiler.WebhookDeliveryColumns.Status,
+		boiler.WebhookDeliveryColumns.Attempts,
+		boiler.WebhookDeliveryColumns.NextAttemptAt,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	return WebhookDeliveryToProto(delivery), nil
+}
+
+// WebhookDeliveriesGet returns the latest deliveries, optionally of one endpoint or status
+func (s *Storage) WebhookDeliveriesGet(endpointID string, status transactionsv1.WebhookDeliveryStatus, limit int) ([]*transactionsv1.WebhookDelivery, error) {
+	results := []*transactionsv1.WebhookDelivery{}
+
+	queryMods := []qm.QueryMod{
+		qm.Load(boiler.WebhookDeliveryRels.Event),
+		qm.OrderBy(fmt.Sprintf("%s DESC", boiler.WebhookDeliveryColumns.CreatedAt)),
+		qm.Limit(limit),
+	}
+	if endpointID != "" {
+		queryMods = append(queryMods, boiler.WebhookDeliveryWhere.EndpointID.EQ(endpointID))
+	}
+	if status != transactionsv1.WebhookDeliveryStatus_WebhookDeliveryStatusUnknown {
+		queryMods = append(queryMods, boiler.WebhookDeliveryWhere.Status.EQ(int(status)))
+	}
+
+	deliveries, err := boiler.WebhookDeliveries(queryMods...).All(s)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, delivery := range deliveries {
+		results = append(results, WebhookDeliveryToProto(delivery))
+	}
+
+	return results, nil
+}
+
+// WebhookDeliveryToProto converts a delivery row, the event needs to be loaded
+func WebhookDeliveryToProto(delivery *boiler.WebhookDelivery) *transactionsv1.WebhookDelivery {
+	result := &transactionsv1.WebhookDelivery{
+		Id:            delivery.ID,
+		EventId:       delivery.EventID,
+		EndpointId:    delivery.EndpointID,
+		TransactionId: delivery.R.Event.TransactionID,
+		Status:        transactionsv1.WebhookDeliveryStatus(delivery.Status),
+		Attempts:      int32(delivery.Attempts),
+		NextAttemptAt: delivery.NextAttemptAt.Unix(),
+		LastError:     delivery.LastError.String,
+		CreatedAt:     delivery.CreatedAt.Unix(),
+	}
+	if delivery.DeliveredAt.Valid {
+		result.DeliveredAt = delivery.DeliveredAt.Time.Unix()
+	}
+	return result
+}
+
+// WebhookEndpointCreate registers an endpoint, it receives the events dispatched from now on
+func (s *Storage) WebhookEndpointCreate(url string, secret string) (*transactionsv1.WebhookEndpoint, error) {
+	endpoint := &boiler.WebhookEndpoint{
+		URL:    url,
+		Secret: secret,
+	}
+	err := endpoint.Insert(s, boil.Infer())
+	if err != nil {
+		return nil, err
+	}
+
+	return WebhookEndpointToProto(endpoint), nil
+}
+
+func (s *Storage) WebhookEndpointsGet() ([]*transactionsv1.WebhookEndpoint, error) {
+	results := []*transactionsv1.WebhookEndpoint{}
+
+	endpoints, err := boiler.WebhookEndpoints(
+		qm.OrderBy(boiler.WebhookEndpointColumns.CreatedAt),
+	).All(s)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, endpoint := range endpoints {
+		results = append(results, WebhookEndpointToProto(endpoint))
+	}
+
+	return results, nil
+}
+
+// WebhookEndpointDelete soft deletes an endpoint and kills its pending deliveries
+func (s *Storage) WebhookEndpointDelete(endpointID string) error {
+	tx, err := s.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	endpoint, err := boiler.FindWebhookEndpoint(tx, endpointID)
+	if err != nil {
+		return err
+	}
+
+	_, err = endpoint.Delete(tx, false)
+	if err != nil {
+		return err
+	}
+
+	_, err = boiler.WebhookDeliveries(
+		boiler.WebhookDeliveryWhere.EndpointID.EQ(endpointID),
+		boiler.WebhookDeliveryWhere.Status.EQ(int(transactionsv1.WebhookDeliveryStatus_WebhookDeliveryStatusPending)),
+	).UpdateAll(tx, boiler.M{
+		boiler.WebhookDeliveryColumns.Status:    int(transactionsv1.WebhookDeliveryStatus_WebhookDeliveryStatusDead),
+		boiler.WebhookDeliveryColumns.LastError: "endpoint deleted",
+	})
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func WebhookEndpointToProto(endpoint *boiler.WebhookEndpoint) *transactionsv1.WebhookEndpoint {
+	return &transactionsv1.WebhookEndpoint{
+		Id:        endpoint.ID,
+		Url:       endpoint.URL,
+		CreatedAt: endpoint.CreatedAt.Unix(),
+	}
+}
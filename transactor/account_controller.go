@@ -2,6 +2,7 @@ package transactor
 
 import (
 	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/proto"
 	"xsyn-transactions/gen/transactions/v1"
 )
 
@@ -11,7 +12,7 @@ func (t *Transactor) balanceUpdate(tx *transactionsv1.CompletedTransfer) {
 		t.log.Error().Err(err).Str("tx.Amount", tx.Amount).Msg("failed convert tx amount to decimal")
 	}
 
-	debitAccount, err := t.get(tx.DebitUserId, tx.Ledger)
+	debitAccount, err := t.getForUpdate(tx.DebitUserId, tx.Ledger)
 	if err != nil {
 		t.log.Error().Err(err).Interface("tx", tx).Msg("error updating balance")
 	} else {
@@ -28,13 +29,13 @@ func (t *Transactor) balanceUpdate(tx *transactionsv1.CompletedTransfer) {
 		}
 	}
 
-	creditAccount, err := t.get(tx.CreditUserId, tx.Ledger)
+	creditAccount, err := t.getForUpdate(tx.CreditUserId, tx.Ledger)
 	if err != nil {
 		t.log.Error().Err(err).Interface("tx", tx).Msg("error updating balance")
 	} else {
 		balance, err := decimal.NewFromString(creditAccount.Balance)
 		if err != nil {
-			t.log.Error().Err(err).Str("creditAccount.Balance", debitAccount.Balance).Msg("failed convert balance to decimal")
+			t.log.Error().Err(err).Str("creditAccount.Balance", creditAccount.Balance).Msg("failed convert balance to decimal")
 		}
 
 		creditAccount.Balance = balance.Add(amount).String()
@@ -46,6 +47,75 @@ func (t *Transactor) balanceUpdate(tx *transactionsv1.CompletedTransfer) {
 	}
 }
 
+// pendingBalanceUpdate applies a change of a pending transfer to the cached accounts, the hold is taken off the balance when reserved
+func (t *Transactor) pendingBalanceUpdate(pt *transactionsv1.PendingTransfer, tx *transactionsv1.CompletedTransfer) {
+	amount, err := decimal.NewFromString(pt.Amount)
+	if err != nil {
+		t.log.Error().Err(err).Str("pt.Amount", pt.Amount).Msg("failed convert pending transfer amount to decimal")
+	}
+
+	debitAccount, err := t.getForUpdate(pt.DebitUserId, pt.Ledger)
+	if err != nil {
+		t.log.Error().Err(err).Interface("pt", pt).Msg("error updating pending balance")
+	} else {
+		switch pt.Status {
+		case transactionsv1.PendingTransferStatus_PendingStatusPending:
+			debitAccount.DebitsPending = t.addAmount(debitAccount.DebitsPending, amount)
+			debitAccount.Balance = t.addAmount(debitAccount.Balance, amount.Neg())
+		case transactionsv1.PendingTransferStatus_PendingStatusPosted:
+			debitAccount.DebitsPending = t.addAmount(debitAccount.DebitsPending, amount.Neg())
+			debitAccount.DebitsPosted = t.addAmount(debitAccount.DebitsPosted, amount)
+		default:
+			debitAccount.DebitsPending = t.addAmount(debitAccount.DebitsPending, amount.Neg())
+			debitAccount.Balance = t.addAmount(debitAccount.Balance, amount)
+		}
+		t.put(debitAccount)
+		t.broadcaster <- &transactionsv1.TransferCompleteSubscribeResponse{
+			Account:         debitAccount,
+			Transaction:     tx,
+			PendingTransfer: pt,
+		}
+	}
+
+	creditAccount, err := t.getForUpdate(pt.CreditUserId, pt.Ledger)
+	if err != nil {
+		t.log.Error().Err(err).Interface("pt", pt).Msg("error updating pending balance")
+	} else {
+		switch pt.Status {
+		case transactionsv1.PendingTransferStatus_PendingStatusPending:
+			creditAccount.CreditsPending = t.addAmount(creditAccount.CreditsPending, amount)
+		case transactionsv1.PendingTransferStatus_PendingStatusPosted:
+			creditAccount.CreditsPending = t.addAmount(creditAccount.CreditsPending, amount.Neg())
+			creditAccount.CreditsPosted = t.addAmount(creditAccount.CreditsPosted, amount)
+			creditAccount.Balance = t.addAmount(creditAccount.Balance, amount)
+		default:
+			creditAccount.CreditsPending = t.addAmount(creditAccount.CreditsPending, amount.Neg())
+		}
+		t.put(creditAccount)
+		t.broadcaster <- &transactionsv1.TransferCompleteSubscribeResponse{
+			Account:         creditAccount,
+			Transaction:     tx,
+			PendingTransfer: pt,
+		}
+	}
+}
+
+// addAmount adds the amount to a decimal string
+func (t *Transactor) addAmount(value string, amount decimal.Decimal) string {
+	if value == "" {
+		return amount.String()
+	}
+
+	d, err := decimal.NewFromString(value)
+	if err != nil {
+		t.log.Error().Err(err).Str("value", value).Msg("failed convert value to decimal")
+	}
+
+	return d.Add(amount).String()
+}
+
+// getAndSet loads the user's accounts into the cache, userMapLock must be held for writing.
+// Accounts already cached are kept, another shard may be applying a transfer to them.
 func (t *Transactor) getAndSet(userID string, ledger transactionsv1.Ledger) (*transactionsv1.Account, error) {
 	accounts, err := t.Storage.GetAllUserAccounts(userID)
 	if err != nil {
@@ -57,7 +127,9 @@ func (t *Transactor) getAndSet(userID string, ledger transactionsv1.Ledger) (*tr
 	}
 
 	for _, account := range accounts {
-		t.userMap[account.UserId][account.Ledger] = account
+		if _, ok := t.userMap[account.UserId][account.Ledger]; !ok {
+			t.userMap[account.UserId][account.Ledger] = account
+		}
 	}
 
 	if account, ok := t.userMap[userID][ledger]; ok {
@@ -69,18 +141,30 @@ func (t *Transactor) getAndSet(userID string, ledger transactionsv1.Ledger) (*tr
 
 func (t *Transactor) get(userID string, ledger transactionsv1.Ledger) (*transactionsv1.Account, error) {
 	t.userMapLock.RLock()
-	defer t.userMapLock.RUnlock()
-
 	userLedgerMap, ok := t.userMap[userID]
 	if ok {
 		if account, accountOk := userLedgerMap[ledger]; accountOk {
+			t.userMapLock.RUnlock()
 			return account, nil
 		}
 	}
+	t.userMapLock.RUnlock()
 
+	t.userMapLock.Lock()
+	defer t.userMapLock.Unlock()
 	return t.getAndSet(userID, ledger)
 }
 
+// getForUpdate returns a copy of the cached account to change and put back.
+// Cached accounts are shared with readers and subscribers, so they are never changed in place.
+func (t *Transactor) getForUpdate(userID string, ledger transactionsv1.Ledger) (*transactionsv1.Account, error) {
+	account, err := t.get(userID, ledger)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Clone(account).(*transactionsv1.Account), nil
+}
+
 func (t *Transactor) put(account *transactionsv1.Account) {
 	t.userMapLock.Lock()
 	t.userMap[account.UserId][account.Ledger] = account
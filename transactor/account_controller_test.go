@@ -0,0 +1,121 @@
+package transactor
+
+import (
+	"github.com/rs/zerolog"
+	"sync"
+	"testing"
+	"xsyn-transactions/gen/transactions/v1"
+)
+
+// newCacheTestTransactor caches the accounts, broadcasting to a channel big enough that updates never block
+func newCacheTestTransactor(accounts ...*transactionsv1.Account) *Transactor {
+	log := zerolog.Nop()
+	t := &Transactor{
+		log:         &log,
+		broadcaster: make(chan *transactionsv1.TransferCompleteSubscribeResponse, 1000),
+		userMap:     map[string]map[transactionsv1.Ledger]*transactionsv1.Account{},
+	}
+	for _, account := range accounts {
+		if _, ok := t.userMap[account.UserId]; !ok {
+			t.userMap[account.UserId] = map[transactionsv1.Ledger]*transactionsv1.Account{}
+		}
+		t.userMap[account.UserId][account.Ledger] = account
+	}
+	return t
+}
+
+func testAccount(userID string, balance string) *transactionsv1.Account {
+	return &transactionsv1.Account{Id: userID + "-account", UserId: userID, Ledger: transactionsv1.Ledger_SUPS, Balance: balance}
+}
+
+func testTransfer(debitUserID string, creditUserID string, amount string) *transactionsv1.CompletedTransfer {
+	return &transactionsv1.CompletedTransfer{DebitUserId: debitUserID, CreditUserId: creditUserID, Ledger: transactionsv1.Ledger_SUPS, Amount: amount}
+}
+
+func TestBalanceUpdateSendsEachTransfersBalance(t *testing.T) {
+	txr := newCacheTestTransactor(testAccount("alice", "100"), testAccount("bob", "0"))
+	cached, _ := txr.get("alice", transactionsv1.Ledger_SUPS)
+
+	txr.balanceUpdate(testTransfer("alice", "bob", "10"))
+	txr.balanceUpdate(testTransfer("alice", "bob", "20"))
+
+	tests := []struct {
+		userID  string
+		balance string
+	}{
+		{"alice", "90"},
+		{"bob", "10"},
+		{"alice", "70"},
+		{"bob", "30"},
+	}
+	for i, tt := range tests {
+		res := <-txr.broadcaster
+		if res.Account.UserId != tt.userID || res.Account.Balance != tt.balance {
+			t.Fatalf("update %d: expected %s with %s, got %s with %s", i, tt.userID, tt.balance, res.Account.UserId, res.Account.Balance)
+		}
+	}
+
+	if cached.Balance != "100" {
+		t.Fatalf("the account read before the transfers changed to %s", cached.Balance)
+	}
+	if account, _ := txr.get("alice", transactionsv1.Ledger_SUPS); account.Balance != "70" {
+		t.Fatalf("expected the cached balance to be 70, got %s", account.Balance)
+	}
+}
+
+func TestPendingBalanceUpdateSendsEachChangesBalance(t *testing.T) {
+	txr := newCacheTestTransactor(testAccount("alice", "100"), testAccount("bob", "0"))
+	pt := &transactionsv1.PendingTransfer{DebitUserId: "alice", CreditUserId: "bob", Ledger: transactionsv1.Ledger_SUPS, Amount: "40"}
+
+	pt.Status = transactionsv1.PendingTransferStatus_PendingStatusPending
+	txr.pendingBalanceUpdate(pt, nil)
+	reserved := <-txr.broadcaster
+	<-txr.broadcaster
+
+	posted := &transactionsv1.PendingTransfer{DebitUserId: "alice", CreditUserId: "bob", Ledger: transactionsv1.Ledger_SUPS, Amount: "40", Status: transactionsv1.PendingTransferStatus_PendingStatusPosted}
+	txr.pendingBalanceUpdate(posted, testTransfer("alice", "bob", "40"))
+	afterPost := <-txr.broadcaster
+
+	if reserved.Account.Balance != "60" || reserved.Account.DebitsPending != "40" {
+		t.Fatalf("the reserve update changed to balance %s pending %s", reserved.Account.Balance, reserved.Account.DebitsPending)
+	}
+	if afterPost.Account.Balance != "60" || afterPost.Account.DebitsPending != "0" || afterPost.Account.DebitsPosted != "40" {
+		t.Fatalf("unexpected post update balance %s pending %s posted %s", afterPost.Account.Balance, afterPost.Account.DebitsPending, afterPost.Account.DebitsPosted)
+	}
+}
+
+// TestBalanceUpdateWhileReading reads the cache and the sent updates while transfers apply, run with -race
+func TestBalanceUpdateWhileReading(t *testing.T) {
+	txr := newCacheTestTransactor(testAccount("alice", "1000"), testAccount("bob", "0"))
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			txr.balanceUpdate(testTransfer("alice", "bob", "1"))
+		}
+		close(txr.broadcaster)
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			account, _ := txr.get("alice", transactionsv1.Ledger_SUPS)
+			_ = account.Balance
+		}
+	}()
+
+	previous := map[string]*transactionsv1.Account{}
+	for res := range txr.broadcaster {
+		if prev, ok := previous[res.Account.UserId]; ok && prev == res.Account {
+			t.Fatal("sent the same account for two transfers")
+		}
+		previous[res.Account.UserId] = res.Account
+		_ = res.Account.Balance
+	}
+	wg.Wait()
+
+	if account, _ := txr.get("bob", transactionsv1.Ledger_SUPS); account.Balance != "100" {
+		t.Fatalf("expected bob to have 100, got %s", account.Balance)
+	}
+}
@@ -0,0 +1,148 @@
+package transactor
+
+import (
+	"context"
+	"fmt"
+	"github.com/bufbuild/connect-go"
+	"xsyn-transactions/gen/transactions/v1"
+	"xsyn-transactions/storage"
+)
+
+var ErrDebitAccountFrozen = storage.ErrDebitAccountFrozen
+var ErrCreditAccountFrozen = storage.ErrCreditAccountFrozen
+
+// AccountFreeze stops an account from being debited, and optionally credited, until it is unfrozen
+func (t *Transactor) AccountFreeze(ctx context.Context, req *connect.Request[transactionsv1.AccountFreezeRequest]) (*connect.Response[transactionsv1.AccountFreezeResponse], error) {
+	if !t.isLeader() {
+		leader, err := t.leaderFor(req.Header())
+		if err != nil {
+			return nil, err
+		}
+		return leader.accounts.AccountFreeze(ctx, forwardRequest(req))
+	}
+
+	account, accountFreeze, err := t.accountFreezeSet(ctx, req.Msg.UserId, req.Msg.Ledger, true, req.Msg.FreezeCredits, req.Msg.Reason, req.Msg.Actor)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse[transactionsv1.AccountFreezeResponse](&transactionsv1.AccountFreezeResponse{Account: account, Freeze: accountFreeze}), nil
+}
+
+// AccountUnfreeze lets a frozen account be debited and credited again
+func (t *Transactor) AccountUnfreeze(ctx context.Context, req *connect.Request[transactionsv1.AccountUnfreezeRequest]) (*connect.Response[transactionsv1.AccountUnfreezeResponse], error) {
+	if !t.isLeader() {
+		leader, err := t.leaderFor(req.Header())
+		if err != nil {
+			return nil, err
+		}
+		return leader.accounts.AccountUnfreeze(ctx, forwardRequest(req))
+	}
+
+	account, accountFreeze, err := t.accountFreezeSet(ctx, req.Msg.UserId, req.Msg.Ledger, false, false, req.Msg.Reason, req.Msg.Actor)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse[transactionsv1.AccountUnfreezeResponse](&transactionsv1.AccountUnfreezeResponse{Account: account, Freeze: accountFreeze}), nil
+}
+
+// AccountFreezeHistory returns every freeze and unfreeze of an account, newest first
+func (t *Transactor) AccountFreezeHistory(ctx context.Context, req *connect.Request[transactionsv1.AccountFreezeHistoryRequest]) (*connect.Response[transactionsv1.AccountFreezeHistoryResponse], error) {
+	if req.Msg.UserId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("user id is empty"))
+	}
+
+	account, err := t.get(req.Msg.UserId, req.Msg.Ledger)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	accountFreezes, err := t.Storage.AccountFreezesGet(account.Id)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse[transactionsv1.AccountFreezeHistoryResponse](&transactionsv1.AccountFreezeHistoryResponse{Freezes: accountFreezes}), nil
+}
+
+func (t *Transactor) accountFreezeSet(ctx context.Context, userID string, ledger transactionsv1.Ledger, frozen bool, frozenCredits bool, reason string, actor string) (*transactionsv1.Account, *transactionsv1.AccountFreeze, error) {
+	if userID == "" {
+		return nil, nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("user id is empty"))
+	}
+	if reason == "" {
+		return nil, nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("reason is empty"))
+	}
+	if actor == "" {
+		return nil, nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("actor is empty"))
+	}
+
+	account, err := t.get(userID, ledger)
+	if err != nil {
+		return nil, nil, connectError(err)
+	}
+
+	var updatedAccount *transactionsv1.Account = nil
+	var accountFreeze *transactionsv1.AccountFreeze = nil
+	// write on the account's shard so it doesn't freeze part way through a transaction
+	err = t.write(ctx, []string{account.Id}, func() error {
+		dbTx, err := t.Storage.Begin()
+		if err != nil {
+			return err
+		}
+		defer dbTx.Rollback()
+
+		acc, af, err := t.Storage.AccountFreezeSet(dbTx, account.Id, frozen, frozenCredits, reason, actor)
+		if err != nil {
+			t.log.Error().Err(err).Str("accountID", account.Id).Bool("frozen", frozen).Msg("failed to set account freeze")
+			return err
+		}
+
+		err = t.notifyChange(dbTx, &change{Kind: changeAccount, UserID: acc.UserId})
+		if err != nil {
+			t.log.Error().Err(err).Str("accountID", account.Id).Msg("failed to notify replicas")
+			return err
+		}
+
+		// a replica that lost leadership must not unfreeze an account the new leader has frozen
+		err = storage.LeaderFence(dbTx, t.leaderEpoch.Load())
+		if err != nil {
+			t.log.Warn().Err(err).Msg("write fenced off, this replica lost leadership")
+			return err
+		}
+
+		err = dbTx.Commit()
+		if err != nil {
+			return err
+		}
+
+		t.put(acc)
+		updatedAccount = acc
+		accountFreeze = af
+		return nil
+	})
+	if err != nil {
+		return nil, nil, connectError(err)
+	}
+
+	t.log.Info().
+		Str("accountID", updatedAccount.Id).
+		Bool("frozen", updatedAccount.Frozen).
+		Bool("frozenCredits", updatedAccount.FrozenCredits).
+		Str("reason", reason).
+		Str("actor", actor).
+		Msg("account freeze set")
+
+	return updatedAccount, accountFreeze, nil
+}
+
+// checkFrozen returns a FailedPrecondition error if either account is frozen for the transfer
+func checkFrozen(debitAccount *transactionsv1.Account, creditAccount *transactionsv1.Account) error {
+	if debitAccount.Frozen {
+		return connectError(ErrDebitAccountFrozen)
+	}
+	if creditAccount.Frozen && creditAccount.FrozenCredits {
+		return connectError(ErrCreditAccountFrozen)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package transactor
+
+import (
+	"errors"
+	"github.com/bufbuild/connect-go"
+	"github.com/jackc/pgconn"
+	"testing"
+	"xsyn-transactions/gen/transactions/v1"
+)
+
+func TestCheckFrozen(t *testing.T) {
+	tests := []struct {
+		name   string
+		debit  *transactionsv1.Account
+		credit *transactionsv1.Account
+		err    error
+	}{
+		{"neither frozen", &transactionsv1.Account{}, &transactionsv1.Account{}, nil},
+		{"debit frozen", &transactionsv1.Account{Frozen: true}, &transactionsv1.Account{}, ErrDebitAccountFrozen},
+		{"debit frozen with credits", &transactionsv1.Account{Frozen: true, FrozenCredits: true}, &transactionsv1.Account{}, ErrDebitAccountFrozen},
+		{"credit frozen can still be credited", &transactionsv1.Account{}, &transactionsv1.Account{Frozen: true}, nil},
+		{"credit frozen with credits", &transactionsv1.Account{}, &transactionsv1.Account{Frozen: true, FrozenCredits: true}, ErrCreditAccountFrozen},
+		{"credits frozen without a freeze", &transactionsv1.Account{}, &transactionsv1.Account{FrozenCredits: true}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkFrozen(tt.debit, tt.credit)
+			if tt.err == nil {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.err) || connect.CodeOf(err) != connect.CodeFailedPrecondition {
+				t.Fatalf("expected %v as failed precondition, got %v", tt.err, err)
+			}
+		})
+	}
+}
+
+// TestCheckBalancesErrors checks the errors check_balances raises for account flags and freezes reach the client with their reason
+func TestCheckBalancesErrors(t *testing.T) {
+	tests := []struct {
+		sqlState string
+		reason   transactionsv1.ErrorReason
+	}{
+		{"XT003", transactionsv1.ErrorReason_ErrorReasonInsufficientFunds},
+		{"XT004", transactionsv1.ErrorReason_ErrorReasonDebitAccountFrozen},
+		{"XT005", transactionsv1.ErrorReason_ErrorReasonCreditAccountFrozen},
+		{"XT006", transactionsv1.ErrorReason_ErrorReasonDebitsDisabled},
+		{"XT007", transactionsv1.ErrorReason_ErrorReasonCreditsDisabled},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sqlState, func(t *testing.T) {
+			err := connectError(&pgconn.PgError{Code: tt.sqlState, Message: "raised by check_balances"})
+
+			connectErr := &connect.Error{}
+			if !errors.As(err, &connectErr) || connectErr.Code() != connect.CodeFailedPrecondition {
+				t.Fatalf("expected a failed precondition, got %v", err)
+			}
+			reason := transactionsv1.ErrorReason_ErrorReasonUnknown
+			for _, d := range connectErr.Details() {
+				msg, _ := d.Value()
+				if detail, ok := msg.(*transactionsv1.ErrorDetail); ok {
+					reason = detail.Reason
+				}
+			}
+			if reason != tt.reason {
+				t.Fatalf("expected reason %s, got %s", tt.reason, reason)
+			}
+		})
+	}
+}
@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"github.com/bufbuild/connect-go"
 	"github.com/friendsofgo/errors"
+	"github.com/shopspring/decimal"
+	"time"
 	"xsyn-transactions/gen/transactions/v1"
+	"xsyn-transactions/storage"
 )
 
 func (t *Transactor) GetBalance(ctx context.Context, req *connect.Request[transactionsv1.GetBalanceRequest]) (*connect.Response[transactionsv1.GetBalanceResponse], error) {
@@ -14,14 +17,14 @@ func (t *Transactor) GetBalance(ctx context.Context, req *connect.Request[transa
 		if errors.Is(err, ErrUnableToFindAccount) && req.Msg.CreateIfNotExists {
 			err = t.Storage.CreateAccount(req.Msg.UserId, transactionsv1.AccountCode_AccountUser, req.Msg.Ledger)
 			if err != nil {
-				return nil, connect.NewError(connect.CodeInternal, err)
+				return nil, connectError(err)
 			}
 			account, err = t.get(req.Msg.UserId, req.Msg.Ledger)
 			if err != nil {
-				return nil, connect.NewError(connect.CodeInternal, err)
+				return nil, connectError(err)
 			}
 		} else {
-			return nil, connect.NewError(connect.CodeInternal, err)
+			return nil, connectError(err)
 		}
 	}
 
@@ -37,7 +40,7 @@ func (t *Transactor) AccountGetViaUser(ctx context.Context, req *connect.Request
 
 	account, err := t.get(req.Msg.UserId, req.Msg.Ledger)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, connectError(err)
 	}
 
 	return connect.NewResponse[transactionsv1.AccountGetViaUserResponse](&transactionsv1.AccountGetViaUserResponse{Account: account}), nil
@@ -63,11 +66,11 @@ func (t *Transactor) AccountsUser(ctx context.Context, req *connect.Request[tran
 				if create {
 					err = t.Storage.CreateAccount(req.Msg.UserId, transactionsv1.AccountCode_AccountUser, transactionsv1.Ledger(l))
 					if err != nil {
-						return nil, connect.NewError(connect.CodeInternal, err)
+						return nil, connectError(err)
 					}
 					account, err := t.get(req.Msg.UserId, transactionsv1.Ledger(l))
 					if err != nil {
-						return nil, connect.NewError(connect.CodeInternal, err)
+						return nil, connectError(err)
 					}
 					accounts = append(accounts, account)
 					continue
@@ -75,10 +78,160 @@ func (t *Transactor) AccountsUser(ctx context.Context, req *connect.Request[tran
 				// not having an account with every ledger isn't an error, so we just continue
 				continue
 			}
-			return nil, connect.NewError(connect.CodeInternal, err)
+			return nil, connectError(err)
 		}
 		accounts = append(accounts, account)
 	}
 
 	return connect.NewResponse[transactionsv1.AccountsUserResponse](&transactionsv1.AccountsUserResponse{Accounts: accounts}), nil
 }
+
+// AccountFlagsSet sets the flags that control how an account can be transferred from and to, such as treasury accounts going negative
+func (t *Transactor) AccountFlagsSet(ctx context.Context, req *connect.Request[transactionsv1.AccountFlagsSetRequest]) (*connect.Response[transactionsv1.AccountFlagsSetResponse], error) {
+	if !t.isLeader() {
+		leader, err := t.leaderFor(req.Header())
+		if err != nil {
+			return nil, err
+		}
+		return leader.accounts.AccountFlagsSet(ctx, forwardRequest(req))
+	}
+
+	if req.Msg.UserId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("user id is empty"))
+	}
+
+	overdraftLimit := decimal.Zero
+	if req.Msg.OverdraftLimit != "" {
+		var err error
+		overdraftLimit, err = decimal.NewFromString(req.Msg.OverdraftLimit)
+		if err != nil {
+			return nil, invalidAmount(err)
+		}
+		if overdraftLimit.IsNegative() {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("overdraft limit is negative"))
+		}
+	}
+
+	account, err := t.get(req.Msg.UserId, req.Msg.Ledger)
+	if err != nil {
+		if errors.Is(err, ErrUnableToFindAccount) && req.Msg.CreateIfNotExists {
+			code := req.Msg.Code
+			if code == transactionsv1.AccountCode_AccountUnknown {
+				code = transactionsv1.AccountCode_AccountUser
+			}
+			err = t.Storage.CreateAccount(req.Msg.UserId, code, req.Msg.Ledger)
+			if err != nil {
+				return nil, connectError(err)
+			}
+			account, err = t.get(req.Msg.UserId, req.Msg.Ledger)
+			if err != nil {
+				return nil, connectError(err)
+			}
+		} else {
+			return nil, connectError(err)
+		}
+	}
+
+	// write on the account's shard so the flags don't change part way through a transaction
+	var updatedAccount *transactionsv1.Account = nil
+	err = t.write(ctx, []string{account.Id}, func() error {
+		dbTx, err := t.Storage.Begin()
+		if err != nil {
+			return err
+		}
+		defer dbTx.Rollback()
+
+		acc, err := t.Storage.AccountFlagsSet(dbTx, account.Id, &storage.AccountFlags{
+			AllowNegativeBalance: req.Msg.AllowNegativeBalance,
+			OverdraftLimit:       overdraftLimit,
+			DebitsDisabled:       req.Msg.DebitsDisabled,
+			CreditsDisabled:      req.Msg.CreditsDisabled,
+		})
+		if err != nil {
+			t.log.Error().Err(err).Str("accountID", account.Id).Msg("failed to set account flags")
+			return err
+		}
+
+		err = t.notifyChange(dbTx, &change{Kind: changeAccount, UserID: acc.UserId})
+		if err != nil {
+			t.log.Error().Err(err).Str("accountID", account.Id).Msg("failed to notify replicas")
+			return err
+		}
+
+		// a replica that lost leadership must not change flags the new leader checks transfers against
+		err = storage.LeaderFence(dbTx, t.leaderEpoch.Load())
+		if err != nil {
+			t.log.Warn().Err(err).Msg("write fenced off, this replica lost leadership")
+			return err
+		}
+
+		err = dbTx.Commit()
+		if err != nil {
+			return err
+		}
+
+		t.put(acc)
+		updatedAccount = acc
+		return nil
+	})
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	t.log.Info().
+		Str("accountID", updatedAccount.Id).
+		Bool("allowNegativeBalance", updatedAccount.AllowNegativeBalance).
+		Str("overdraftLimit", updatedAccount.OverdraftLimit).
+		Bool("debitsDisabled", updatedAccount.DebitsDisabled).
+		Bool("creditsDisabled", updatedAccount.CreditsDisabled).
+		Msg("account flags set")
+
+	return connect.NewResponse[transactionsv1.AccountFlagsSetResponse](&transactionsv1.AccountFlagsSetResponse{Account: updatedAccount}), nil
+}
+
+// GetBalanceAt returns the posted balance of a user's account at a point in time
+func (t *Transactor) GetBalanceAt(ctx context.Context, req *connect.Request[transactionsv1.GetBalanceAtRequest]) (*connect.Response[transactionsv1.GetBalanceAtResponse], error) {
+	if req.Msg.UserId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("user id is empty"))
+	}
+
+	account, err := t.get(req.Msg.UserId, req.Msg.Ledger)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	balance, err := t.Storage.BalanceAt(account.Id, time.Unix(req.Msg.Timestamp, 0))
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse[transactionsv1.GetBalanceAtResponse](&transactionsv1.GetBalanceAtResponse{
+		Balance: balance.String(),
+	}), nil
+}
+
+// snapshotBalances periodically snapshots account totals so GetBalanceAt only has to sum recent transactions
+func (t *Transactor) snapshotBalances() {
+	ticker := time.NewTicker(t.balanceSnapshotInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-t.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if !t.isLeader() {
+			continue
+		}
+		// leave time for transactions that are still committing with an earlier created_at
+		takenAt := time.Now().Add(-balanceSnapshotSettleTime)
+		count, err := t.Storage.BalanceSnapshotsCreate(takenAt)
+		if err != nil {
+			t.log.Error().Err(err).Msg("failed to create balance snapshots")
+			continue
+		}
+		t.log.Info().Int64("accounts", count).Time("takenAt", takenAt).Msg("created balance snapshots")
+	}
+}
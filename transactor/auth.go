@@ -0,0 +1,95 @@
+package transactor
+
+import (
+	"context"
+	"github.com/bufbuild/connect-go"
+	"xsyn-transactions/gen/transactions/v1/transactionsv1connect"
+)
+
+// AuthHeader carries the client key
+const AuthHeader = "xsyn-transaction-auth-key"
+
+// procedureScopes is the scope each procedure needs, a procedure that isn't listed needs admin
+var procedureScopes = map[string]Scope{
+	"/" + transactionsv1connect.AccountsName + "/AccountGetViaUser":          ScopeRead,
+	"/" + transactionsv1connect.AccountsName + "/AccountsUser":               ScopeRead,
+	"/" + transactionsv1connect.AccountsName + "/GetBalance":                 ScopeRead,
+	"/" + transactionsv1connect.AccountsName + "/GetBalanceAt":               ScopeRead,
+	"/" + transactionsv1connect.AccountsName + "/TransactionGetByID":         ScopeRead,
+	"/" + transactionsv1connect.AccountsName + "/TransactionsGetByAccountID": ScopeRead,
+	"/" + transactionsv1connect.AccountsName + "/StatementGet":               ScopeRead,
+	"/" + transactionsv1connect.AccountsName + "/AccountFreezeHistory":       ScopeRead,
+	"/" + transactionsv1connect.AccountsName + "/AccountFlagsSet":            ScopeAdmin,
+	"/" + transactionsv1connect.AccountsName + "/AccountFreeze":              ScopeAdmin,
+	"/" + transactionsv1connect.AccountsName + "/AccountUnfreeze":            ScopeAdmin,
+
+	"/" + transactionsv1connect.TransactorName + "/TransactWithID":            ScopeTransact,
+	"/" + transactionsv1connect.TransactorName + "/Transact":                  ScopeTransact,
+	"/" + transactionsv1connect.TransactorName + "/TransactBatch":             ScopeTransact,
+	"/" + transactionsv1connect.TransactorName + "/TransferReserve":           ScopeTransact,
+	"/" + transactionsv1connect.TransactorName + "/TransferPost":              ScopeTransact,
+	"/" + transactionsv1connect.TransactorName + "/TransferVoid":              ScopeTransact,
+	"/" + transactionsv1connect.TransactorName + "/Refund":                    ScopeTransact,
+	"/" + transactionsv1connect.TransactorName + "/TransferCompleteSubscribe": ScopeSubscribe,
+}
+
+func procedureScope(procedure string) Scope {
+	scope, ok := procedureScopes[procedure]
+	if !ok {
+		return ScopeAdmin
+	}
+	return scope
+}
+
+// authorize authenticates the client key and checks the client has the scope of the procedure
+func (t *Transactor) authorize(procedure string, key string) (*Client, error) {
+	client, err := t.authenticate(key)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	if !client.HasScope(procedureScope(procedure)) {
+		return nil, connectError(ErrPermissionDenied)
+	}
+	return client, nil
+}
+
+// authInterceptor authenticates requests with the client key in AuthHeader and puts the client in the context,
+// it covers streaming handlers as well as unary ones
+type authInterceptor struct {
+	t *Transactor
+}
+
+// NewAuthInterceptor returns the interceptor that authenticates and authorizes every request, unary or streaming
+func (t *Transactor) NewAuthInterceptor() connect.Interceptor {
+	return &authInterceptor{t: t}
+}
+
+func (i *authInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return next(ctx, req)
+		}
+
+		client, err := i.t.authorize(req.Spec().Procedure, req.Header().Get(AuthHeader))
+		if err != nil {
+			return nil, err
+		}
+		return next(withClient(ctx, client), req)
+	}
+}
+
+// WrapStreamingClient leaves client streams alone, the key is set on the request header by the caller
+func (i *authInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler rejects a stream before the handler runs, so nothing is sent to an unauthenticated client
+func (i *authInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		client, err := i.t.authorize(conn.Spec().Procedure, conn.RequestHeader().Get(AuthHeader))
+		if err != nil {
+			return err
+		}
+		return next(withClient(ctx, client), conn)
+	}
+}
@@ -0,0 +1,235 @@
+package transactor
+
+import (
+	"context"
+	"errors"
+	"github.com/bufbuild/connect-go"
+	"github.com/rs/zerolog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"xsyn-transactions/gen/transactions/v1"
+	"xsyn-transactions/gen/transactions/v1/transactionsv1connect"
+)
+
+// authTestHandler streams one update to a subscriber and records the client the stream was opened by
+type authTestHandler struct {
+	transactionsv1connect.UnimplementedTransactorHandler
+	clients chan *Client
+}
+
+func (h *authTestHandler) TransferCompleteSubscribe(ctx context.Context, req *connect.Request[transactionsv1.TransferCompleteSubscribeRequest], resp *connect.ServerStream[transactionsv1.TransferCompleteSubscribeResponse]) error {
+	client, _ := ClientFromContext(ctx)
+	h.clients <- client
+	return resp.Send(&transactionsv1.TransferCompleteSubscribeResponse{Account: &transactionsv1.Account{UserId: "user"}})
+}
+
+func (h *authTestHandler) Transact(ctx context.Context, req *connect.Request[transactionsv1.TransactRequest]) (*connect.Response[transactionsv1.TransactResponse], error) {
+	client, _ := ClientFromContext(ctx)
+	h.clients <- client
+	return connect.NewResponse(&transactionsv1.TransactResponse{}), nil
+}
+
+// newAuthTestServer serves the Transactor service behind the auth interceptor with the clients' keys
+func newAuthTestServer(t *testing.T, keys map[string]clientKey) (transactionsv1connect.TransactorClient, *authTestHandler) {
+	log := zerolog.Nop()
+	txr := &Transactor{log: &log}
+	hashed := map[string]clientKey{}
+	for key, ck := range keys {
+		hashed[HashClientKey(key)] = ck
+	}
+	txr.clientKeys.Store(&hashed)
+
+	handler := &authTestHandler{clients: make(chan *Client, 1)}
+	mux := http.NewServeMux()
+	mux.Handle(transactionsv1connect.NewTransactorHandler(handler, connect.WithInterceptors(txr.NewAuthInterceptor())))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return transactionsv1connect.NewTransactorClient(server.Client(), server.URL), handler
+}
+
+func testClient(name string, scopes ...Scope) *Client {
+	client := &Client{ID: name, Name: name, scopes: map[Scope]bool{}}
+	for _, scope := range scopes {
+		client.scopes[scope] = true
+	}
+	return client
+}
+
+func subscribe(client transactionsv1connect.TransactorClient, key string) (*connect.ServerStreamForClient[transactionsv1.TransferCompleteSubscribeResponse], error) {
+	req := connect.NewRequest(&transactionsv1.TransferCompleteSubscribeRequest{Id: "subscriber"})
+	if key != "" {
+		req.Header().Set(AuthHeader, key)
+	}
+	return client.TransferCompleteSubscribe(context.Background(), req)
+}
+
+// assertStreamRejected checks the stream ends with the code before anything is received and the handler never ran
+func assertStreamRejected(t *testing.T, stream *connect.ServerStreamForClient[transactionsv1.TransferCompleteSubscribeResponse], err error, handler *authTestHandler, code connect.Code) {
+	t.Helper()
+	if err == nil {
+		defer stream.Close()
+		if stream.Receive() {
+			t.Fatalf("received %v on a rejected stream", stream.Msg())
+		}
+		err = stream.Err()
+	}
+	if connect.CodeOf(err) != code {
+		t.Fatalf("expected %s, got %v", code, err)
+	}
+	select {
+	case <-handler.clients:
+		t.Fatal("handler ran for a rejected stream")
+	default:
+	}
+}
+
+func TestAuthInterceptorRejectsStreamWithoutKey(t *testing.T) {
+	client, handler := newAuthTestServer(t, map[string]clientKey{
+		"key": {client: testClient("subscriber", ScopeSubscribe)},
+	})
+
+	stream, err := subscribe(client, "")
+	assertStreamRejected(t, stream, err, handler, connect.CodeUnauthenticated)
+}
+
+func TestAuthInterceptorRejectsStreamWithUnknownKey(t *testing.T) {
+	client, handler := newAuthTestServer(t, map[string]clientKey{
+		"key": {client: testClient("subscriber", ScopeSubscribe)},
+	})
+
+	stream, err := subscribe(client, "not the key")
+	assertStreamRejected(t, stream, err, handler, connect.CodeUnauthenticated)
+}
+
+func TestAuthInterceptorRejectsStreamWithExpiredKey(t *testing.T) {
+	client, handler := newAuthTestServer(t, map[string]clientKey{
+		"old key": {client: testClient("subscriber", ScopeSubscribe), expiresAt: time.Now().Add(-time.Minute)},
+	})
+
+	stream, err := subscribe(client, "old key")
+	assertStreamRejected(t, stream, err, handler, connect.CodeUnauthenticated)
+}
+
+func TestAuthInterceptorRejectsStreamWithoutSubscribeScope(t *testing.T) {
+	client, handler := newAuthTestServer(t, map[string]clientKey{
+		"key": {client: testClient("reader", ScopeRead, ScopeTransact)},
+	})
+
+	stream, err := subscribe(client, "key")
+	assertStreamRejected(t, stream, err, handler, connect.CodePermissionDenied)
+}
+
+func TestAuthInterceptorAcceptsStreamWithKey(t *testing.T) {
+	for _, scope := range []Scope{ScopeSubscribe, ScopeAdmin} {
+		t.Run(string(scope), func(t *testing.T) {
+			client, handler := newAuthTestServer(t, map[string]clientKey{
+				"key":         {client: testClient("subscriber", scope)},
+				"rotated key": {client: testClient("subscriber", scope), expiresAt: time.Now().Add(time.Hour)},
+				"another key": {client: testClient("another", ScopeRead)},
+			})
+
+			for _, key := range []string{"key", "rotated key"} {
+				stream, err := subscribe(client, key)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !stream.Receive() {
+					t.Fatalf("expected an update, got %v", stream.Err())
+				}
+				if stream.Msg().Account.UserId != "user" {
+					t.Fatalf("unexpected update %v", stream.Msg())
+				}
+				_ = stream.Close()
+
+				if c := <-handler.clients; c == nil || c.Name != "subscriber" {
+					t.Fatalf("expected the subscriber client in the handler context, got %v", c)
+				}
+			}
+		})
+	}
+}
+
+func TestAuthInterceptorRejectsUnaryWithoutKey(t *testing.T) {
+	client, handler := newAuthTestServer(t, map[string]clientKey{
+		"key": {client: testClient("transactor", ScopeTransact)},
+	})
+
+	_, err := client.Transact(context.Background(), connect.NewRequest(&transactionsv1.TransactRequest{}))
+	if connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Fatalf("expected unauthenticated, got %v", err)
+	}
+
+	req := connect.NewRequest(&transactionsv1.TransactRequest{})
+	req.Header().Set(AuthHeader, "key")
+	_, err = client.Transact(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c := <-handler.clients; c == nil || c.Name != "transactor" {
+		t.Fatalf("expected the transactor client in the handler context, got %v", c)
+	}
+}
+
+func TestAuthInterceptorRejectsUnaryWithoutScope(t *testing.T) {
+	client, _ := newAuthTestServer(t, map[string]clientKey{
+		"key": {client: testClient("subscriber", ScopeSubscribe, ScopeRead)},
+	})
+
+	req := connect.NewRequest(&transactionsv1.TransactRequest{})
+	req.Header().Set(AuthHeader, "key")
+	_, err := client.Transact(context.Background(), req)
+	if connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("expected permission denied, got %v", err)
+	}
+
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) || len(connectErr.Details()) == 0 {
+		t.Fatalf("expected an error detail, got %v", err)
+	}
+	detail, err := connectErr.Details()[0].Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reason := detail.(*transactionsv1.ErrorDetail).Reason; reason != transactionsv1.ErrorReason_ErrorReasonPermissionDenied {
+		t.Fatalf("expected the permission denied reason, got %s", reason)
+	}
+}
+
+func TestCheckLegacyClient(t *testing.T) {
+	apiClient := legacyClient("d21f0c89-567e-4b4f-928f-68679e48df6c")
+	err := checkLegacyClient(apiClient, "d21f0c89-567e-4b4f-928f-68679e48df6c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = checkLegacyClient(apiClient, "0b6a5c2e-7f8d-4e1a-9c3b-2d4e5f6a7b8c")
+	if !errors.Is(err, ErrLegacyClientKeyMismatch) {
+		t.Fatalf("expected ErrLegacyClientKeyMismatch, got %v", err)
+	}
+}
+
+func TestLegacyClientCanDoEverything(t *testing.T) {
+	apiClient := legacyClient("d21f0c89-567e-4b4f-928f-68679e48df6c")
+	if apiClient.KeyHash != HashClientKey("d21f0c89-567e-4b4f-928f-68679e48df6c") {
+		t.Fatal("the legacy client isn't found by the old key")
+	}
+
+	client := newClient(apiClient)
+	for _, scope := range Scopes {
+		if !client.HasScope(scope) {
+			t.Fatalf("expected the %s scope", scope)
+		}
+	}
+	debitAccount := &transactionsv1.Account{Code: transactionsv1.AccountCode_AccountUser}
+	for name, code := range transactionsv1.TransferCode_value {
+		if code == int32(transactionsv1.TransferCode_UnusedTransferCode) {
+			continue
+		}
+		err := client.canTransfer(transactionsv1.TransferCode(code), transactionsv1.Ledger_SUPS, debitAccount)
+		if err != nil {
+			t.Fatalf("expected to make %s transfers: %v", name, err)
+		}
+	}
+}
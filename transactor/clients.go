@@ -0,0 +1,277 @@
+package transactor
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+	"xsyn-transactions/boiler"
+	"xsyn-transactions/gen/transactions/v1"
+	"xsyn-transactions/storage"
+)
+
+var ErrUnauthenticated = fmt.Errorf("invalid client key")
+var ErrPermissionDenied = fmt.Errorf("permission denied")
+
+// Scope is what a client is allowed to call
+type Scope string
+
+const (
+	// ScopeRead reads accounts, balances, transactions and statements
+	ScopeRead Scope = "read"
+	// ScopeSubscribe streams completed transfers
+	ScopeSubscribe Scope = "subscribe"
+	// ScopeTransact makes, reserves, posts, voids and refunds transfers
+	ScopeTransact Scope = "transact"
+	// ScopeAdmin sets account flags, freezes accounts and manages webhooks, it includes every other scope
+	ScopeAdmin Scope = "admin"
+)
+
+var Scopes = []Scope{ScopeRead, ScopeSubscribe, ScopeTransact, ScopeAdmin}
+
+func ParseScope(s string) (Scope, error) {
+	for _, scope := range Scopes {
+		if string(scope) == s {
+			return scope, nil
+		}
+	}
+	return "", fmt.Errorf("invalid scope %q, must be one of %v", s, Scopes)
+}
+
+// Client is an authenticated api client and what it is allowed to do
+type Client struct {
+	ID   string
+	Name string
+
+	scopes        map[Scope]bool
+	transferCodes map[transactionsv1.TransferCode]bool
+	ledgers       map[transactionsv1.Ledger]bool
+	// empty allows debiting any account
+	debitAccountCodes map[transactionsv1.AccountCode]bool
+}
+
+func newClient(apiClient *boiler.APIClient) *Client {
+	client := &Client{
+		ID:                apiClient.ID,
+		Name:              apiClient.Name,
+		scopes:            map[Scope]bool{},
+		transferCodes:     map[transactionsv1.TransferCode]bool{},
+		ledgers:           map[transactionsv1.Ledger]bool{},
+		debitAccountCodes: map[transactionsv1.AccountCode]bool{},
+	}
+	for _, scope := range apiClient.Scopes {
+		client.scopes[Scope(scope)] = true
+	}
+	for _, code := range apiClient.TransferCodes {
+		client.transferCodes[transactionsv1.TransferCode(code)] = true
+	}
+	for _, ledger := range apiClient.Ledgers {
+		client.ledgers[transactionsv1.Ledger(ledger)] = true
+	}
+	for _, code := range apiClient.DebitAccountCodes {
+		client.debitAccountCodes[transactionsv1.AccountCode(code)] = true
+	}
+	return client
+}
+
+// HasScope returns true if the client has the scope, admins have every scope
+func (c *Client) HasScope(scope Scope) bool {
+	return c.scopes[ScopeAdmin] || c.scopes[scope]
+}
+
+// canTransfer checks the client is allowed to move funds with the code, on the ledger, out of the debit account
+func (c *Client) canTransfer(code transactionsv1.TransferCode, ledger transactionsv1.Ledger, debitAccount *transactionsv1.Account) error {
+	if !c.HasScope(ScopeTransact) {
+		return fmt.Errorf("%w: client %s can't transact", ErrPermissionDenied, c.Name)
+	}
+	if !c.transferCodes[code] {
+		return fmt.Errorf("%w: client %s can't make %s transfers", ErrPermissionDenied, c.Name, code)
+	}
+	if !c.ledgers[ledger] {
+		return fmt.Errorf("%w: client %s can't transfer on the %s ledger", ErrPermissionDenied, c.Name, ledger)
+	}
+	if len(c.debitAccountCodes) > 0 && !c.debitAccountCodes[debitAccount.Code] {
+		return fmt.Errorf("%w: client %s can't debit %s accounts", ErrPermissionDenied, c.Name, debitAccount.Code)
+	}
+	return nil
+}
+
+type clientContextKey struct{}
+
+func withClient(ctx context.Context, client *Client) context.Context {
+	return context.WithValue(ctx, clientContextKey{}, client)
+}
+
+// ClientFromContext returns the client that made the request
+func ClientFromContext(ctx context.Context) (*Client, bool) {
+	client, ok := ctx.Value(clientContextKey{}).(*Client)
+	return client, ok
+}
+
+// initiatorID is the id of the client that made the request, recorded with the transfers it makes
+func initiatorID(ctx context.Context) string {
+	client, ok := ClientFromContext(ctx)
+	if !ok {
+		return ""
+	}
+	return client.ID
+}
+
+// authorizeTransfer checks the client of the request can make the transfer
+func (t *Transactor) authorizeTransfer(ctx context.Context, code transactionsv1.TransferCode, ledger transactionsv1.Ledger, debitAccount *transactionsv1.Account) error {
+	client, ok := ClientFromContext(ctx)
+	if !ok {
+		return ErrUnauthenticated
+	}
+	return client.canTransfer(code, ledger, debitAccount)
+}
+
+// NewClientKey generates a key for a client, only the hash is stored
+func NewClientKey() (key string, keyHash string, err error) {
+	b := make([]byte, 32)
+	_, err = rand.Read(b)
+	if err != nil {
+		return "", "", err
+	}
+	key = hex.EncodeToString(b)
+	return key, HashClientKey(key), nil
+}
+
+// HashClientKey is how client keys are stored and looked up, they are random so a plain sha256 is enough
+func HashClientKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// clientKey is a key hash of a client, a rotated out key works until it expires
+type clientKey struct {
+	client    *Client
+	expiresAt time.Time
+}
+
+// authenticate returns the client with the key
+func (t *Transactor) authenticate(key string) (*Client, error) {
+	if key == "" {
+		return nil, ErrUnauthenticated
+	}
+
+	keys := t.clientKeys.Load()
+	if keys == nil {
+		return nil, ErrUnauthenticated
+	}
+	ck, ok := (*keys)[HashClientKey(key)]
+	if !ok || (!ck.expiresAt.IsZero() && time.Now().After(ck.expiresAt)) {
+		return nil, ErrUnauthenticated
+	}
+	return ck.client, nil
+}
+
+// loadClients replaces the client keys with the active clients in the database
+func (t *Transactor) loadClients() error {
+	apiClients, err := t.Storage.APIClientsActive()
+	if err != nil {
+		return err
+	}
+
+	keys := map[string]clientKey{}
+	for _, apiClient := range apiClients {
+		client := newClient(apiClient)
+		keys[apiClient.KeyHash] = clientKey{client: client}
+		if apiClient.PreviousKeyHash.Valid && apiClient.PreviousKeyExpiresAt.Valid {
+			keys[apiClient.PreviousKeyHash.String] = clientKey{client: client, expiresAt: apiClient.PreviousKeyExpiresAt.Time}
+		}
+	}
+	t.clientKeys.Store(&keys)
+
+	t.log.Info().Int("clients", len(apiClients)).Msg("loaded api clients")
+	return nil
+}
+
+// listenClients reloads the clients whenever one is created, rotated or revoked, so keys change without a restart
+func (t *Transactor) listenClients() {
+	for {
+		err := t.Storage.Listen(t.ctx, storage.APIClientsChannel, func() {
+			// changes made while we weren't listening are missed, so reload once listening
+			err := t.loadClients()
+			if err != nil {
+				t.log.Error().Err(err).Msg("failed to load api clients")
+			}
+		}, func(payload string) {
+			err := t.loadClients()
+			if err != nil {
+				t.log.Error().Err(err).Str("clientID", payload).Msg("failed to reload api clients")
+			}
+		})
+		if t.ctx.Err() != nil {
+			return
+		}
+		t.log.Error().Err(err).Msg("lost listen connection for api clients")
+		if !t.sleep(listenRetryInterval) {
+			return
+		}
+	}
+}
+
+// legacyClientName is the client seeded from the shared auth key used before per-client keys
+const legacyClientName = "legacy-auth-key"
+
+// legacyClient has every scope, transfer code and ledger, as the shared auth key did
+func legacyClient(key string) *boiler.APIClient {
+	apiClient := &boiler.APIClient{
+		Name:    legacyClientName,
+		KeyHash: HashClientKey(key),
+		Scopes:  []string{string(ScopeAdmin)},
+	}
+	for _, code := range transactionsv1.TransferCode_value {
+		if code != int32(transactionsv1.TransferCode_UnusedTransferCode) {
+			apiClient.TransferCodes = append(apiClient.TransferCodes, int64(code))
+		}
+	}
+	for _, ledger := range transactionsv1.Ledger_value {
+		if ledger != int32(transactionsv1.Ledger_UnusedLedgerCode) {
+			apiClient.Ledgers = append(apiClient.Ledgers, int64(ledger))
+		}
+	}
+	sort.Slice(apiClient.TransferCodes, func(i, j int) bool { return apiClient.TransferCodes[i] < apiClient.TransferCodes[j] })
+	sort.Slice(apiClient.Ledgers, func(i, j int) bool { return apiClient.Ledgers[i] < apiClient.Ledgers[j] })
+	return apiClient
+}
+
+// ErrLegacyClientKeyMismatch is returned on start when the legacy client was seeded from a different auth key than the one set
+var ErrLegacyClientKeyMismatch = fmt.Errorf("the %s client has a different key than auth_key, unset auth_key or set it to the client's key", legacyClientName)
+
+// seedLegacyClient creates a client for the deprecated shared auth key, so callers still sending it keep working until they have keys of their own.
+// It is only ever created once, so revoking it stops the key working even while the flag is still set.
+func (t *Transactor) seedLegacyClient(key string) error {
+	err := t.Storage.APIClientCreate(legacyClient(key))
+	if errors.Is(err, storage.ErrAlreadyExists) {
+		apiClient, err := t.Storage.APIClientGet(legacyClientName)
+		if err != nil {
+			return err
+		}
+		if apiClient.RevokedAt.Valid {
+			t.log.Warn().Str("client", legacyClientName).Msg("the deprecated auth key is set but its client is revoked, unset it")
+			return nil
+		}
+		return checkLegacyClient(apiClient, key)
+	}
+	if err != nil {
+		return err
+	}
+
+	t.log.Warn().Str("client", legacyClientName).Msg("created a client from the deprecated auth key, give its callers their own keys and revoke it")
+	return nil
+}
+
+// checkLegacyClient checks the seeded legacy client is the one for key. Changing the key would otherwise leave callers sending it
+// unauthenticated while the server starts as if nothing was wrong.
+func checkLegacyClient(apiClient *boiler.APIClient, key string) error {
+	if apiClient.KeyHash != HashClientKey(key) {
+		return ErrLegacyClientKeyMismatch
+	}
+	return nil
+}
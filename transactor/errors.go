@@ -0,0 +1,102 @@
+package transactor
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/bufbuild/connect-go"
+	"xsyn-transactions/gen/transactions/v1"
+	"xsyn-transactions/storage"
+)
+
+var ErrInvalidAmount = fmt.Errorf("invalid amount")
+var ErrInvalidID = fmt.Errorf("invalid id")
+var ErrUnableToFindTransaction = fmt.Errorf("unable to find transaction")
+
+// domainError is the connect code and reason a domain error is returned with
+type domainError struct {
+	err    error
+	code   connect.Code
+	reason transactionsv1.ErrorReason
+}
+
+// domainErrors are checked in order, so the more specific errors come first
+var domainErrors = []domainError{
+	{ErrInvalidAmount, connect.CodeInvalidArgument, transactionsv1.ErrorReason_ErrorReasonInvalidAmount},
+	{ErrInvalidID, connect.CodeInvalidArgument, transactionsv1.ErrorReason_ErrorReasonInvalidID},
+	{storage.ErrInvalidPageToken, connect.CodeInvalidArgument, transactionsv1.ErrorReason_ErrorReasonInvalidArgument},
+	{storage.ErrTransferToSelf, connect.CodeInvalidArgument, transactionsv1.ErrorReason_ErrorReasonTransferToSelf},
+
+	{ErrUnableToFindAccount, connect.CodeNotFound, transactionsv1.ErrorReason_ErrorReasonAccountNotFound},
+	{ErrUnableToFindTransaction, connect.CodeNotFound, transactionsv1.ErrorReason_ErrorReasonTransactionNotFound},
+	{storage.ErrRefundedTransactionMissing, connect.CodeNotFound, transactionsv1.ErrorReason_ErrorReasonTransactionNotFound},
+	{ErrUnableToFindPendingTransfer, connect.CodeNotFound, transactionsv1.ErrorReason_ErrorReasonPendingTransferNotFound},
+	{sql.ErrNoRows, connect.CodeNotFound, transactionsv1.ErrorReason_ErrorReasonNotFound},
+
+	{storage.ErrLedgerMismatch, connect.CodeFailedPrecondition, transactionsv1.ErrorReason_ErrorReasonLedgerMismatch},
+	{storage.ErrNotEnoughFunds, connect.CodeFailedPrecondition, transactionsv1.ErrorReason_ErrorReasonInsufficientFunds},
+	{ErrDebitAccountFrozen, connect.CodeFailedPrecondition, transactionsv1.ErrorReason_ErrorReasonDebitAccountFrozen},
+	{ErrCreditAccountFrozen, connect.CodeFailedPrecondition, transactionsv1.ErrorReason_ErrorReasonCreditAccountFrozen},
+	{storage.ErrDebitsDisabled, connect.CodeFailedPrecondition, transactionsv1.ErrorReason_ErrorReasonDebitsDisabled},
+	{storage.ErrCreditsDisabled, connect.CodeFailedPrecondition, transactionsv1.ErrorReason_ErrorReasonCreditsDisabled},
+	{ErrRefundExceedsOriginal, connect.CodeFailedPrecondition, transactionsv1.ErrorReason_ErrorReasonRefundExceedsOriginal},
+	{ErrTransferCodeNotRefundable, connect.CodeFailedPrecondition, transactionsv1.ErrorReason_ErrorReasonTransferCodeNotRefundable},
+	{ErrPendingTransferResolved, connect.CodeFailedPrecondition, transactionsv1.ErrorReason_ErrorReasonPendingTransferResolved},
+	{ErrPendingTransferExpired, connect.CodeFailedPrecondition, transactionsv1.ErrorReason_ErrorReasonPendingTransferExpired},
+	{storage.ErrPendingTransferInvalid, connect.CodeFailedPrecondition, transactionsv1.ErrorReason_ErrorReasonUnknown},
+	{storage.ErrWebhookDeliveryNotDead, connect.CodeFailedPrecondition, transactionsv1.ErrorReason_ErrorReasonUnknown},
+	{storage.ErrReplayTooLarge, connect.CodeFailedPrecondition, transactionsv1.ErrorReason_ErrorReasonUnknown},
+	{storage.ErrStatementTooLarge, connect.CodeFailedPrecondition, transactionsv1.ErrorReason_ErrorReasonUnknown},
+
+	{ErrIdempotencyKeyReused, connect.CodeAlreadyExists, transactionsv1.ErrorReason_ErrorReasonIdempotencyKeyReused},
+	{storage.ErrAlreadyExists, connect.CodeAlreadyExists, transactionsv1.ErrorReason_ErrorReasonAlreadyExists},
+
+	{ErrUnauthenticated, connect.CodeUnauthenticated, transactionsv1.ErrorReason_ErrorReasonUnknown},
+	{ErrPermissionDenied, connect.CodePermissionDenied, transactionsv1.ErrorReason_ErrorReasonPermissionDenied},
+
+	{ErrQueueFull, connect.CodeResourceExhausted, transactionsv1.ErrorReason_ErrorReasonUnknown},
+	{context.DeadlineExceeded, connect.CodeDeadlineExceeded, transactionsv1.ErrorReason_ErrorReasonUnknown},
+	{context.Canceled, connect.CodeCanceled, transactionsv1.ErrorReason_ErrorReasonUnknown},
+	{ErrNotLeader, connect.CodeUnavailable, transactionsv1.ErrorReason_ErrorReasonUnknown},
+	{storage.ErrLeaderFenced, connect.CodeUnavailable, transactionsv1.ErrorReason_ErrorReasonUnknown},
+	{ErrTimeToClose, connect.CodeUnavailable, transactionsv1.ErrorReason_ErrorReasonUnknown},
+	{ErrWatermarkUnknown, connect.CodeUnavailable, transactionsv1.ErrorReason_ErrorReasonUnknown},
+}
+
+// connectError returns err with the connect code of the domain error it wraps, and an ErrorDetail with the reason.
+// Errors raised by the database triggers are translated first, anything that isn't a domain error is internal.
+func connectError(err error) error {
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return connectErr
+	}
+
+	err = storage.DomainError(err)
+	for _, de := range domainErrors {
+		if !errors.Is(err, de.err) {
+			continue
+		}
+
+		connectErr = connect.NewError(de.code, err)
+		if de.reason != transactionsv1.ErrorReason_ErrorReasonUnknown {
+			detail, detailErr := connect.NewErrorDetail(&transactionsv1.ErrorDetail{Reason: de.reason})
+			if detailErr == nil {
+				connectErr.AddDetail(detail)
+			}
+		}
+		return connectErr
+	}
+
+	return connect.NewError(connect.CodeInternal, err)
+}
+
+// invalidAmount wraps a decimal parse error
+func invalidAmount(err error) error {
+	return connectError(fmt.Errorf("%w: %s", ErrInvalidAmount, err))
+}
+
+// invalidID wraps a uuid parse error
+func invalidID(err error) error {
+	return connectError(fmt.Errorf("%w: %s", ErrInvalidID, err))
+}
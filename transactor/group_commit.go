@@ -0,0 +1,189 @@
+package transactor
+
+import (
+	"context"
+	"fmt"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"sync/atomic"
+	"time"
+	"xsyn-transactions/boiler"
+	"xsyn-transactions/gen/transactions/v1"
+)
+
+// the states of a groupTransfer, a batch is either claimed by the group committer or abandoned by its caller
+const (
+	groupTransferWaiting int32 = iota
+	groupTransferClaimed
+	groupTransferAbandoned
+)
+
+// groupTransfer is a batch of transactions waiting to be committed in a group with others
+type groupTransfer struct {
+	ctx       context.Context
+	nts       []*NewTransaction
+	txs       []*boiler.Transaction
+	transfers []*transactionsv1.CompletedTransfer
+	done      chan error
+	state     atomic.Int32
+}
+
+// claim takes the batch for the group being committed, false if its caller has given up on it
+func (gt *groupTransfer) claim() bool {
+	return gt.state.CompareAndSwap(groupTransferWaiting, groupTransferClaimed)
+}
+
+// abandon gives up on the batch, false if it is already being committed
+func (gt *groupTransfer) abandon() bool {
+	return gt.state.CompareAndSwap(groupTransferWaiting, groupTransferAbandoned)
+}
+
+// groupCommit hands the transactions to the group committer and waits for their result.
+// The caller holds the shards of the accounts, so no two batches in a group touch the same account.
+// It stops waiting when ctx is done or the transactor closes, unless the batch is already being committed,
+// in which case the caller keeps its shards until the commit finishes.
+func (t *Transactor) groupCommit(ctx context.Context, nts []*NewTransaction, txs []*boiler.Transaction) ([]*transactionsv1.CompletedTransfer, error) {
+	gt := &groupTransfer{
+		ctx:  ctx,
+		nts:  nts,
+		txs:  txs,
+		done: make(chan error, 1),
+	}
+	select {
+	case t.groupCommits <- gt:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-t.ctx.Done():
+		return nil, ErrTimeToClose
+	}
+
+	var err error
+	select {
+	case err = <-gt.done:
+	case <-ctx.Done():
+		if gt.abandon() {
+			return nil, ctx.Err()
+		}
+		err = <-gt.done
+	case <-t.ctx.Done():
+		if gt.abandon() {
+			return nil, ErrTimeToClose
+		}
+		err = <-gt.done
+	}
+	if err != nil {
+		return nil, err
+	}
+	return gt.transfers, nil
+}
+
+// runGroupCommits takes up to groupCommitSize batches, waiting up to groupCommitWindow for them, and commits them together.
+// It commits what it has and returns once groupCommits is closed.
+func (t *Transactor) runGroupCommits() {
+	defer close(t.groupCommitsDone)
+
+	for first := range t.groupCommits {
+		group := []*groupTransfer{first}
+
+		timer := time.NewTimer(t.groupCommitWindow)
+	collect:
+		for len(group) < t.groupCommitSize {
+			select {
+			case gt, ok := <-t.groupCommits:
+				if !ok {
+					break collect
+				}
+				group = append(group, gt)
+			case <-timer.C:
+				break collect
+			}
+		}
+		timer.Stop()
+
+		t.commitGroup(group)
+	}
+}
+
+// commitGroup commits the batches in one db transaction, each behind a savepoint so a failing batch only rejects itself
+func (t *Transactor) commitGroup(group []*groupTransfer) {
+	finish := func(group []*groupTransfer, err error) {
+		for _, gt := range group {
+			gt.done <- err
+		}
+	}
+
+	dbTx, err := t.Storage.Begin()
+	if err != nil {
+		t.log.Error().Err(err).Msg("failed to begin transaction")
+		finish(group, err)
+		return
+	}
+	defer dbTx.Rollback()
+
+	committed := []*groupTransfer{}
+	txs := []*boiler.Transaction{}
+	transfers := []*transactionsv1.CompletedTransfer{}
+	for i, gt := range group {
+		// skip a batch whose caller went away while it waited for the group
+		if !gt.claim() {
+			continue
+		}
+		if gt.ctx.Err() != nil {
+			gt.done <- gt.ctx.Err()
+			continue
+		}
+
+		rejected, err := inSavepoint(dbTx, fmt.Sprintf("group_transfer_%d", i), func() error {
+			var err error
+			gt.transfers, err = t.insertTransactions(dbTx, gt.nts, gt.txs)
+			return err
+		})
+		if err != nil {
+			finish(group[i:], err)
+			finish(committed, err)
+			return
+		}
+		if rejected != nil {
+			gt.done <- rejected
+			continue
+		}
+
+		committed = append(committed, gt)
+		txs = append(txs, gt.txs...)
+		transfers = append(transfers, gt.transfers...)
+	}
+	if len(committed) == 0 {
+		return
+	}
+
+	err = t.commit(dbTx, txs, transfers, func() {
+		for _, transfer := range transfers {
+			t.balanceUpdate(transfer)
+		}
+	})
+	if err != nil {
+		t.log.Error().Err(err).Int("transactions", len(txs)).Int("batches", len(committed)).Msg("failed to commit transactions")
+		finish(committed, err)
+		return
+	}
+
+	t.logTransactions(txs)
+	finish(committed, nil)
+}
+
+// inSavepoint runs fn behind a savepoint, rolling back to it if fn fails so the rest of the transaction carries on.
+// The error of fn is returned as rejected, err is a savepoint error that leaves the whole transaction unusable.
+func inSavepoint(exec boil.Executor, name string, fn func() error) (rejected error, err error) {
+	_, err = exec.Exec("SAVEPOINT " + name)
+	if err != nil {
+		return nil, err
+	}
+
+	rejected = fn()
+	if rejected != nil {
+		_, err = exec.Exec("ROLLBACK TO SAVEPOINT " + name)
+		return rejected, err
+	}
+
+	_, err = exec.Exec("RELEASE SAVEPOINT " + name)
+	return nil, err
+}
@@ -0,0 +1,202 @@
+package transactor
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+	"xsyn-transactions/gen/transactions/v1"
+)
+
+// newGroupCommitTestTransactor has the group commit queue but no committer, the tests play the committer
+func newGroupCommitTestTransactor() *Transactor {
+	txr := &Transactor{
+		groupCommits:     make(chan *groupTransfer, 10),
+		groupCommitsDone: make(chan struct{}),
+	}
+	txr.ctx, txr.stop = context.WithCancel(context.Background())
+	return txr
+}
+
+func TestGroupCommitGivesUpWhileWaitingForGroup(t *testing.T) {
+	tests := []struct {
+		name   string
+		cancel func(txr *Transactor, cancel context.CancelFunc)
+		err    error
+	}{
+		{"caller goes away", func(txr *Transactor, cancel context.CancelFunc) { cancel() }, context.Canceled},
+		{"transactor closes", func(txr *Transactor, cancel context.CancelFunc) { txr.stop() }, ErrTimeToClose},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			txr := newGroupCommitTestTransactor()
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			errs := make(chan error, 1)
+			go func() {
+				_, err := txr.groupCommit(ctx, nil, nil)
+				errs <- err
+			}()
+			gt := <-txr.groupCommits
+
+			tt.cancel(txr, cancel)
+			select {
+			case err := <-errs:
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("expected %v, got %v", tt.err, err)
+				}
+			case <-time.After(time.Second):
+				t.Fatal("still waiting for a group that was never committed")
+			}
+
+			if gt.claim() {
+				t.Fatal("the committer claimed an abandoned batch")
+			}
+		})
+	}
+}
+
+func TestGroupCommitWaitsForClaimedBatch(t *testing.T) {
+	txr := newGroupCommitTestTransactor()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	type result struct {
+		transfers []*transactionsv1.CompletedTransfer
+		err       error
+	}
+	results := make(chan result, 1)
+	go func() {
+		transfers, err := txr.groupCommit(ctx, nil, nil)
+		results <- result{transfers, err}
+	}()
+
+	// once the committer has it, the caller waits for the commit even if it goes away
+	gt := <-txr.groupCommits
+	if !gt.claim() {
+		t.Fatal("couldn't claim a waiting batch")
+	}
+	cancel()
+	select {
+	case r := <-results:
+		t.Fatalf("stopped waiting for a batch being committed: %v", r.err)
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	gt.transfers = []*transactionsv1.CompletedTransfer{{Id: "transfer"}}
+	gt.done <- nil
+	r := <-results
+	if r.err != nil {
+		t.Fatal(r.err)
+	}
+	if len(r.transfers) != 1 || r.transfers[0].Id != "transfer" {
+		t.Fatalf("expected the committed transfer, got %v", r.transfers)
+	}
+}
+
+func TestRunGroupCommitsReturnsOnClose(t *testing.T) {
+	txr := newGroupCommitTestTransactor()
+	txr.groupCommitSize = 10
+	txr.groupCommitWindow = time.Hour
+
+	go txr.runGroupCommits()
+	close(txr.groupCommits)
+
+	select {
+	case <-txr.groupCommitsDone:
+	case <-time.After(time.Second):
+		t.Fatal("group committer didn't return after its queue closed")
+	}
+}
+
+// savepointExec records the statements it runs, failing the ones starting with fail
+type savepointExec struct {
+	statements []string
+	fail       string
+}
+
+func (e *savepointExec) Exec(query string, args ...interface{}) (sql.Result, error) {
+	e.statements = append(e.statements, query)
+	if e.fail != "" && strings.HasPrefix(query, e.fail) {
+		return nil, fmt.Errorf("%s failed", query)
+	}
+	return nil, nil
+}
+
+func (e *savepointExec) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return nil, fmt.Errorf("unexpected query %s", query)
+}
+
+func (e *savepointExec) QueryRow(query string, args ...interface{}) *sql.Row {
+	return nil
+}
+
+func TestInSavepoint(t *testing.T) {
+	errInsert := fmt.Errorf("not enough funds")
+
+	tests := []struct {
+		name       string
+		insert     error
+		fail       string
+		rejected   error
+		broken     bool
+		statements []string
+	}{
+		{"committed", nil, "", nil, false, []string{"SAVEPOINT sp", "insert", "RELEASE SAVEPOINT sp"}},
+		{"rolled back", errInsert, "", errInsert, false, []string{"SAVEPOINT sp", "insert", "ROLLBACK TO SAVEPOINT sp"}},
+		{"savepoint fails", nil, "SAVEPOINT", nil, true, []string{"SAVEPOINT sp"}},
+		{"rollback fails", errInsert, "ROLLBACK", errInsert, true, []string{"SAVEPOINT sp", "insert", "ROLLBACK TO SAVEPOINT sp"}},
+		{"release fails", nil, "RELEASE", nil, true, []string{"SAVEPOINT sp", "insert", "RELEASE SAVEPOINT sp"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exec := &savepointExec{fail: tt.fail}
+			rejected, err := inSavepoint(exec, "sp", func() error {
+				exec.statements = append(exec.statements, "insert")
+				return tt.insert
+			})
+			if rejected != tt.rejected {
+				t.Fatalf("expected rejected %v, got %v", tt.rejected, rejected)
+			}
+			if (err != nil) != tt.broken {
+				t.Fatalf("expected broken %v, got %v", tt.broken, err)
+			}
+			if !equalStrings(exec.statements, tt.statements) {
+				t.Fatalf("expected %v, got %v", tt.statements, exec.statements)
+			}
+		})
+	}
+}
+
+// TestInSavepointRejectsOneBatch runs a group where the middle batch fails, only its insert is rolled back
+func TestInSavepointRejectsOneBatch(t *testing.T) {
+	exec := &savepointExec{}
+	rejections := []error{}
+	for i, insert := range []error{nil, fmt.Errorf("debit account is frozen"), nil} {
+		savepoint := fmt.Sprintf("group_transfer_%d", i)
+		rejected, err := inSavepoint(exec, savepoint, func() error {
+			exec.statements = append(exec.statements, "insert "+savepoint)
+			return insert
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		rejections = append(rejections, rejected)
+	}
+
+	if rejections[0] != nil || rejections[1] == nil || rejections[2] != nil {
+		t.Fatalf("expected only the middle batch rejected, got %v", rejections)
+	}
+	expected := []string{
+		"SAVEPOINT group_transfer_0", "insert group_transfer_0", "RELEASE SAVEPOINT group_transfer_0",
+		"SAVEPOINT group_transfer_1", "insert group_transfer_1", "ROLLBACK TO SAVEPOINT group_transfer_1",
+		"SAVEPOINT group_transfer_2", "insert group_transfer_2", "RELEASE SAVEPOINT group_transfer_2",
+	}
+	if !equalStrings(exec.statements, expected) {
+		t.Fatalf("expected %v, got %v", expected, exec.statements)
+	}
+}
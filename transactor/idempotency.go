@@ -0,0 +1,76 @@
+package transactor
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/gofrs/uuid"
+	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/proto"
+	"xsyn-transactions/gen/transactions/v1"
+)
+
+var ErrIdempotencyKeyReused = fmt.Errorf("idempotency key has already been used with different parameters")
+
+// transactRequestHash hashes the parameters of a transact request so a replay can be compared to the original
+func transactRequestHash(req *transactionsv1.TransactRequest) string {
+	amount := req.Amount
+	if d, err := decimal.NewFromString(req.Amount); err == nil {
+		amount = d.String()
+	}
+
+	params := fmt.Sprintf("%s|%s|%d|%d|%s", req.CreditUserId, req.DebitUserId, req.Code, req.Ledger, amount)
+	// only added when set, so requests without them hash the same as before they existed
+	if req.ReferenceId != "" || len(req.Metadata.GetFields()) > 0 {
+		metadata, _ := proto.MarshalOptions{Deterministic: true}.Marshal(req.Metadata)
+		params += fmt.Sprintf("|%s|%x", req.ReferenceId, metadata)
+	}
+
+	hash := sha256.Sum256([]byte(params))
+	return hex.EncodeToString(hash[:])
+}
+
+// idempotencyScope is who idempotency keys belong to, the client of the request, so clients can't replay each other's transfers
+func idempotencyScope(ctx context.Context) string {
+	clientID := initiatorID(ctx)
+	if clientID == "" {
+		return uuid.Nil.String()
+	}
+	return clientID
+}
+
+// idempotentTransfer returns the transfer the client previously made with the idempotency key, or nil if it hasn't used the key.
+// The client has to still be allowed to make the transfer to get it back.
+func (t *Transactor) idempotentTransfer(ctx context.Context, req *transactionsv1.TransactRequest) (*transactionsv1.CompletedTransfer, error) {
+	idempotencyKey, err := t.Storage.IdempotencyKeyGet(idempotencyScope(ctx), req.IdempotencyKey)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, connectError(err)
+	}
+
+	if idempotencyKey.RequestHash != transactRequestHash(req) {
+		return nil, connectError(ErrIdempotencyKeyReused)
+	}
+
+	tx, err := t.Storage.TransactionGetByID(idempotencyKey.TransactionID)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	debitAccount, err := t.get(tx.DebitUserId, tx.Ledger)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	err = t.authorizeTransfer(ctx, tx.Code, tx.Ledger, debitAccount)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	t.log.Info().Str("idempotencyKey", req.IdempotencyKey).Str("id", tx.Id).Msg("replayed transaction")
+	return tx, nil
+}
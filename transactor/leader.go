@@ -0,0 +1,207 @@
+package transactor
+
+import (
+	"context"
+	"fmt"
+	"github.com/bufbuild/connect-go"
+	"net/http"
+	"time"
+	"xsyn-transactions/gen/transactions/v1/transactionsv1connect"
+	"xsyn-transactions/storage"
+)
+
+// the advisory lock key the leader holds
+const leaderLockKey int64 = 0x7873796e
+
+// how often followers try to take the lock and the leader checks it still holds it.
+// A leader that loses its database connection steps down within this interval.
+const leaderElectionInterval = 2 * time.Second
+
+// set on writes forwarded to the leader, so a replica that isn't the leader doesn't forward them again
+const forwardedHeader = "xsyn-transaction-forwarded"
+
+var ErrNotLeader = fmt.Errorf("this replica is not the leader")
+var ErrNoLeader = fmt.Errorf("no leader is elected")
+
+// leaderClients call the leader at an address
+type leaderClients struct {
+	address    string
+	transactor transactionsv1connect.TransactorClient
+	accounts   transactionsv1connect.AccountsClient
+}
+
+// isLeader returns true if this replica owns the write path
+func (t *Transactor) isLeader() bool {
+	return t.leader.Load()
+}
+
+// elect campaigns for leadership for as long as the transactor runs, starting with lock if this replica already leads with it
+func (t *Transactor) elect(lock *storage.SessionLock) {
+	for {
+		if lock == nil {
+			var err error
+			lock, err = t.Storage.NewSessionLock(t.ctx, leaderLockKey)
+			if err != nil {
+				if t.ctx.Err() != nil {
+					return
+				}
+				t.log.Error().Err(err).Msg("failed to connect for leader election")
+				if !t.sleep(leaderElectionInterval) {
+					return
+				}
+				continue
+			}
+		}
+
+		// closing the lock's connection releases it, so another replica can take over
+		err := t.campaign(lock)
+		lock.Close()
+		lock = nil
+		if t.leader.Swap(false) {
+			t.log.Warn().Err(err).Msg("stepped down as leader")
+		} else if t.ctx.Err() == nil {
+			t.log.Error().Err(err).Msg("leader election connection failed")
+		}
+		if !t.sleep(leaderElectionInterval) {
+			return
+		}
+	}
+}
+
+// campaign tries to take the leader lock until it does, then holds it until the connection fails or the transactor closes
+func (t *Transactor) campaign(lock *storage.SessionLock) error {
+	ctx := t.ctx
+	for {
+		if t.isLeader() {
+			err := lock.Ping(ctx)
+			if err != nil {
+				return err
+			}
+		} else {
+			acquired, err := lock.TryAcquire(ctx)
+			if err != nil {
+				return err
+			}
+
+			if acquired {
+				err = t.lead()
+				if err != nil {
+					return err
+				}
+			} else {
+				err = t.refreshLeader()
+				if err != nil {
+					t.log.Error().Err(err).Msg("failed to look up leader")
+				}
+			}
+		}
+
+		if !t.sleep(leaderElectionInterval) {
+			return ErrTimeToClose
+		}
+	}
+}
+
+// lead takes over the write path once this replica holds the leader lock
+func (t *Transactor) lead() error {
+	// the new epoch fences off the writes of a previous leader that hasn't noticed it lost the lock yet
+	epoch, err := t.Storage.LeaderSet(t.instanceID, t.advertiseAddress)
+	if err != nil {
+		return err
+	}
+	t.leaderEpoch.Store(epoch)
+
+	// the previous leader can't commit anymore, so every number it took is either committed or never will be
+	last, err := t.Storage.TransactionSequenceLast()
+	if err != nil {
+		return err
+	}
+	// queued behind the writes, so the held updates aren't sent once the transactor has closed
+	err = t.queue(context.Background(), func() error {
+		t.sequencer.passed(last)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// followers only learn of writes through notifications, start writing from the database's balances
+	t.resyncCache()
+	t.leader.Store(true)
+	t.signalWatermark()
+	t.log.Info().Str("instanceID", t.instanceID).Str("address", t.advertiseAddress).Int64("epoch", epoch).Msg("elected leader")
+	return nil
+}
+
+// leadAlone takes the leader lock for a replica running without leader election, erroring if another replica holds it
+func (t *Transactor) leadAlone() (*storage.SessionLock, error) {
+	lock, err := t.Storage.NewSessionLock(t.ctx, leaderLockKey)
+	if err != nil {
+		return nil, err
+	}
+
+	acquired, err := lock.TryAcquire(t.ctx)
+	if err != nil {
+		lock.Close()
+		return nil, err
+	}
+	if !acquired {
+		lock.Close()
+		return nil, fmt.Errorf("another replica holds the leader lock, enable leader election to run more than one")
+	}
+
+	err = t.lead()
+	if err != nil {
+		lock.Close()
+		return nil, err
+	}
+	return lock, nil
+}
+
+// refreshLeader points the leader clients at the address the leader recorded
+func (t *Transactor) refreshLeader() error {
+	leader, err := t.Storage.LeaderGet()
+	if err != nil {
+		return err
+	}
+
+	t.leaderLock.Lock()
+	defer t.leaderLock.Unlock()
+
+	if t.leaderClients != nil && t.leaderClients.address == leader.Address {
+		return nil
+	}
+
+	t.leaderClients = &leaderClients{
+		address:    leader.Address,
+		transactor: transactionsv1connect.NewTransactorClient(t.forwardClient, leader.Address, t.forwardOptions...),
+		accounts:   transactionsv1connect.NewAccountsClient(t.forwardClient, leader.Address, t.forwardOptions...),
+	}
+	t.log.Info().Str("address", leader.Address).Str("instanceID", leader.InstanceID).Msg("following leader")
+
+	return nil
+}
+
+// leaderFor returns the clients to forward a write to, erroring if the request was already forwarded
+func (t *Transactor) leaderFor(header http.Header) (*leaderClients, error) {
+	if header.Get(forwardedHeader) != "" {
+		return nil, connect.NewError(connect.CodeUnavailable, ErrNotLeader)
+	}
+
+	t.leaderLock.RLock()
+	defer t.leaderLock.RUnlock()
+
+	if t.leaderClients == nil {
+		return nil, connect.NewError(connect.CodeUnavailable, ErrNoLeader)
+	}
+	return t.leaderClients, nil
+}
+
+// forwardRequest copies a request to send on to the leader
+func forwardRequest[T any](req *connect.Request[T]) *connect.Request[T] {
+	forward := connect.NewRequest(req.Msg)
+	forward.Header().Set(forwardedHeader, "true")
+	// the leader authenticates the client that made the request
+	forward.Header().Set(AuthHeader, req.Header().Get(AuthHeader))
+	return forward
+}
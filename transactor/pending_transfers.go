@@ -0,0 +1,369 @@
+package transactor
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/bufbuild/connect-go"
+	"github.com/gofrs/uuid"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"time"
+	"xsyn-transactions/boiler"
+	"xsyn-transactions/gen/transactions/v1"
+	"xsyn-transactions/storage"
+)
+
+// TransferReserve holds funds on the debit account until the transfer is posted, voided or expires
+func (t *Transactor) TransferReserve(ctx context.Context, req *connect.Request[transactionsv1.TransferReserveRequest]) (*connect.Response[transactionsv1.TransferReserveResponse], error) {
+	if !t.isLeader() {
+		leader, err := t.leaderFor(req.Header())
+		if err != nil {
+			return nil, err
+		}
+		return leader.transactor.TransferReserve(ctx, forwardRequest(req))
+	}
+
+	nt, err := t.newTransaction(ctx, &transactionsv1.TransactRequest{
+		CreditUserId: req.Msg.CreditUserId,
+		DebitUserId:  req.Msg.DebitUserId,
+		Code:         req.Msg.Code,
+		Ledger:       req.Msg.Ledger,
+		Amount:       req.Msg.Amount,
+		ReferenceId:  req.Msg.ReferenceId,
+		Metadata:     req.Msg.Metadata,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := t.pendingTransferTimeout
+	if req.Msg.TimeoutSeconds > 0 {
+		timeout = time.Duration(req.Msg.TimeoutSeconds) * time.Second
+	}
+	if timeout > maxPendingTransferTimeout {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("pending transfer timeout can be at most %s", maxPendingTransferTimeout))
+	}
+
+	pendingTransfer, err := t.reserve(ctx, nt, time.Now().Add(timeout))
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse[transactionsv1.TransferReserveResponse](&transactionsv1.TransferReserveResponse{PendingTransfer: pendingTransfer}), nil
+}
+
+// TransferPost settles a pending transfer, moving the held funds to the credit account
+func (t *Transactor) TransferPost(ctx context.Context, req *connect.Request[transactionsv1.TransferPostRequest]) (*connect.Response[transactionsv1.TransferPostResponse], error) {
+	if !t.isLeader() {
+		leader, err := t.leaderFor(req.Header())
+		if err != nil {
+			return nil, err
+		}
+		return leader.transactor.TransferPost(ctx, forwardRequest(req))
+	}
+
+	err := t.authorizePendingTransfer(ctx, req.Msg.ReserveId)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	pendingTransfer, tx, err := t.resolvePendingTransfer(ctx, req.Msg.ReserveId, transactionsv1.PendingTransferStatus_PendingStatusPosted)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse[transactionsv1.TransferPostResponse](&transactionsv1.TransferPostResponse{Transfer: tx, PendingTransfer: pendingTransfer}), nil
+}
+
+// TransferVoid releases the held funds of a pending transfer back to the debit account
+func (t *Transactor) TransferVoid(ctx context.Context, req *connect.Request[transactionsv1.TransferVoidRequest]) (*connect.Response[transactionsv1.TransferVoidResponse], error) {
+	if !t.isLeader() {
+		leader, err := t.leaderFor(req.Header())
+		if err != nil {
+			return nil, err
+		}
+		return leader.transactor.TransferVoid(ctx, forwardRequest(req))
+	}
+
+	err := t.authorizePendingTransfer(ctx, req.Msg.ReserveId)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	pendingTransfer, _, err := t.resolvePendingTransfer(ctx, req.Msg.ReserveId, transactionsv1.PendingTransferStatus_PendingStatusVoided)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse[transactionsv1.TransferVoidResponse](&transactionsv1.TransferVoidResponse{PendingTransfer: pendingTransfer}), nil
+}
+
+func (t *Transactor) reserve(ctx context.Context, nt *NewTransaction, expiresAt time.Time) (*transactionsv1.PendingTransfer, error) {
+	var pendingTransfer *transactionsv1.PendingTransfer = nil
+
+	err := t.write(ctx, []string{nt.DebitAccountID, nt.CreditAccountID}, func() error {
+		dbTx, err := t.Storage.Begin()
+		if err != nil {
+			return err
+		}
+		defer dbTx.Rollback()
+
+		pt := &boiler.PendingTransfer{
+			ID:              uuid.Must(uuid.NewV4()).String(),
+			Amount:          nt.Amount,
+			DebitAccountID:  nt.DebitAccountID,
+			CreditAccountID: nt.CreditAccountID,
+			Ledger:          int(nt.Ledger),
+			TransferCode:    int(nt.TransferCode),
+			Status:          int(transactionsv1.PendingTransferStatus_PendingStatusPending),
+			ExpiresAt:       expiresAt,
+		}
+		if nt.InitiatorID != "" {
+			pt.InitiatorID = null.StringFrom(nt.InitiatorID)
+		}
+		if nt.ReferenceID != "" {
+			pt.ReferenceID = null.StringFrom(nt.ReferenceID)
+		}
+		metadata, err := storage.MetadataToJSON(nt.Metadata)
+		if err != nil {
+			return err
+		}
+		pt.Metadata = metadata
+
+		// inserting the pending transfer holds the funds, in the same transaction as its notification
+		err = pt.Insert(dbTx, boil.Infer())
+		if err != nil {
+			t.log.Error().
+				Err(err).
+				Str("from", pt.DebitAccountID).
+				Str("to", pt.CreditAccountID).
+				Str("amount", pt.Amount.String()).
+				Msg("reserve failed")
+			return err
+		}
+
+		err = t.notifyChange(dbTx, &change{Kind: changePendingTransfer, PendingTransferID: pt.ID})
+		if err != nil {
+			t.log.Error().Err(err).Str("id", pt.ID).Msg("failed to notify replicas")
+			return err
+		}
+
+		pendingTransfer = &transactionsv1.PendingTransfer{
+			Id:              pt.ID,
+			CreditUserId:    nt.CreditUserID,
+			CreditAccountId: nt.CreditAccountID,
+			DebitUserId:     nt.DebitUserID,
+			DebitAccountId:  nt.DebitAccountID,
+			Amount:          nt.Amount.String(),
+			Ledger:          nt.Ledger,
+			Code:            nt.TransferCode,
+			Status:          transactionsv1.PendingTransferStatus_PendingStatusPending,
+			ExpiresAt:       pt.ExpiresAt.Unix(),
+			CreatedAt:       pt.CreatedAt.Unix(),
+			InitiatorId:     nt.InitiatorID,
+			ReferenceId:     nt.ReferenceID,
+			Metadata:        nt.Metadata,
+		}
+
+		// no transfers to number, commit still fences the write
+		err = t.commit(dbTx, nil, nil, func() {
+			t.pendingBalanceUpdate(pendingTransfer, nil)
+		})
+		if err != nil {
+			return err
+		}
+
+		t.log.Info().Str("id", pt.ID).Str("fromAccount", pt.DebitAccountID).Str("toAccount", pt.CreditAccountID).Int("ledger", pt.Ledger).Int("transferCode", pt.TransferCode).Str("amount", pt.Amount.String()).Msg("successful reserve")
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pendingTransfer, nil
+}
+
+// authorizePendingTransfer checks the client of the request could have made the pending transfer, so it can post or void it
+func (t *Transactor) authorizePendingTransfer(ctx context.Context, pendingTransferID string) error {
+	pt, err := t.Storage.PendingTransferGetByID(pendingTransferID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrUnableToFindPendingTransfer
+		}
+		return err
+	}
+	debitAccount, err := t.get(pt.DebitUserId, pt.Ledger)
+	if err != nil {
+		return err
+	}
+	return t.authorizeTransfer(ctx, pt.Code, pt.Ledger, debitAccount)
+}
+
+// resolvePendingTransfer moves a pending transfer to posted, voided or expired, posting inserts the transaction
+func (t *Transactor) resolvePendingTransfer(ctx context.Context, pendingTransferID string, status transactionsv1.PendingTransferStatus) (*transactionsv1.PendingTransfer, *transactionsv1.CompletedTransfer, error) {
+	var pendingTransfer *transactionsv1.PendingTransfer = nil
+	var completedTx *transactionsv1.CompletedTransfer = nil
+	expired := false
+
+	// the accounts never change, so they can be read before taking their shards
+	current, err := t.Storage.PendingTransferGetByID(pendingTransferID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, ErrUnableToFindPendingTransfer
+		}
+		return nil, nil, err
+	}
+
+	err = t.write(ctx, []string{current.DebitAccountId, current.CreditAccountId}, func() error {
+		dbTx, err := t.Storage.Begin()
+		if err != nil {
+			return err
+		}
+		defer dbTx.Rollback()
+
+		pt, err := boiler.PendingTransfers(
+			boiler.PendingTransferWhere.ID.EQ(pendingTransferID),
+			qm.Load(boiler.PendingTransferRels.CreditAccount),
+			qm.Load(boiler.PendingTransferRels.DebitAccount),
+		).One(dbTx)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrUnableToFindPendingTransfer
+			}
+			return err
+		}
+		if pt.Status != int(transactionsv1.PendingTransferStatus_PendingStatusPending) {
+			return ErrPendingTransferResolved
+		}
+
+		// a hold past its expiry is expired rather than posted or voided, even if the expiry loop hasn't got to it yet
+		now := time.Now()
+		if status != transactionsv1.PendingTransferStatus_PendingStatusExpired && !pt.ExpiresAt.After(now) {
+			status = transactionsv1.PendingTransferStatus_PendingStatusExpired
+			expired = true
+		}
+
+		pt.Status = int(status)
+		pt.ResolvedAt = null.TimeFrom(now)
+
+		var tx *boiler.Transaction = nil
+		if status == transactionsv1.PendingTransferStatus_PendingStatusPosted {
+			tx = &boiler.Transaction{
+				ID:              uuid.Must(uuid.NewV4()).String(),
+				CreditAccountID: pt.CreditAccountID,
+				DebitAccountID:  pt.DebitAccountID,
+				Amount:          pt.Amount,
+				TransferCode:    pt.TransferCode,
+				Ledger:          pt.Ledger,
+				InitiatorID:     pt.InitiatorID,
+				ReferenceID:     pt.ReferenceID,
+				Metadata:        pt.Metadata,
+			}
+			pt.TransactionID = null.StringFrom(tx.ID)
+		}
+
+		// the update releases the held funds, so it has to happen before the transaction is inserted
+		_, err = pt.Update(dbTx, boil.Whitelist(
+			boiler.PendingTransferColumns.Status,
+			boiler.PendingTransferColumns.TransactionID,
+			boiler.PendingTransferColumns.ResolvedAt,
+		))
+		if err != nil {
+			t.log.Error().Err(err).Str("id", pt.ID).Int("status", pt.Status).Msg("failed to resolve pending transfer")
+			return err
+		}
+
+		if tx != nil {
+			err = tx.Insert(dbTx, boil.Infer())
+			if err != nil {
+				t.log.Error().
+					Err(err).
+					Str("from", tx.DebitAccountID).
+					Str("to", tx.CreditAccountID).
+					Str("id", tx.ID).
+					Str("amount", tx.Amount.String()).
+					Msg("transaction failed")
+				return err
+			}
+		}
+
+		pendingTransfer = storage.PendingTransferToProto(pt)
+		txs := []*boiler.Transaction{}
+		transfers := []*transactionsv1.CompletedTransfer{}
+		if tx != nil {
+			completedTx = &transactionsv1.CompletedTransfer{
+				Id:              tx.ID,
+				CreditUserId:    pendingTransfer.CreditUserId,
+				CreditAccountId: tx.CreditAccountID,
+				DebitUserId:     pendingTransfer.DebitUserId,
+				DebitAccountId:  tx.DebitAccountID,
+				Amount:          tx.Amount.String(),
+				Ledger:          transactionsv1.Ledger(tx.Ledger),
+				Code:            transactionsv1.TransferCode(tx.TransferCode),
+				Timestamp:       tx.CreatedAt.Unix(),
+				InitiatorId:     pendingTransfer.InitiatorId,
+				ReferenceId:     pendingTransfer.ReferenceId,
+				Metadata:        pendingTransfer.Metadata,
+			}
+			txs = append(txs, tx)
+			transfers = append(transfers, completedTx)
+		}
+
+		err = t.notifyChange(dbTx, &change{Kind: changePendingTransfer, PendingTransferID: pt.ID})
+		if err != nil {
+			t.log.Error().Err(err).Str("id", pt.ID).Msg("failed to notify replicas")
+			return err
+		}
+
+		err = t.commit(dbTx, txs, transfers, func() {
+			t.pendingBalanceUpdate(pendingTransfer, completedTx)
+		})
+		if err != nil {
+			return err
+		}
+
+		t.log.Info().Str("id", pt.ID).Int("status", pt.Status).Str("amount", pt.Amount.String()).Msg("successful pending transfer resolve")
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if expired {
+		return nil, nil, ErrPendingTransferExpired
+	}
+
+	return pendingTransfer, completedTx, nil
+}
+
+// expirePendingTransfers releases the funds of pending transfers that have timed out
+func (t *Transactor) expirePendingTransfers() {
+	ticker := time.NewTicker(pendingTransferExpiryInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-t.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if !t.isLeader() {
+			continue
+		}
+		ids, err := t.Storage.PendingTransfersExpired(time.Now())
+		if err != nil {
+			t.log.Error().Err(err).Msg("failed to get expired pending transfers")
+			continue
+		}
+
+		for _, id := range ids {
+			_, _, err = t.resolvePendingTransfer(context.Background(), id, transactionsv1.PendingTransferStatus_PendingStatusExpired)
+			if err != nil && !errors.Is(storage.DomainError(err), ErrPendingTransferResolved) {
+				t.log.Error().Err(err).Str("id", id).Msg("failed to expire pending transfer")
+			}
+		}
+	}
+}
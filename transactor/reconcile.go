@@ -0,0 +1,136 @@
+package transactor
+
+import (
+	"context"
+	"database/sql"
+	"google.golang.org/protobuf/proto"
+	"time"
+	"xsyn-transactions/gen/transactions/v1"
+	"xsyn-transactions/storage"
+)
+
+// the default time between reconciliations
+const defaultReconcileInterval = time.Hour
+
+// AccountDrift is an account whose columns or cached values disagree with what they are derived from
+type AccountDrift struct {
+	AccountID     string
+	UserID        string
+	Ledger        transactionsv1.Ledger
+	Drift         []*storage.Drift
+	CacheRepaired bool
+}
+
+// ReconcileTotals compares each account's totals, and its cached account when cached is given, returning the accounts that drifted
+func ReconcileTotals(totals []*storage.AccountTotals, cached map[string]*transactionsv1.Account) []*AccountDrift {
+	drifted := []*AccountDrift{}
+	for _, total := range totals {
+		var account *transactionsv1.Account
+		if cached != nil {
+			account = cached[total.AccountID]
+		}
+
+		drift := total.Drift(account)
+		if len(drift) == 0 {
+			continue
+		}
+		drifted = append(drifted, &AccountDrift{
+			AccountID: total.AccountID,
+			UserID:    total.UserID,
+			Ledger:    total.Ledger,
+			Drift:     drift,
+		})
+	}
+	return drifted
+}
+
+// Reconcile checks every account's columns against the transaction sums and the cached balances against the columns.
+// When repair is set drifted accounts are reloaded into the cache, the columns are only reported.
+func (t *Transactor) Reconcile(repair bool) ([]*AccountDrift, error) {
+	var snapshot *sql.Tx
+	cached := map[string]*transactionsv1.Account{}
+
+	// pin the snapshot and copy the cache between writes so both have seen the same transactions
+	err := t.queue(context.Background(), func() error {
+		var err error
+		snapshot, err = t.Storage.BeginSnapshot()
+		if err != nil {
+			return err
+		}
+
+		t.userMapLock.RLock()
+		for _, ledgers := range t.userMap {
+			for _, account := range ledgers {
+				cached[account.Id] = proto.Clone(account).(*transactionsv1.Account)
+			}
+		}
+		t.userMapLock.RUnlock()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer snapshot.Rollback()
+
+	totals, err := t.Storage.AccountTotalsGet(snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	drifted := ReconcileTotals(totals, cached)
+	if !repair || len(drifted) == 0 {
+		return drifted, nil
+	}
+
+	err = t.queue(context.Background(), func() error {
+		for _, d := range drifted {
+			accounts, err := t.Storage.GetAllUserAccounts(d.UserID)
+			if err != nil {
+				t.log.Error().Err(err).Str("accountID", d.AccountID).Msg("failed to reload drifted account")
+				continue
+			}
+			for _, account := range accounts {
+				if account.Id == d.AccountID {
+					t.put(account)
+					d.CacheRepaired = true
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return drifted, nil
+}
+
+// reconcile periodically reconciles the accounts and logs any drift
+func (t *Transactor) reconcile() {
+	ticker := time.NewTicker(t.reconcileInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-t.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		drifted, err := t.Reconcile(t.reconcileRepairCache)
+		if err != nil {
+			t.log.Error().Err(err).Msg("failed to reconcile accounts")
+			continue
+		}
+		for _, d := range drifted {
+			t.log.Error().
+				Str("accountID", d.AccountID).
+				Str("userID", d.UserID).
+				Str("ledger", d.Ledger.String()).
+				Interface("drift", d.Drift).
+				Bool("cacheRepaired", d.CacheRepaired).
+				Msg("account balance drift")
+		}
+		t.log.Info().Int("drifted", len(drifted)).Msg("reconciled accounts")
+	}
+}
@@ -0,0 +1,138 @@
+package transactor
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/bufbuild/connect-go"
+	"github.com/shopspring/decimal"
+	"xsyn-transactions/gen/transactions/v1"
+	"xsyn-transactions/storage"
+)
+
+var ErrTransferCodeNotRefundable = fmt.Errorf("transfer code can not be refunded")
+var ErrRefundExceedsOriginal = storage.ErrRefundExceedsOriginal
+
+// refundCodes maps each transfer code to the code used when it is refunded
+var refundCodes = map[transactionsv1.TransferCode]transactionsv1.TransferCode{
+	transactionsv1.TransferCode_Deposit:                  transactionsv1.TransferCode_DepositRefund,
+	transactionsv1.TransferCode_Withdraw:                 transactionsv1.TransferCode_WithdrawRefund,
+	transactionsv1.TransferCode_SupsPurchase:             transactionsv1.TransferCode_SupsPurchaseRefund,
+	transactionsv1.TransferCode_StorePurchase:            transactionsv1.TransferCode_StorePurchaseRefund,
+	transactionsv1.TransferCode_AssetTransferFee:         transactionsv1.TransferCode_AssetTransferFeeRefund,
+	transactionsv1.TransferCode_SupremacyStorePurchase:   transactionsv1.TransferCode_SupremacyStoreRefund,
+	transactionsv1.TransferCode_SupremacySyndicate:       transactionsv1.TransferCode_SupremacySyndicateRefund,
+	transactionsv1.TransferCode_SupremacyBattle:          transactionsv1.TransferCode_SupremacyBattleRefund,
+	transactionsv1.TransferCode_SupremacyBattleLobbyFee:  transactionsv1.TransferCode_SupremacyBattleLobbyFeeRefund,
+	transactionsv1.TransferCode_SupremacyBattleLobbyJoin: transactionsv1.TransferCode_SupremacyBattleLobbyJoinRefund,
+	transactionsv1.TransferCode_SupremacyMarketplaceBuy:  transactionsv1.TransferCode_SupremacyMarketplaceBuyRefund,
+	transactionsv1.TransferCode_SupremacyMarketplaceBid:  transactionsv1.TransferCode_SupremacyMarketplaceBidRefund,
+	transactionsv1.TransferCode_SupremacyRepair:          transactionsv1.TransferCode_SupremacyRepairRefund,
+	transactionsv1.TransferCode_SupremacyNotification:    transactionsv1.TransferCode_SupremacyNotificationRefund,
+	transactionsv1.TransferCode_SupremacyMarketplaceFee:  transactionsv1.TransferCode_SupremacyMarketplaceFeeRefund,
+}
+
+// Refund reverses a transaction with its matching refund code, if no amount is given the remaining amount is refunded
+func (t *Transactor) Refund(ctx context.Context, req *connect.Request[transactionsv1.RefundRequest]) (*connect.Response[transactionsv1.RefundResponse], error) {
+	if !t.isLeader() {
+		leader, err := t.leaderFor(req.Header())
+		if err != nil {
+			return nil, err
+		}
+		return leader.transactor.Refund(ctx, forwardRequest(req))
+	}
+
+	original, err := t.Storage.TransactionGetByID(req.Msg.TransactionId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, connectError(ErrUnableToFindTransaction)
+		}
+		return nil, connectError(err)
+	}
+
+	refundCode, ok := refundCodes[original.Code]
+	if !ok {
+		return nil, connectError(ErrTransferCodeNotRefundable)
+	}
+
+	// the refund debits the account that was credited
+	debitAccount, err := t.get(original.CreditUserId, original.Ledger)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	err = t.authorizeTransfer(ctx, refundCode, original.Ledger, debitAccount)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	creditAccount, err := t.get(original.DebitUserId, original.Ledger)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	err = checkFrozen(debitAccount, creditAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	refunds, err := t.Storage.TransactionRefunds(original.Id)
+	if err != nil {
+		return nil, connectError(err)
+	}
+	amount, err := refundAmount(original, refunds, req.Msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := t.transact(ctx, &NewTransaction{
+		CreditUserID:        original.DebitUserId,
+		CreditAccountID:     original.DebitAccountId,
+		DebitAccountID:      original.CreditAccountId,
+		DebitUserID:         original.CreditUserId,
+		Amount:              amount,
+		Ledger:              original.Ledger,
+		TransferCode:        refundCode,
+		ParentTransactionID: original.Id,
+		InitiatorID:         initiatorID(ctx),
+		ReferenceID:         req.Msg.ReferenceId,
+		Metadata:            req.Msg.Metadata,
+	})
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse[transactionsv1.RefundResponse](&transactionsv1.RefundResponse{Transfer: tx}), nil
+}
+
+// refundAmount is the amount to refund of the original after its earlier refunds, all that is left of it when none is requested
+func refundAmount(original *transactionsv1.CompletedTransfer, refunds []*transactionsv1.CompletedTransfer, requested string) (decimal.Decimal, error) {
+	remaining, err := decimal.NewFromString(original.Amount)
+	if err != nil {
+		return decimal.Zero, connectError(err)
+	}
+	for _, refund := range refunds {
+		refunded, err := decimal.NewFromString(refund.Amount)
+		if err != nil {
+			return decimal.Zero, connectError(err)
+		}
+		remaining = remaining.Sub(refunded)
+	}
+
+	amount := remaining
+	if requested != "" {
+		amount, err = decimal.NewFromString(requested)
+		if err != nil {
+			return decimal.Zero, invalidAmount(err)
+		}
+		if !amount.IsPositive() {
+			return decimal.Zero, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("refund amount must be positive"))
+		}
+		if violation := amountViolation(amount, original.Ledger); violation != "" {
+			return decimal.Zero, invalidAmount(fmt.Errorf("refund amount %s", violation))
+		}
+	}
+	// the trigger enforces this too, checking here gives a clearer error
+	if !remaining.IsPositive() || amount.GreaterThan(remaining) {
+		return decimal.Zero, connectError(ErrRefundExceedsOriginal)
+	}
+	return amount, nil
+}
@@ -0,0 +1,61 @@
+package transactor
+
+import (
+	"errors"
+	"github.com/bufbuild/connect-go"
+	"testing"
+	"xsyn-transactions/gen/transactions/v1"
+)
+
+func TestRefundAmount(t *testing.T) {
+	refunded := func(amounts ...string) []*transactionsv1.CompletedTransfer {
+		refunds := []*transactionsv1.CompletedTransfer{}
+		for _, amount := range amounts {
+			refunds = append(refunds, &transactionsv1.CompletedTransfer{Amount: amount})
+		}
+		return refunds
+	}
+
+	tests := []struct {
+		name      string
+		refunds   []*transactionsv1.CompletedTransfer
+		requested string
+		amount    string
+		err       error
+		code      connect.Code
+	}{
+		{"full refund", nil, "", "100", nil, 0},
+		{"rest after partial refunds", refunded("30", "20"), "", "50", nil, 0},
+		{"partial refund", nil, "40", "40", nil, 0},
+		{"exactly what is left", refunded("60"), "40", "40", nil, 0},
+		{"more than is left", refunded("60"), "41", "", ErrRefundExceedsOriginal, connect.CodeFailedPrecondition},
+		{"more than the original", nil, "101", "", ErrRefundExceedsOriginal, connect.CodeFailedPrecondition},
+		{"already fully refunded", refunded("100"), "", "", ErrRefundExceedsOriginal, connect.CodeFailedPrecondition},
+		{"partial of fully refunded", refunded("50", "50"), "1", "", ErrRefundExceedsOriginal, connect.CodeFailedPrecondition},
+		{"zero", nil, "0", "", nil, connect.CodeInvalidArgument},
+		{"negative", nil, "-10", "", nil, connect.CodeInvalidArgument},
+		{"fraction of a sup", nil, "0.5", "", ErrInvalidAmount, connect.CodeInvalidArgument},
+		{"not a number", nil, "ten", "", ErrInvalidAmount, connect.CodeInvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := &transactionsv1.CompletedTransfer{Amount: "100", Ledger: transactionsv1.Ledger_SUPS}
+			amount, err := refundAmount(original, tt.refunds, tt.requested)
+			if tt.code == 0 {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if amount.String() != tt.amount {
+					t.Fatalf("expected to refund %s, got %s", tt.amount, amount)
+				}
+				return
+			}
+			if connect.CodeOf(err) != tt.code {
+				t.Fatalf("expected %s, got %v", tt.code, err)
+			}
+			if tt.err != nil && !errors.Is(err, tt.err) {
+				t.Fatalf("expected %v, got %v", tt.err, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,252 @@
+package transactor
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"time"
+	"xsyn-transactions/gen/transactions/v1"
+)
+
+// the postgres channel replicas publish their changes on
+const changesChannel = "xsyn_transactions_changes"
+
+// how long to wait before listening again after the listen connection fails
+const listenRetryInterval = 5 * time.Second
+
+type changeKind string
+
+const (
+	changeTransfer        changeKind = "transfer"
+	changePendingTransfer changeKind = "pending_transfer"
+	changeAccount         changeKind = "account"
+	// the leader's watermark moved, followers send the transfers it passes to their subscribers
+	changeWatermark changeKind = "watermark"
+)
+
+// change is the payload of a notification, replicas reload what changed from the database rather than trusting the payload
+type change struct {
+	Origin            string     `json:"origin"`
+	Kind              changeKind `json:"kind"`
+	TransactionID     string     `json:"transaction_id,omitempty"`
+	PendingTransferID string     `json:"pending_transfer_id,omitempty"`
+	UserID            string     `json:"user_id,omitempty"`
+	Sequence          int64      `json:"sequence,omitempty"`
+}
+
+// notifyChange tells the other replicas about a change, in a db transaction it is only sent if the transaction commits
+func (t *Transactor) notifyChange(exec boil.Executor, c *change) error {
+	c.Origin = t.instanceID
+	payload, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+	return t.Storage.Notify(exec, changesChannel, string(payload))
+}
+
+// listenChanges applies the changes of other replicas to the cache and fans them out to this replica's subscribers
+func (t *Transactor) listenChanges() {
+	reconnect := false
+	for {
+		err := t.Storage.Listen(t.ctx, changesChannel, func() {
+			// notifications sent while we weren't listening are gone, so reload everything
+			if reconnect {
+				t.resyncCache()
+			}
+			reconnect = true
+		}, t.applyChange)
+		if t.ctx.Err() != nil {
+			return
+		}
+		t.log.Error().Err(err).Msg("lost listen connection for replica changes")
+		if !t.sleep(listenRetryInterval) {
+			return
+		}
+	}
+}
+
+func (t *Transactor) applyChange(payload string) {
+	c := &change{}
+	err := json.Unmarshal([]byte(payload), c)
+	if err != nil {
+		t.log.Error().Err(err).Str("payload", payload).Msg("failed to parse replica change")
+		return
+	}
+	if c.Origin == t.instanceID {
+		return
+	}
+
+	// queue behind every write so a reload can't overwrite a local write made after it was read
+	err = t.queue(context.Background(), func() error {
+		switch c.Kind {
+		case changeTransfer:
+			return t.applyTransfer(c.TransactionID)
+		case changePendingTransfer:
+			return t.applyPendingTransfer(c.PendingTransferID)
+		case changeAccount:
+			_, err := t.refresh(c.UserID)
+			return err
+		case changeWatermark:
+			t.sequencer.passed(c.Sequence)
+		}
+		return nil
+	})
+	if err != nil {
+		t.log.Error().Err(err).Str("payload", payload).Msg("failed to apply replica change")
+	}
+}
+
+func (t *Transactor) applyTransfer(transactionID string) error {
+	transfer, err := t.Storage.TransactionGetByID(transactionID)
+	if err != nil {
+		return err
+	}
+
+	updates := []*transactionsv1.TransferCompleteSubscribeResponse{}
+	for _, userID := range []string{transfer.DebitUserId, transfer.CreditUserId} {
+		account, err := t.refreshAccount(userID, transfer.Ledger)
+		if err != nil {
+			return err
+		}
+		updates = append(updates, &transactionsv1.TransferCompleteSubscribeResponse{
+			Account:     account,
+			Transaction: transfer,
+		})
+	}
+
+	// transfers commit out of sequence order, subscribers get them once the leader's watermark passes them
+	t.sequencer.hold(transfer.Sequence, updates...)
+	return nil
+}
+
+func (t *Transactor) applyPendingTransfer(pendingTransferID string) error {
+	pendingTransfer, err := t.Storage.PendingTransferGetByID(pendingTransferID)
+	if err != nil {
+		return err
+	}
+
+	var transfer *transactionsv1.CompletedTransfer = nil
+	if pendingTransfer.TransactionId != "" {
+		transfer, err = t.Storage.TransactionGetByID(pendingTransfer.TransactionId)
+		if err != nil {
+			return err
+		}
+	}
+
+	updates := []*transactionsv1.TransferCompleteSubscribeResponse{}
+	for _, userID := range []string{pendingTransfer.DebitUserId, pendingTransfer.CreditUserId} {
+		account, err := t.refreshAccount(userID, pendingTransfer.Ledger)
+		if err != nil {
+			return err
+		}
+		updates = append(updates, &transactionsv1.TransferCompleteSubscribeResponse{
+			Account:         account,
+			Transaction:     transfer,
+			PendingTransfer: pendingTransfer,
+		})
+	}
+
+	// only a post has a sequence to wait for
+	if transfer != nil {
+		t.sequencer.hold(transfer.Sequence, updates...)
+		return nil
+	}
+	for _, res := range updates {
+		t.broadcaster <- res
+	}
+	return nil
+}
+
+// signalWatermark wakes notifyWatermarks without waiting on it
+func (t *Transactor) signalWatermark() {
+	select {
+	case t.watermarkMoved <- struct{}{}:
+	default:
+	}
+}
+
+// notifyWatermarks tells the followers the leader's watermark when it moves, and every election interval for followers that just started
+func (t *Transactor) notifyWatermarks() {
+	ticker := time.NewTicker(leaderElectionInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-t.ctx.Done():
+			return
+		case <-t.watermarkMoved:
+		case <-ticker.C:
+		}
+
+		if !t.isLeader() {
+			continue
+		}
+		watermark, err := t.sequencer.current()
+		if err != nil {
+			continue
+		}
+		err = t.notifyChange(t.Storage, &change{Kind: changeWatermark, Sequence: watermark})
+		if err != nil {
+			t.log.Error().Err(err).Int64("watermark", watermark).Msg("failed to notify replicas of the watermark")
+		}
+	}
+}
+
+// refresh reloads a user's accounts into the cache
+func (t *Transactor) refresh(userID string) ([]*transactionsv1.Account, error) {
+	accounts, err := t.Storage.GetAllUserAccounts(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	t.userMapLock.Lock()
+	defer t.userMapLock.Unlock()
+
+	if _, ok := t.userMap[userID]; !ok {
+		t.userMap[userID] = make(map[transactionsv1.Ledger]*transactionsv1.Account)
+	}
+	for _, account := range accounts {
+		t.userMap[userID][account.Ledger] = account
+	}
+
+	return accounts, nil
+}
+
+func (t *Transactor) refreshAccount(userID string, ledger transactionsv1.Ledger) (*transactionsv1.Account, error) {
+	accounts, err := t.refresh(userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, account := range accounts {
+		if account.Ledger == ledger {
+			return account, nil
+		}
+	}
+	return nil, ErrUnableToFindAccount
+}
+
+// resyncCache reloads every account into the cache
+func (t *Transactor) resyncCache() {
+	err := t.queue(context.Background(), func() error {
+		accounts, err := t.Storage.GetAllAccounts()
+		if err != nil {
+			return err
+		}
+
+		t.userMapLock.Lock()
+		defer t.userMapLock.Unlock()
+
+		for _, account := range accounts {
+			if _, ok := t.userMap[account.UserId]; !ok {
+				t.userMap[account.UserId] = make(map[transactionsv1.Ledger]*transactionsv1.Account)
+			}
+			t.userMap[account.UserId][account.Ledger] = account
+		}
+		return nil
+	})
+	if err != nil {
+		t.log.Error().Err(err).Msg("failed to resync account cache")
+		return
+	}
+	t.log.Info().Msg("resynced account cache")
+}
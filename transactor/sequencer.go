@@ -0,0 +1,162 @@
+package transactor
+
+import (
+	"fmt"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"xsyn-transactions/gen/transactions/v1"
+)
+
+var ErrWatermarkUnknown = fmt.Errorf("transfer sequence hasn't caught up with the leader yet")
+
+// sequencer numbers transfers and releases their updates in sequence order.
+// Transfers on different shards commit in parallel, so a later number can commit before an earlier one.
+// Its updates are held until every earlier number has committed or been given up on, so subscribers see transfers
+// in sequence order and resuming after a sequence never skips one that commits late.
+//
+// The watermark is the sequence every transfer up to has been released. On the leader it follows its own commits,
+// followers hold the transfers they're notified of until the leader tells them its watermark has passed them.
+type sequencer struct {
+	// held while taking numbers, so batches queue up in number order
+	numberLock sync.Mutex
+	numbers    func(exec boil.Executor, n int) ([]int64, error)
+
+	// guards the rest, releasing happens under it so updates go out one batch at a time
+	lock      sync.Mutex
+	batches   []*sequenceBatch
+	held      []heldUpdate
+	watermark atomic.Int64
+	known     atomic.Bool
+	// sends a follower's held updates to the subscribers
+	send func(res *transactionsv1.TransferCompleteSubscribeResponse)
+	// called with the watermark whenever it moves, under lock
+	moved func(watermark int64)
+}
+
+// sequenceBatch is the numbers of the transfers committed together
+type sequenceBatch struct {
+	seqs     []int64
+	done     bool
+	apply    func()
+	released chan struct{}
+}
+
+// heldUpdate is a follower's update of a transfer, waiting for the watermark to pass it
+type heldUpdate struct {
+	seq     int64
+	updates []*transactionsv1.TransferCompleteSubscribeResponse
+}
+
+func newSequencer(numbers func(exec boil.Executor, n int) ([]int64, error), send func(res *transactionsv1.TransferCompleteSubscribeResponse), moved func(watermark int64)) *sequencer {
+	return &sequencer{numbers: numbers, send: send, moved: moved}
+}
+
+// reserve takes n numbers on the writer's db transaction, the batch has to be finished whether or not its transfers commit
+func (s *sequencer) reserve(exec boil.Executor, n int) (*sequenceBatch, error) {
+	s.numberLock.Lock()
+	defer s.numberLock.Unlock()
+
+	batch := &sequenceBatch{released: make(chan struct{})}
+	if n > 0 {
+		seqs, err := s.numbers(exec, n)
+		if err != nil {
+			return nil, err
+		}
+		batch.seqs = seqs
+	}
+
+	s.lock.Lock()
+	s.batches = append(s.batches, batch)
+	s.lock.Unlock()
+
+	return batch, nil
+}
+
+// finish marks the batch committed, with apply to run once every earlier batch is released, and waits for it to be.
+// A nil apply gives up on the batch, its numbers are skipped.
+func (s *sequencer) finish(batch *sequenceBatch, apply func()) {
+	s.lock.Lock()
+	batch.done = true
+	batch.apply = apply
+	s.release()
+	s.lock.Unlock()
+
+	if apply != nil {
+		<-batch.released
+	}
+}
+
+// release runs the finished batches at the head of the queue, s.lock must be held
+func (s *sequencer) release() {
+	watermark := int64(0)
+	for len(s.batches) > 0 && s.batches[0].done {
+		batch := s.batches[0]
+		s.batches = s.batches[1:]
+		if batch.apply != nil {
+			batch.apply()
+		}
+		if len(batch.seqs) > 0 && batch.seqs[len(batch.seqs)-1] > watermark {
+			watermark = batch.seqs[len(batch.seqs)-1]
+		}
+		close(batch.released)
+	}
+	if watermark > 0 {
+		s.advance(watermark)
+	}
+}
+
+// hold keeps a follower's updates of a transfer until the watermark passes its sequence
+func (s *sequencer) hold(seq int64, updates ...*transactionsv1.TransferCompleteSubscribeResponse) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.known.Load() && seq <= s.watermark.Load() {
+		for _, res := range updates {
+			s.send(res)
+		}
+		return
+	}
+	i := sort.Search(len(s.held), func(i int) bool { return s.held[i].seq > seq })
+	s.held = append(s.held, heldUpdate{})
+	copy(s.held[i+1:], s.held[i:])
+	s.held[i] = heldUpdate{seq: seq, updates: updates}
+}
+
+// passed moves the watermark to a sequence every transfer up to has committed or been given up on
+func (s *sequencer) passed(watermark int64) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.advance(watermark)
+}
+
+// advance moves the watermark forward and sends the held updates it passes, s.lock must be held
+func (s *sequencer) advance(watermark int64) {
+	if s.known.Load() && watermark <= s.watermark.Load() {
+		return
+	}
+	s.watermark.Store(watermark)
+	s.known.Store(true)
+
+	released := 0
+	for released < len(s.held) && s.held[released].seq <= watermark {
+		for _, res := range s.held[released].updates {
+			s.send(res)
+		}
+		released++
+	}
+	s.held = s.held[released:]
+
+	if s.moved != nil {
+		s.moved(watermark)
+	}
+}
+
+// current returns the watermark, erroring if this replica hasn't learnt it yet
+func (s *sequencer) current() (int64, error) {
+	if !s.known.Load() {
+		return 0, ErrWatermarkUnknown
+	}
+	return s.watermark.Load(), nil
+}
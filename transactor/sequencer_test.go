@@ -0,0 +1,207 @@
+package transactor
+
+import (
+	"errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+	"xsyn-transactions/gen/transactions/v1"
+)
+
+// newTestSequencer numbers from 1 and records the sequences of the updates it sends
+func newTestSequencer() (*sequencer, func() []int64) {
+	var lock sync.Mutex
+	next := int64(0)
+	sent := []int64{}
+
+	s := newSequencer(func(exec boil.Executor, n int) ([]int64, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		seqs := []int64{}
+		for i := 0; i < n; i++ {
+			next++
+			seqs = append(seqs, next)
+		}
+		return seqs, nil
+	}, func(res *transactionsv1.TransferCompleteSubscribeResponse) {
+		lock.Lock()
+		defer lock.Unlock()
+		sent = append(sent, res.Transaction.Sequence)
+	}, nil)
+
+	return s, func() []int64 {
+		lock.Lock()
+		defer lock.Unlock()
+		return append([]int64{}, sent...)
+	}
+}
+
+func sequenced(seq int64) *transactionsv1.TransferCompleteSubscribeResponse {
+	return &transactionsv1.TransferCompleteSubscribeResponse{Transaction: &transactionsv1.CompletedTransfer{Sequence: seq}}
+}
+
+func equalSeqs(a []int64, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestSequencerReleasesInSequenceOrder(t *testing.T) {
+	s, _ := newTestSequencer()
+	s.passed(0)
+
+	applied := make(chan int64, 10)
+	apply := func(batch *sequenceBatch) func() {
+		return func() {
+			for _, seq := range batch.seqs {
+				applied <- seq
+			}
+		}
+	}
+
+	first, _ := s.reserve(nil, 1)
+	second, _ := s.reserve(nil, 2)
+	third, _ := s.reserve(nil, 1)
+
+	// the last batch commits first, it waits for the others
+	thirdDone := make(chan struct{})
+	go func() {
+		s.finish(third, apply(third))
+		close(thirdDone)
+	}()
+	select {
+	case seq := <-applied:
+		t.Fatalf("applied %d before the earlier batches finished", seq)
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	s.finish(first, apply(first))
+	if seq := <-applied; seq != 1 {
+		t.Fatalf("expected 1 to be applied, got %d", seq)
+	}
+	if watermark, _ := s.current(); watermark != 1 {
+		t.Fatalf("expected the watermark at 1, got %d", watermark)
+	}
+
+	// giving up on the middle batch skips its numbers
+	s.finish(second, nil)
+	<-thirdDone
+	if seq := <-applied; seq != 4 {
+		t.Fatalf("expected 4 to be applied, got %d", seq)
+	}
+	if watermark, _ := s.current(); watermark != 4 {
+		t.Fatalf("expected the watermark at 4, got %d", watermark)
+	}
+	select {
+	case seq := <-applied:
+		t.Fatalf("applied %d of a batch that was given up on", seq)
+	default:
+	}
+}
+
+func TestSequencerConcurrentCommits(t *testing.T) {
+	s, _ := newTestSequencer()
+	s.passed(0)
+
+	var lock sync.Mutex
+	applied := []int64{}
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			batch, err := s.reserve(nil, 1+i%3)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			// commits take different times, so they finish out of order
+			time.Sleep(time.Duration(rand.Intn(5)) * time.Millisecond)
+			if i%7 == 0 {
+				s.finish(batch, nil)
+				return
+			}
+			s.finish(batch, func() {
+				lock.Lock()
+				applied = append(applied, batch.seqs...)
+				lock.Unlock()
+			})
+		}(i)
+	}
+	wg.Wait()
+
+	for i := 1; i < len(applied); i++ {
+		if applied[i-1] >= applied[i] {
+			t.Fatalf("applied out of sequence order: %v", applied)
+		}
+	}
+	if watermark, _ := s.current(); watermark != 99 {
+		t.Fatalf("expected the watermark at the last number 99, got %d", watermark)
+	}
+}
+
+func TestSequencerHoldsFollowerUpdates(t *testing.T) {
+	s, sent := newTestSequencer()
+
+	if _, err := s.current(); !errors.Is(err, ErrWatermarkUnknown) {
+		t.Fatalf("expected the watermark to be unknown, got %v", err)
+	}
+
+	// notified in commit order, which isn't sequence order
+	s.hold(5, sequenced(5))
+	s.hold(3, sequenced(3))
+	if len(sent()) != 0 {
+		t.Fatalf("sent %v before the watermark was known", sent())
+	}
+
+	tests := []struct {
+		name      string
+		watermark int64
+		hold      int64
+		sent      []int64
+	}{
+		{"passes the first", 3, 0, []int64{3}},
+		{"doesn't go back", 2, 0, []int64{3}},
+		{"passes the rest", 6, 0, []int64{3, 5}},
+		{"sends a late update straight away", 6, 4, []int64{3, 5, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s.passed(tt.watermark)
+			if tt.hold > 0 {
+				s.hold(tt.hold, sequenced(tt.hold))
+			}
+			if !equalSeqs(sent(), tt.sent) {
+				t.Fatalf("expected %v sent, got %v", tt.sent, sent())
+			}
+		})
+	}
+}
+
+// TestSequencerNumbersOnWritersTransaction takes the numbers on the executor it is given,
+// a writer holding a connection mustn't wait on the pool for another one
+func TestSequencerNumbersOnWritersTransaction(t *testing.T) {
+	dbTx := &savepointExec{}
+	var used boil.Executor
+	s := newSequencer(func(exec boil.Executor, n int) ([]int64, error) {
+		used = exec
+		return []int64{1}, nil
+	}, nil, nil)
+
+	batch, err := s.reserve(dbTx, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.finish(batch, nil)
+	if used != dbTx {
+		t.Fatalf("expected the numbers taken on the writer's transaction, got %v", used)
+	}
+}
@@ -0,0 +1,34 @@
+package transactor
+
+import (
+	"context"
+	"fmt"
+	"github.com/bufbuild/connect-go"
+	"time"
+	"xsyn-transactions/gen/transactions/v1"
+)
+
+// the most transfers one statement can have, longer ranges have to be split
+const maxStatementLines = 10000
+
+// StatementGet returns an account's statement with the opening, running and closing balances and a subtotal per transfer code
+func (t *Transactor) StatementGet(ctx context.Context, req *connect.Request[transactionsv1.StatementGetRequest]) (*connect.Response[transactionsv1.StatementGetResponse], error) {
+	if req.Msg.UserId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("user id is empty"))
+	}
+	if req.Msg.To <= req.Msg.From {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("statement to must be after from"))
+	}
+
+	account, err := t.get(req.Msg.UserId, req.Msg.Ledger)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	statement, err := t.Storage.StatementGet(account, time.Unix(req.Msg.From, 0), time.Unix(req.Msg.To, 0), maxStatementLines)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse[transactionsv1.StatementGetResponse](&transactionsv1.StatementGetResponse{Statement: statement}), nil
+}
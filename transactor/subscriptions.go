@@ -0,0 +1,311 @@
+package transactor
+
+import (
+	"fmt"
+	"github.com/bufbuild/connect-go"
+	"sync"
+	"time"
+	"xsyn-transactions/gen/transactions/v1"
+)
+
+// the most transfers a resuming subscriber can be replayed
+const maxReplayTransfers = 10000
+
+// the default number of updates a subscriber can fall behind by before its overflow policy applies
+const defaultSubscriberQueueSize = 1000
+
+var ErrSubscriberOverflow = fmt.Errorf("subscriber fell too far behind")
+
+// OverflowPolicy is what happens when a subscriber's queue is full
+type OverflowPolicy string
+
+const (
+	// OverflowDisconnect ends the stream, the client can reconnect with resume_after
+	OverflowDisconnect OverflowPolicy = "disconnect"
+	// OverflowDropOldest drops the oldest queued update
+	OverflowDropOldest OverflowPolicy = "drop_oldest"
+	// OverflowCoalesce keeps only the latest queued update of each account
+	OverflowCoalesce OverflowPolicy = "coalesce"
+)
+
+func ParseOverflowPolicy(policy string) (OverflowPolicy, error) {
+	switch OverflowPolicy(policy) {
+	case OverflowDisconnect, OverflowDropOldest, OverflowCoalesce:
+		return OverflowPolicy(policy), nil
+	default:
+		return "", fmt.Errorf("invalid overflow policy: %s", policy)
+	}
+}
+
+type queuedUpdate struct {
+	res      *transactionsv1.TransferCompleteSubscribeResponse
+	queuedAt time.Time
+}
+
+// subscriber is a TransferCompleteSubscribe stream and the updates it asked for, a nil set matches everything.
+// Updates are queued by the broadcaster and sent by the stream's handler, so a slow client only holds up itself.
+type subscriber struct {
+	conn       connect.StreamingHandlerConn
+	userIDs    map[string]bool
+	accountIDs map[string]bool
+	ledgers    map[transactionsv1.Ledger]bool
+	codes      map[transactionsv1.TransferCode]bool
+
+	policy OverflowPolicy
+	size   int
+	notify chan struct{}
+	done   chan struct{}
+
+	lock       sync.Mutex
+	queue      []*queuedUpdate
+	closed     bool
+	replayedTo int64
+	lastQueued int64
+	lastSent   int64
+	sent       uint64
+	dropped    uint64
+	coalesced  uint64
+}
+
+func newSubscriber(conn connect.StreamingHandlerConn, req *transactionsv1.TransferCompleteSubscribeRequest, policy OverflowPolicy, size int) *subscriber {
+	sub := &subscriber{
+		conn:       conn,
+		policy:     policy,
+		size:       size,
+		notify:     make(chan struct{}, 1),
+		done:       make(chan struct{}),
+		replayedTo: req.ResumeAfter,
+	}
+
+	if len(req.UserIds) > 0 {
+		sub.userIDs = make(map[string]bool)
+		for _, userID := range req.UserIds {
+			sub.userIDs[userID] = true
+		}
+	}
+	if len(req.AccountIds) > 0 {
+		sub.accountIDs = make(map[string]bool)
+		for _, accountID := range req.AccountIds {
+			sub.accountIDs[accountID] = true
+		}
+	}
+	if len(req.Ledgers) > 0 {
+		sub.ledgers = make(map[transactionsv1.Ledger]bool)
+		for _, ledger := range req.Ledgers {
+			sub.ledgers[ledger] = true
+		}
+	}
+	if len(req.Codes) > 0 {
+		sub.codes = make(map[transactionsv1.TransferCode]bool)
+		for _, code := range req.Codes {
+			sub.codes[code] = true
+		}
+	}
+
+	return sub
+}
+
+// matches returns true if the update passes every filter of the subscriber
+func (s *subscriber) matches(res *transactionsv1.TransferCompleteSubscribeResponse) bool {
+	account := res.Account
+	if account == nil {
+		return s.userIDs == nil && s.accountIDs == nil && s.ledgers == nil && s.codes == nil
+	}
+
+	if s.userIDs != nil && !s.userIDs[account.UserId] {
+		return false
+	}
+	if s.accountIDs != nil && !s.accountIDs[account.Id] {
+		return false
+	}
+	if s.ledgers != nil && !s.ledgers[account.Ledger] {
+		return false
+	}
+	if s.codes != nil {
+		switch {
+		case res.Transaction != nil:
+			return s.codes[res.Transaction.Code]
+		case res.PendingTransfer != nil:
+			return s.codes[res.PendingTransfer.Code]
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// enqueue queues an update without blocking, applying the overflow policy when the queue is full
+func (s *subscriber) enqueue(res *transactionsv1.TransferCompleteSubscribeResponse) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.closed {
+		return
+	}
+
+	if len(s.queue) >= s.size {
+		switch s.policy {
+		case OverflowDropOldest:
+			s.queue = s.queue[1:]
+			s.dropped++
+		case OverflowCoalesce:
+			s.coalesce()
+			if len(s.queue) >= s.size {
+				s.queue = s.queue[1:]
+				s.dropped++
+			}
+		default:
+			s.closed = true
+			s.queue = nil
+			close(s.done)
+			return
+		}
+	}
+
+	s.queue = append(s.queue, &queuedUpdate{res: res, queuedAt: time.Now()})
+	if res.Transaction != nil && res.Transaction.Sequence > s.lastQueued {
+		s.lastQueued = res.Transaction.Sequence
+	}
+
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+// coalesce keeps the latest queued update of each account, in the order they were queued
+func (s *subscriber) coalesce() {
+	seen := map[string]bool{}
+	kept := 0
+	for i := len(s.queue) - 1; i >= 0; i-- {
+		update := s.queue[i]
+		if update.res.Account != nil {
+			if seen[update.res.Account.Id] {
+				continue
+			}
+			seen[update.res.Account.Id] = true
+		}
+		kept++
+		s.queue[len(s.queue)-kept] = update
+	}
+
+	s.coalesced += uint64(len(s.queue) - kept)
+	s.queue = s.queue[len(s.queue)-kept:]
+}
+
+// write sends everything queued, skipping the transfers the replay already sent
+func (s *subscriber) write() error {
+	s.lock.Lock()
+	queue := s.queue
+	s.queue = nil
+	s.lock.Unlock()
+
+	for _, update := range queue {
+		if s.replayed(update.res) {
+			continue
+		}
+		err := s.conn.Send(update.res)
+		if err != nil {
+			return err
+		}
+		s.sentUpdate(update.res)
+	}
+
+	return nil
+}
+
+func (s *subscriber) sentUpdate(res *transactionsv1.TransferCompleteSubscribeResponse) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.sent++
+	if res.Transaction != nil && res.Transaction.Sequence > s.lastSent {
+		s.lastSent = res.Transaction.Sequence
+	}
+}
+
+// replayed returns true if the update's transfer was already sent by the replay
+func (s *subscriber) replayed(res *transactionsv1.TransferCompleteSubscribeResponse) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return res.Transaction != nil && res.Transaction.Sequence <= s.replayedTo
+}
+
+// replay sends the transfers after the resume sequence up to the watermark, live updates queue up meanwhile and are sent after.
+// Transfers past the watermark may still be waiting on earlier ones, they are sent live once it passes them.
+func (t *Transactor) replay(sub *subscriber) error {
+	watermark, err := t.sequencer.current()
+	if err != nil {
+		return err
+	}
+	replayedTo := sub.replayedTo
+	if watermark <= replayedTo {
+		return nil
+	}
+
+	updates, err := t.Storage.TransfersReplay(replayedTo, watermark, maxReplayTransfers)
+	if err != nil {
+		return err
+	}
+
+	for _, res := range updates {
+		if !sub.matches(res) {
+			continue
+		}
+		err = sub.conn.Send(res)
+		if err != nil {
+			return err
+		}
+		sub.sentUpdate(res)
+	}
+
+	sub.lock.Lock()
+	sub.replayedTo = watermark
+	sub.lock.Unlock()
+
+	return nil
+}
+
+// SubscriberMetrics is how far behind a subscriber is
+type SubscriberMetrics struct {
+	Policy OverflowPolicy `json:"policy"`
+	// Queued is the number of updates waiting to be sent
+	Queued int `json:"queued"`
+	// LagSeconds is the age of the oldest queued update
+	LagSeconds float64 `json:"lag_seconds"`
+	// LagSequence is how far the sequence of the last transfer sent is behind the last one queued
+	LagSequence int64  `json:"lag_sequence"`
+	Sent        uint64 `json:"sent"`
+	Dropped     uint64 `json:"dropped"`
+	Coalesced   uint64 `json:"coalesced"`
+}
+
+// SubscriberMetrics returns the lag of every subscriber by client id
+func (t *Transactor) SubscriberMetrics() interface{} {
+	metrics := map[string]*SubscriberMetrics{}
+
+	t.clients.Range(func(key string, sub *subscriber) bool {
+		sub.lock.Lock()
+		defer sub.lock.Unlock()
+
+		m := &SubscriberMetrics{
+			Policy:    sub.policy,
+			Queued:    len(sub.queue),
+			Sent:      sub.sent,
+			Dropped:   sub.dropped,
+			Coalesced: sub.coalesced,
+		}
+		if len(sub.queue) > 0 {
+			m.LagSeconds = time.Since(sub.queue[0].queuedAt).Seconds()
+		}
+		if sub.lastQueued > sub.lastSent {
+			m.LagSequence = sub.lastQueued - sub.lastSent
+		}
+		metrics[key] = m
+		return true
+	})
+
+	return metrics
+}
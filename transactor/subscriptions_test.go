@@ -0,0 +1,161 @@
+package transactor
+
+import (
+	"github.com/bufbuild/connect-go"
+	"testing"
+	"xsyn-transactions/gen/transactions/v1"
+)
+
+// testStream records what is sent to a subscriber
+type testStream struct {
+	connect.StreamingHandlerConn
+	sent []*transactionsv1.TransferCompleteSubscribeResponse
+}
+
+func (s *testStream) Send(msg interface{}) error {
+	s.sent = append(s.sent, msg.(*transactionsv1.TransferCompleteSubscribeResponse))
+	return nil
+}
+
+func accountUpdate(userID string, ledger transactionsv1.Ledger, code transactionsv1.TransferCode) *transactionsv1.TransferCompleteSubscribeResponse {
+	return &transactionsv1.TransferCompleteSubscribeResponse{
+		Account:     &transactionsv1.Account{Id: userID + "-account", UserId: userID, Ledger: ledger},
+		Transaction: &transactionsv1.CompletedTransfer{Code: code},
+	}
+}
+
+func TestSubscriberMatches(t *testing.T) {
+	deposit := accountUpdate("alice", transactionsv1.Ledger_SUPS, transactionsv1.TransferCode_Deposit)
+	reserve := &transactionsv1.TransferCompleteSubscribeResponse{
+		Account:         deposit.Account,
+		PendingTransfer: &transactionsv1.PendingTransfer{Code: transactionsv1.TransferCode_Withdraw},
+	}
+	accountOnly := &transactionsv1.TransferCompleteSubscribeResponse{Account: deposit.Account}
+	withoutAccount := &transactionsv1.TransferCompleteSubscribeResponse{Transaction: deposit.Transaction}
+
+	tests := []struct {
+		name    string
+		req     *transactionsv1.TransferCompleteSubscribeRequest
+		res     *transactionsv1.TransferCompleteSubscribeResponse
+		matches bool
+	}{
+		{"no filters", &transactionsv1.TransferCompleteSubscribeRequest{}, deposit, true},
+		{"no filters without an account", &transactionsv1.TransferCompleteSubscribeRequest{}, withoutAccount, true},
+		{"filtered without an account", &transactionsv1.TransferCompleteSubscribeRequest{UserIds: []string{"alice"}}, withoutAccount, false},
+		{"user", &transactionsv1.TransferCompleteSubscribeRequest{UserIds: []string{"bob", "alice"}}, deposit, true},
+		{"other user", &transactionsv1.TransferCompleteSubscribeRequest{UserIds: []string{"bob"}}, deposit, false},
+		{"account", &transactionsv1.TransferCompleteSubscribeRequest{AccountIds: []string{"alice-account"}}, deposit, true},
+		{"other account", &transactionsv1.TransferCompleteSubscribeRequest{AccountIds: []string{"bob-account"}}, deposit, false},
+		{"ledger", &transactionsv1.TransferCompleteSubscribeRequest{Ledgers: []transactionsv1.Ledger{transactionsv1.Ledger_SUPS}}, deposit, true},
+		{"other ledger", &transactionsv1.TransferCompleteSubscribeRequest{Ledgers: []transactionsv1.Ledger{9999}}, deposit, false},
+		{"code", &transactionsv1.TransferCompleteSubscribeRequest{Codes: []transactionsv1.TransferCode{transactionsv1.TransferCode_Deposit}}, deposit, true},
+		{"other code", &transactionsv1.TransferCompleteSubscribeRequest{Codes: []transactionsv1.TransferCode{transactionsv1.TransferCode_Withdraw}}, deposit, false},
+		{"code of a pending transfer", &transactionsv1.TransferCompleteSubscribeRequest{Codes: []transactionsv1.TransferCode{transactionsv1.TransferCode_Withdraw}}, reserve, true},
+		{"code without a transfer", &transactionsv1.TransferCompleteSubscribeRequest{Codes: []transactionsv1.TransferCode{transactionsv1.TransferCode_Deposit}}, accountOnly, false},
+		{"every filter", &transactionsv1.TransferCompleteSubscribeRequest{
+			UserIds:    []string{"alice"},
+			AccountIds: []string{"alice-account"},
+			Ledgers:    []transactionsv1.Ledger{transactionsv1.Ledger_SUPS},
+			Codes:      []transactionsv1.TransferCode{transactionsv1.TransferCode_Deposit},
+		}, deposit, true},
+		{"every filter but one", &transactionsv1.TransferCompleteSubscribeRequest{
+			UserIds:    []string{"alice"},
+			AccountIds: []string{"alice-account"},
+			Ledgers:    []transactionsv1.Ledger{transactionsv1.Ledger_SUPS},
+			Codes:      []transactionsv1.TransferCode{transactionsv1.TransferCode_Withdraw},
+		}, deposit, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sub := newSubscriber(nil, tt.req, OverflowDisconnect, 10)
+			if sub.matches(tt.res) != tt.matches {
+				t.Fatalf("expected matches to be %v", tt.matches)
+			}
+		})
+	}
+}
+
+func TestSubscriberSkipsReplayedTransfers(t *testing.T) {
+	stream := &testStream{}
+	sub := newSubscriber(stream, &transactionsv1.TransferCompleteSubscribeRequest{ResumeAfter: 2}, OverflowDisconnect, 10)
+
+	// the replay sent up to 4 while 3 to 6 were queued live
+	sub.replayedTo = 4
+	for seq := int64(3); seq <= 6; seq++ {
+		sub.enqueue(sequenced(seq))
+	}
+	// updates without a transfer are never replayed
+	sub.enqueue(&transactionsv1.TransferCompleteSubscribeResponse{Account: &transactionsv1.Account{Id: "account"}})
+
+	err := sub.write()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sent := []int64{}
+	for _, res := range stream.sent {
+		sent = append(sent, res.GetTransaction().GetSequence())
+	}
+	if !equalSeqs(sent, []int64{5, 6, 0}) {
+		t.Fatalf("expected 5, 6 and the account update sent, got %v", sent)
+	}
+	if sub.lastSent != 6 || sub.sent != 3 {
+		t.Fatalf("expected 3 sent up to 6, got %d up to %d", sub.sent, sub.lastSent)
+	}
+}
+
+func TestSubscriberOverflow(t *testing.T) {
+	update := func(accountID string, seq int64) *transactionsv1.TransferCompleteSubscribeResponse {
+		return &transactionsv1.TransferCompleteSubscribeResponse{
+			Account:     &transactionsv1.Account{Id: accountID},
+			Transaction: &transactionsv1.CompletedTransfer{Sequence: seq},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		policy    OverflowPolicy
+		updates   []*transactionsv1.TransferCompleteSubscribeResponse
+		queued    []int64
+		closed    bool
+		dropped   uint64
+		coalesced uint64
+	}{
+		{"room left", OverflowDisconnect, []*transactionsv1.TransferCompleteSubscribeResponse{update("a", 1), update("b", 2), update("c", 3)}, []int64{1, 2, 3}, false, 0, 0},
+		{"disconnect", OverflowDisconnect, []*transactionsv1.TransferCompleteSubscribeResponse{update("a", 1), update("b", 2), update("c", 3), update("d", 4), update("e", 5)}, []int64{}, true, 0, 0},
+		{"drop oldest", OverflowDropOldest, []*transactionsv1.TransferCompleteSubscribeResponse{update("a", 1), update("b", 2), update("c", 3), update("d", 4), update("e", 5)}, []int64{3, 4, 5}, false, 2, 0},
+		{"coalesce an account", OverflowCoalesce, []*transactionsv1.TransferCompleteSubscribeResponse{update("a", 1), update("b", 2), update("a", 3), update("c", 4)}, []int64{2, 3, 4}, false, 0, 1},
+		{"coalesce every account", OverflowCoalesce, []*transactionsv1.TransferCompleteSubscribeResponse{update("a", 1), update("a", 2), update("a", 3), update("b", 4)}, []int64{3, 4}, false, 0, 2},
+		{"coalesce distinct accounts drops oldest", OverflowCoalesce, []*transactionsv1.TransferCompleteSubscribeResponse{update("a", 1), update("b", 2), update("c", 3), update("d", 4)}, []int64{2, 3, 4}, false, 1, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sub := newSubscriber(nil, &transactionsv1.TransferCompleteSubscribeRequest{}, tt.policy, 3)
+			for _, res := range tt.updates {
+				sub.enqueue(res)
+			}
+
+			queued := []int64{}
+			for _, update := range sub.queue {
+				queued = append(queued, update.res.Transaction.Sequence)
+			}
+			if !equalSeqs(queued, tt.queued) {
+				t.Fatalf("expected %v queued, got %v", tt.queued, queued)
+			}
+			if sub.dropped != tt.dropped || sub.coalesced != tt.coalesced {
+				t.Fatalf("expected %d dropped and %d coalesced, got %d and %d", tt.dropped, tt.coalesced, sub.dropped, sub.coalesced)
+			}
+
+			select {
+			case <-sub.done:
+				if !tt.closed {
+					t.Fatal("subscriber was disconnected")
+				}
+			default:
+				if tt.closed {
+					t.Fatal("subscriber wasn't disconnected")
+				}
+			}
+		})
+	}
+}
@@ -2,81 +2,191 @@ package transactor
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"fmt"
 	"github.com/bufbuild/connect-go"
 	"github.com/gofrs/uuid"
 	"github.com/shopspring/decimal"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
-	"sync"
+	"google.golang.org/protobuf/types/known/structpb"
+	"time"
 	"xsyn-transactions/boiler"
 	"xsyn-transactions/gen/transactions/v1"
+	"xsyn-transactions/storage"
 )
 
 // Transact makes a transaction using user id and ledger code
+// If an idempotency key is given, a retry of the same request returns the original transfer instead of making a new one
 func (t *Transactor) Transact(ctx context.Context, req *connect.Request[transactionsv1.TransactRequest]) (*connect.Response[transactionsv1.TransactResponse], error) {
-	creditorAccount, err := t.get(req.Msg.CreditUserId, req.Msg.Ledger)
+	if !t.isLeader() {
+		leader, err := t.leaderFor(req.Header())
+		if err != nil {
+			return nil, err
+		}
+		return leader.transactor.Transact(ctx, forwardRequest(req))
+	}
+
+	if req.Msg.IdempotencyKey != "" {
+		tx, err := t.idempotentTransfer(ctx, req.Msg)
+		if err != nil {
+			return nil, err
+		}
+		if tx != nil {
+			return connect.NewResponse[transactionsv1.TransactResponse](&transactionsv1.TransactResponse{Transfer: tx}), nil
+		}
+	}
+
+	nt, err := t.newTransaction(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := t.transact(ctx, nt)
+	if err != nil {
+		// a concurrent request with the same key may have committed first
+		if req.Msg.IdempotencyKey != "" {
+			replayedTx, replayErr := t.idempotentTransfer(ctx, req.Msg)
+			if replayErr != nil {
+				return nil, replayErr
+			}
+			if replayedTx != nil {
+				return connect.NewResponse[transactionsv1.TransactResponse](&transactionsv1.TransactResponse{Transfer: replayedTx}), nil
+			}
+		}
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse[transactionsv1.TransactResponse](&transactionsv1.TransactResponse{Transfer: tx}), nil
+}
+
+// TransactBatch makes multiple transactions that are committed all or nothing
+func (t *Transactor) TransactBatch(ctx context.Context, req *connect.Request[transactionsv1.TransactBatchRequest]) (*connect.Response[transactionsv1.TransactBatchResponse], error) {
+	if !t.isLeader() {
+		leader, err := t.leaderFor(req.Header())
+		if err != nil {
+			return nil, err
+		}
+		return leader.transactor.TransactBatch(ctx, forwardRequest(req))
+	}
+
+	if len(req.Msg.Transfers) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("transfers is empty"))
+	}
+
+	nts := []*NewTransaction{}
+	for _, transfer := range req.Msg.Transfers {
+		nt, err := t.newTransaction(ctx, transfer)
+		if err != nil {
+			return nil, err
+		}
+		nts = append(nts, nt)
+	}
+
+	txs, err := t.transactBatch(ctx, nts)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse[transactionsv1.TransactBatchResponse](&transactionsv1.TransactBatchResponse{Transfers: txs}), nil
+}
+
+// newTransaction resolves the accounts of a transact request the client is allowed to make, creating the creditor account if it doesn't exist
+func (t *Transactor) newTransaction(ctx context.Context, req *transactionsv1.TransactRequest) (*NewTransaction, error) {
+	debitorAccount, err := t.get(req.DebitUserId, req.Ledger)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	err = t.authorizeTransfer(ctx, req.Code, req.Ledger, debitorAccount)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	creditorAccount, err := t.get(req.CreditUserId, req.Ledger)
 	if err != nil {
 		if errors.Is(err, ErrUnableToFindAccount) {
-			err = t.Storage.CreateAccount(req.Msg.CreditUserId, transactionsv1.AccountCode_AccountUser, req.Msg.Ledger)
+			err = t.Storage.CreateAccount(req.CreditUserId, transactionsv1.AccountCode_AccountUser, req.Ledger)
 			if err != nil {
-				return nil, connect.NewError(connect.CodeInternal, err)
+				return nil, connectError(err)
 			}
-			creditorAccount, err = t.get(req.Msg.CreditUserId, req.Msg.Ledger)
+			creditorAccount, err = t.get(req.CreditUserId, req.Ledger)
 			if err != nil {
-				return nil, connect.NewError(connect.CodeInternal, err)
+				return nil, connectError(err)
 			}
 		} else {
-			return nil, connect.NewError(connect.CodeInternal, err)
+			return nil, connectError(err)
 		}
 	}
-	debitorAccount, err := t.get(req.Msg.DebitUserId, req.Msg.Ledger)
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
-	}
 
-	amount, err := decimal.NewFromString(req.Msg.Amount)
+	err = checkFrozen(debitorAccount, creditorAccount)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, err
 	}
 
-	tx, err := t.transact(&NewTransaction{
-		CreditUserID:    req.Msg.CreditUserId,
-		CreditAccountID: creditorAccount.Id,
-		DebitAccountID:  debitorAccount.Id,
-		DebitUserID:     req.Msg.DebitUserId,
-		Amount:          amount,
-		Ledger:          req.Msg.Ledger,
-		TransferCode:    req.Msg.Code,
-	})
+	amount, err := decimal.NewFromString(req.Amount)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, invalidAmount(err)
 	}
 
-	return connect.NewResponse[transactionsv1.TransactResponse](&transactionsv1.TransactResponse{Transfer: tx}), nil
+	return &NewTransaction{
+		CreditUserID:     req.CreditUserId,
+		CreditAccountID:  creditorAccount.Id,
+		DebitAccountID:   debitorAccount.Id,
+		DebitUserID:      req.DebitUserId,
+		Amount:           amount,
+		Ledger:           req.Ledger,
+		TransferCode:     req.Code,
+		IdempotencyKey:   req.IdempotencyKey,
+		IdempotencyScope: idempotencyScope(ctx),
+		RequestHash:      transactRequestHash(req),
+		InitiatorID:      initiatorID(ctx),
+		ReferenceID:      req.ReferenceId,
+		Metadata:         req.Metadata,
+	}, nil
 }
 
 // TransactWithID makes a transaction using user id and ledger code but takes a pre-generated tx id
 func (t *Transactor) TransactWithID(ctx context.Context, req *connect.Request[transactionsv1.TransactWithIDRequest]) (*connect.Response[transactionsv1.TransactWithIDResponse], error) {
+	if !t.isLeader() {
+		leader, err := t.leaderFor(req.Header())
+		if err != nil {
+			return nil, err
+		}
+		return leader.transactor.TransactWithID(ctx, forwardRequest(req))
+	}
+
 	creditorAccount, err := t.get(req.Msg.CreditUserId, req.Msg.Ledger)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, connectError(err)
 	}
 	debitorAccount, err := t.get(req.Msg.DebitUserId, req.Msg.Ledger)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, connectError(err)
+	}
+
+	err = t.authorizeTransfer(ctx, req.Msg.Code, req.Msg.Ledger, debitorAccount)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	err = checkFrozen(debitorAccount, creditorAccount)
+	if err != nil {
+		return nil, err
 	}
 
 	amount, err := decimal.NewFromString(req.Msg.Amount)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, invalidAmount(err)
 	}
 
 	uid, err := uuid.FromString(req.Msg.TxId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, invalidID(err)
 	}
 
-	tx, err := t.transact(&NewTransaction{
+	tx, err := t.transact(ctx, &NewTransaction{
 		ID:              uid,
 		CreditUserID:    req.Msg.CreditUserId,
 		CreditAccountID: creditorAccount.Id,
@@ -85,9 +195,12 @@ func (t *Transactor) TransactWithID(ctx context.Context, req *connect.Request[tr
 		Amount:          amount,
 		Ledger:          req.Msg.Ledger,
 		TransferCode:    req.Msg.Code,
+		InitiatorID:     initiatorID(ctx),
+		ReferenceID:     req.Msg.ReferenceId,
+		Metadata:        req.Msg.Metadata,
 	})
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, connectError(err)
 	}
 
 	return connect.NewResponse[transactionsv1.TransactWithIDResponse](&transactionsv1.TransactWithIDResponse{Transfer: tx}), nil
@@ -102,19 +215,38 @@ type NewTransaction struct {
 	Amount          decimal.Decimal
 	Ledger          transactionsv1.Ledger
 	TransferCode    transactionsv1.TransferCode
+	IdempotencyKey  string
+	// IdempotencyScope is the client the idempotency key belongs to
+	IdempotencyScope string
+	RequestHash      string
+	// ParentTransactionID is the transaction this transaction refunds
+	ParentTransactionID string
+	// InitiatorID is the api client that made the transfer
+	InitiatorID string
+	ReferenceID string
+	Metadata    *structpb.Struct
 }
 
-func (t *Transactor) transact(nt *NewTransaction) (*transactionsv1.CompletedTransfer, error) {
-	var transactionError error = nil
-	var completedTx *transactionsv1.CompletedTransfer = nil
-	transactionID := uuid.Must(uuid.NewV4())
-	if !nt.ID.IsNil() {
-		transactionID = nt.ID
+func (t *Transactor) transact(ctx context.Context, nt *NewTransaction) (*transactionsv1.CompletedTransfer, error) {
+	completedTxs, err := t.transactBatch(ctx, []*NewTransaction{nt})
+	if err != nil {
+		return nil, err
 	}
 
-	wg := sync.WaitGroup{}
-	wg.Add(1)
-	fn := func() error {
+	return completedTxs[0], nil
+}
+
+// transactBatch inserts all the transactions in a single db transaction, the balances are only updated once they all commit
+func (t *Transactor) transactBatch(ctx context.Context, nts []*NewTransaction) ([]*transactionsv1.CompletedTransfer, error) {
+	var completedTxs []*transactionsv1.CompletedTransfer = nil
+
+	txs := []*boiler.Transaction{}
+	for _, nt := range nts {
+		transactionID := uuid.Must(uuid.NewV4())
+		if !nt.ID.IsNil() {
+			transactionID = nt.ID
+		}
+
 		tx := &boiler.Transaction{
 			ID:              transactionID.String(),
 			CreditAccountID: nt.CreditAccountID,
@@ -123,87 +255,257 @@ func (t *Transactor) transact(nt *NewTransaction) (*transactionsv1.CompletedTran
 			TransferCode:    int(nt.TransferCode),
 			Ledger:          int(nt.Ledger),
 		}
+		if nt.ParentTransactionID != "" {
+			tx.ParentTransactionID = null.StringFrom(nt.ParentTransactionID)
+		}
+		if nt.InitiatorID != "" {
+			tx.InitiatorID = null.StringFrom(nt.InitiatorID)
+		}
+		if nt.ReferenceID != "" {
+			tx.ReferenceID = null.StringFrom(nt.ReferenceID)
+		}
+		metadata, err := storage.MetadataToJSON(nt.Metadata)
+		if err != nil {
+			return nil, err
+		}
+		tx.Metadata = metadata
+		txs = append(txs, tx)
+	}
+
+	accountIDs := []string{}
+	for _, tx := range txs {
+		accountIDs = append(accountIDs, tx.DebitAccountID, tx.CreditAccountID)
+	}
 
-		transactionError = tx.Insert(t.Storage, boil.Infer())
-		if transactionError != nil {
+	err := t.write(ctx, accountIDs, func() error {
+		if t.groupCommitSize > 1 {
+			transfers, err := t.groupCommit(ctx, nts, txs)
+			if err != nil {
+				return err
+			}
+			completedTxs = transfers
+			return nil
+		}
+
+		dbTx, err := t.Storage.Begin()
+		if err != nil {
+			t.log.Error().Err(err).Msg("failed to begin transaction")
+			return err
+		}
+		defer dbTx.Rollback()
+
+		transfers, err := t.insertTransactions(dbTx, nts, txs)
+		if err != nil {
+			return err
+		}
+
+		err = t.commit(dbTx, txs, transfers, func() {
+			for _, transfer := range transfers {
+				t.balanceUpdate(transfer)
+			}
+		})
+		if err != nil {
+			t.log.Error().Err(err).Int("transactions", len(txs)).Msg("failed to commit transactions")
+			return err
+		}
+
+		t.logTransactions(txs)
+		completedTxs = transfers
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return completedTxs, nil
+}
+
+// insertTransactions inserts the transactions and their idempotency keys, returning the transfers to commit
+func (t *Transactor) insertTransactions(dbTx *sql.Tx, nts []*NewTransaction, txs []*boiler.Transaction) ([]*transactionsv1.CompletedTransfer, error) {
+	for _, tx := range txs {
+		err := tx.Insert(dbTx, boil.Infer())
+		if err != nil {
 			t.log.Error().
-				Err(transactionError).
+				Err(err).
 				Str("from", tx.DebitAccountID).
 				Str("to", tx.CreditAccountID).
 				Str("id", tx.ID).
 				Str("amount", tx.Amount.String()).
 				Msg("transaction failed")
-			wg.Done()
-			return transactionError
+			return nil, err
 		}
+	}
 
-		t.log.Info().Str("fromAccount", tx.DebitAccountID).Str("toAccount", tx.CreditAccountID).Int("ledger", tx.Ledger).Int("transferCode", tx.TransferCode).Str("amount", tx.Amount.String()).Msg("successful transaction")
-
-		completedTx = &transactionsv1.CompletedTransfer{
-			Id:              tx.ID,
-			CreditUserId:    nt.CreditUserID,
-			CreditAccountId: nt.CreditAccountID,
-			DebitUserId:     nt.DebitUserID,
-			DebitAccountId:  nt.DebitAccountID,
-			Amount:          nt.Amount.String(),
-			Ledger:          nt.Ledger,
-			Code:            nt.TransferCode,
-			Timestamp:       tx.CreatedAt.Unix(),
+	for i, nt := range nts {
+		if nt.IdempotencyKey == "" {
+			continue
 		}
 
-		t.balanceUpdate(completedTx)
-		wg.Done()
-		return nil
+		idempotencyKey := &boiler.IdempotencyKey{
+			ClientID:      nt.IdempotencyScope,
+			Key:           nt.IdempotencyKey,
+			RequestHash:   nt.RequestHash,
+			TransactionID: txs[i].ID,
+		}
+		err := idempotencyKey.Insert(dbTx, boil.Infer())
+		if err != nil {
+			t.log.Error().Err(err).Str("idempotencyKey", nt.IdempotencyKey).Str("id", txs[i].ID).Msg("failed to store idempotency key")
+			return nil, err
+		}
 	}
-	select {
-	case t.runner <- fn: //put in channel
-	default: //unless it's full!
-		t.log.Error().Msg("Transaction queue is blocked! 100 transactions waiting to be processed.")
-		return completedTx, ErrQueueFull
+
+	transfers := []*transactionsv1.CompletedTransfer{}
+	for i, tx := range txs {
+		transfer := &transactionsv1.CompletedTransfer{
+			Id:                  tx.ID,
+			CreditUserId:        nts[i].CreditUserID,
+			CreditAccountId:     nts[i].CreditAccountID,
+			DebitUserId:         nts[i].DebitUserID,
+			DebitAccountId:      nts[i].DebitAccountID,
+			Amount:              nts[i].Amount.String(),
+			Ledger:              nts[i].Ledger,
+			Code:                nts[i].TransferCode,
+			Timestamp:           tx.CreatedAt.Unix(),
+			ParentTransactionId: nts[i].ParentTransactionID,
+			InitiatorId:         nts[i].InitiatorID,
+			ReferenceId:         nts[i].ReferenceID,
+			Metadata:            nts[i].Metadata,
+		}
+		transfers = append(transfers, transfer)
+
+		err := t.notifyChange(dbTx, &change{Kind: changeTransfer, TransactionID: tx.ID})
+		if err != nil {
+			t.log.Error().Err(err).Str("id", tx.ID).Msg("failed to notify replicas")
+			return nil, err
+		}
 	}
-	wg.Wait()
 
-	return completedTx, transactionError
+	return transfers, nil
+}
+
+// logTransactions logs committed transactions
+func (t *Transactor) logTransactions(txs []*boiler.Transaction) {
+	for _, tx := range txs {
+		t.log.Info().Str("fromAccount", tx.DebitAccountID).Str("toAccount", tx.CreditAccountID).Int("ledger", tx.Ledger).Int("transferCode", tx.TransferCode).Str("amount", tx.Amount.String()).Msg("successful transaction")
+	}
 }
 
-func (t *Transactor) Close() {
-	wg := sync.WaitGroup{}
-	wg.Add(1)
+// commit numbers the transfers, writes their outbox events and commits them. Only taking the numbers is serialized,
+// writes to other accounts commit in parallel and the sequencer applies them in number order once they have.
+func (t *Transactor) commit(dbTx *sql.Tx, txs []*boiler.Transaction, transfers []*transactionsv1.CompletedTransfer, apply func()) error {
+	batch, err := t.sequencer.reserve(dbTx, len(txs))
+	if err != nil {
+		t.log.Error().Err(err).Msg("failed to number transactions")
+		return err
+	}
+	committed := false
+	defer func() {
+		// the numbers are skipped, so later transfers aren't held up waiting for them
+		if !committed {
+			t.sequencer.finish(batch, nil)
+		}
+	}()
+
+	for i, tx := range txs {
+		tx.Seq = batch.seqs[i]
+		err := t.Storage.TransactionSequence(dbTx, tx)
+		if err != nil {
+			t.log.Error().Err(err).Str("id", tx.ID).Msg("failed to number transaction")
+			return err
+		}
+		transfers[i].Sequence = tx.Seq
+
+		err = t.Storage.OutboxEventInsert(dbTx, transfers[i])
+		if err != nil {
+			t.log.Error().Err(err).Str("id", tx.ID).Msg("failed to write outbox event")
+			return err
+		}
+	}
 
-	fn := func() error {
-		wg.Done()
-		return ErrTimeToClose
+	err = storage.LeaderFence(dbTx, t.leaderEpoch.Load())
+	if err != nil {
+		t.log.Warn().Err(err).Msg("write fenced off, this replica lost leadership")
+		return err
 	}
 
-	select {
-	case t.runner <- fn: //queue close
-	default: //unless it's full!
-		t.log.Error().Msg("Transaction queue is blocked! Exiting.")
-		return
+	err = dbTx.Commit()
+	if err != nil {
+		return err
 	}
-	wg.Wait()
+	committed = true
+
+	t.sequencer.finish(batch, apply)
+	return nil
 }
 
 func (t *Transactor) TransactionGetByID(ctx context.Context, req *connect.Request[transactionsv1.TransactionGetByIDRequest]) (*connect.Response[transactionsv1.TransactionGetByIDResponse], error) {
 	transaction, err := t.Storage.TransactionGetByID(req.Msg.TransactionId)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, connectError(err)
+	}
+
+	refunds, err := t.Storage.TransactionRefunds(req.Msg.TransactionId)
+	if err != nil {
+		return nil, connectError(err)
 	}
 
-	return connect.NewResponse[transactionsv1.TransactionGetByIDResponse](&transactionsv1.TransactionGetByIDResponse{Transaction: transaction}), nil
+	return connect.NewResponse[transactionsv1.TransactionGetByIDResponse](&transactionsv1.TransactionGetByIDResponse{Transaction: transaction, Refunds: refunds}), nil
 }
 
 func (t *Transactor) TransactionsGetByAccountID(ctx context.Context, req *connect.Request[transactionsv1.TransactionsGetByAccountIDRequest]) (*connect.Response[transactionsv1.TransactionsGetByAccountIDResponse], error) {
-	total, transactions, err := t.Storage.TransactionsGetByAccountID(
-		req.Msg.AccountId,
-		int(req.Msg.Offset),
-		int(req.Msg.PageSize),
-		req.Msg.SortBy,
-		req.Msg.SortDir,
-	)
+	q := &storage.TransactionsQuery{
+		AccountID:             req.Msg.AccountId,
+		Codes:                 req.Msg.Codes,
+		Direction:             req.Msg.Direction,
+		CounterpartyAccountID: req.Msg.CounterpartyAccountId,
+		PageToken:             req.Msg.PageToken,
+		Offset:                int(req.Msg.Offset),
+		PageSize:              int(req.Msg.PageSize),
+		SortBy:                req.Msg.SortBy,
+		SortDir:               req.Msg.SortDir,
+		SkipTotal:             req.Msg.SkipTotal,
+		InitiatorID:           req.Msg.InitiatorId,
+		ReferenceID:           req.Msg.ReferenceId,
+	}
+	if q.PageSize == 0 {
+		q.PageSize = defaultPageSize
+	}
+	if len(req.Msg.Metadata.GetFields()) > 0 {
+		metadata, err := storage.MetadataToJSON(req.Msg.Metadata)
+		if err != nil {
+			return nil, connectError(err)
+		}
+		q.MetadataContains = metadata
+	}
+	if req.Msg.From > 0 {
+		q.From = time.Unix(req.Msg.From, 0)
+	}
+	if req.Msg.To > 0 {
+		q.To = time.Unix(req.Msg.To, 0)
+	}
+	if req.Msg.AmountMin != "" {
+		amount, err := decimal.NewFromString(req.Msg.AmountMin)
+		if err != nil {
+			return nil, invalidAmount(fmt.Errorf("amount min: %w", err))
+		}
+		q.AmountMin = decimal.NewNullDecimal(amount)
+	}
+	if req.Msg.AmountMax != "" {
+		amount, err := decimal.NewFromString(req.Msg.AmountMax)
+		if err != nil {
+			return nil, invalidAmount(fmt.Errorf("amount max: %w", err))
+		}
+		q.AmountMax = decimal.NewNullDecimal(amount)
+	}
+
+	total, transactions, nextPageToken, err := t.Storage.TransactionsGetByAccountID(q)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, connectError(err)
 	}
 
-	return connect.NewResponse[transactionsv1.TransactionsGetByAccountIDResponse](&transactionsv1.TransactionsGetByAccountIDResponse{Transactions: transactions, Total: total}), nil
+	return connect.NewResponse[transactionsv1.TransactionsGetByAccountIDResponse](&transactionsv1.TransactionsGetByAccountIDResponse{
+		Transactions:  transactions,
+		Total:         total,
+		NextPageToken: nextPageToken,
+	}), nil
 }
@@ -2,46 +2,134 @@ package transactor
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"github.com/bufbuild/connect-go"
+	"github.com/gofrs/uuid"
 	"github.com/puzpuzpuz/xsync"
 	"github.com/rs/zerolog"
 	"github.com/sasha-s/go-deadlock"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
 	"xsyn-transactions/gen/transactions/v1"
 	"xsyn-transactions/storage"
 )
 
-var ErrTimeToClose = fmt.Errorf("closing")
+var ErrTimeToClose = fmt.Errorf("transactor is closing")
 var ErrQueueFull = fmt.Errorf("transaction queue is full")
 var ErrUnableToFindAccount = fmt.Errorf("unable to find account")
+var ErrUnableToFindPendingTransfer = fmt.Errorf("unable to find pending transfer")
+var ErrPendingTransferResolved = storage.ErrPendingTransferResolved
+var ErrPendingTransferExpired = fmt.Errorf("pending transfer has expired")
+
+// how often pending transfers are checked for expiry
+const pendingTransferExpiryInterval = 10 * time.Second
+
+// the default time a pending transfer holds funds for
+const defaultPendingTransferTimeout = 10 * time.Minute
+
+// the longest a pending transfer can hold funds for
+const maxPendingTransferTimeout = 7 * 24 * time.Hour
+
+// the default time between balance snapshots
+const defaultBalanceSnapshotInterval = time.Hour
+
+// how far behind now a balance snapshot is taken
+const balanceSnapshotSettleTime = time.Minute
 
 type Transactor struct {
 	Storage     *storage.Storage
+	instanceID  string
 	log         *zerolog.Logger
-	runner      chan func() error
 	broadcaster chan *transactionsv1.TransferCompleteSubscribeResponse
+	// closed once every broadcast has been queued on the subscribers, after the transactor closes
+	broadcastDone chan struct{}
+
+	// cancelled when the transactor closes, stopping the background loops
+	ctx  context.Context
+	stop context.CancelFunc
+
+	// writes hold the shards of the accounts they touch, see writer.go
+	writeShards *writeShards
+	writeSlots  chan struct{}
+	closed      atomic.Bool
+	// numbers transfers and sends their updates in sequence order, see sequencer.go
+	sequencer *sequencer
+	// signalled when the watermark moves, so the leader tells the followers
+	watermarkMoved chan struct{}
+
+	// transfers are committed in groups when groupCommitSize is more than 1, see group_commit.go
+	groupCommitSize   int
+	groupCommitWindow time.Duration
+	groupCommits      chan *groupTransfer
+	// closed once the group committer has committed the last group, after groupCommits closes
+	groupCommitsDone chan struct{}
 
 	userMap     map[string]map[transactionsv1.Ledger]*transactionsv1.Account // map[user_id]map[currency]account
 	userMapLock deadlock.RWMutex
 
+	pendingTransferTimeout  time.Duration
+	balanceSnapshotInterval time.Duration
+	reconcileInterval       time.Duration
+	reconcileRepairCache    bool
+
+	subscriberQueueSize      int
+	subscriberOverflowPolicy OverflowPolicy
+
+	// only the leader writes, followers forward writes to it
+	leader           atomic.Bool
+	leaderEpoch      atomic.Int64
+	advertiseAddress string
+	forwardClient    *http.Client
+	forwardOptions   []connect.ClientOption
+	leaderLock       sync.RWMutex
+	leaderClients    *leaderClients
+
+	// the clients that can call the api by key hash, reloaded whenever a client changes, see clients.go
+	clientKeys atomic.Pointer[map[string]clientKey]
+
 	// We use this cool package, meant to be faster than using mutex locks to ensure concurrency safeness
 	// https://pkg.go.dev/github.com/puzpuzpuz/xsync#Map
-	clients *xsync.MapOf[string, connect.StreamingHandlerConn]
+	clients *xsync.MapOf[string, *subscriber]
 }
 
 type NewTransactorOpts struct {
-	StorageOpts *storage.Opts
-	Log         *zerolog.Logger
+	StorageOpts             *storage.Opts
+	Log                     *zerolog.Logger
+	PendingTransferTimeout  time.Duration
+	BalanceSnapshotInterval time.Duration
+	ReconcileInterval       time.Duration
+	ReconcileRepairCache    bool
+	// SubscriberQueueSize is how many updates a subscriber can fall behind by before SubscriberOverflowPolicy applies
+	SubscriberQueueSize      int
+	SubscriberOverflowPolicy OverflowPolicy
+	// LeaderElection makes the replicas elect one leader to write, without it this replica has to be the only one
+	LeaderElection bool
+	// AdvertiseAddress is the base url followers forward writes to when this replica leads
+	AdvertiseAddress string
+	// ForwardOptions are the client options for forwarding writes to the leader
+	ForwardOptions []connect.ClientOption
+	// WriteShards is how many shards account writes are spread over, writes in different shards commit in parallel
+	WriteShards int
+	// WriteQueueSize is how many writes can be in progress or waiting at once, later writes wait for room until their deadline
+	WriteQueueSize int
+	// GroupCommitSize is the most transfers committed in one db transaction, 1 or less commits each on its own
+	GroupCommitSize int
+	// GroupCommitWindow is how long a group waits for more transfers before it commits
+	GroupCommitWindow time.Duration
+	// LegacyAuthKey is the shared key from before per-client keys, when set a client with every permission is seeded from it
+	LegacyAuthKey string
 }
 
 func NewTransactor(opts *NewTransactorOpts) (*Transactor, error) {
 	var err error
 	txr := &Transactor{
-		runner:      make(chan func() error, 100),
-		broadcaster: make(chan *transactionsv1.TransferCompleteSubscribeResponse, 1000),
-		userMap:     make(map[string]map[transactionsv1.Ledger]*transactionsv1.Account),
-		clients:     xsync.NewMapOf[connect.StreamingHandlerConn](),
+		broadcaster:   make(chan *transactionsv1.TransferCompleteSubscribeResponse, 1000),
+		broadcastDone: make(chan struct{}),
+		userMap:       make(map[string]map[transactionsv1.Ledger]*transactionsv1.Account),
+		clients:       xsync.NewMapOf[*subscriber](),
+		instanceID:    uuid.Must(uuid.NewV4()).String(),
 	}
 
 	if opts == nil {
@@ -49,11 +137,79 @@ func NewTransactor(opts *NewTransactorOpts) (*Transactor, error) {
 	}
 
 	txr.log = opts.Log
+	txr.ctx, txr.stop = context.WithCancel(context.Background())
+	txr.writeShards = newWriteShards(opts.WriteShards)
+	writeQueueSize := opts.WriteQueueSize
+	if writeQueueSize <= 0 {
+		writeQueueSize = defaultWriteQueueSize
+	}
+	txr.writeSlots = make(chan struct{}, writeQueueSize)
+	txr.groupCommitSize = opts.GroupCommitSize
+	txr.groupCommitWindow = opts.GroupCommitWindow
+	txr.groupCommits = make(chan *groupTransfer, writeQueueSize)
+	txr.groupCommitsDone = make(chan struct{})
+
+	txr.pendingTransferTimeout = opts.PendingTransferTimeout
+	if txr.pendingTransferTimeout <= 0 {
+		txr.pendingTransferTimeout = defaultPendingTransferTimeout
+	}
+	if txr.pendingTransferTimeout > maxPendingTransferTimeout {
+		return nil, fmt.Errorf("pending transfer timeout can be at most %s", maxPendingTransferTimeout)
+	}
+
+	txr.balanceSnapshotInterval = opts.BalanceSnapshotInterval
+	if txr.balanceSnapshotInterval <= 0 {
+		txr.balanceSnapshotInterval = defaultBalanceSnapshotInterval
+	}
+
+	txr.reconcileInterval = opts.ReconcileInterval
+	if txr.reconcileInterval <= 0 {
+		txr.reconcileInterval = defaultReconcileInterval
+	}
+	txr.reconcileRepairCache = opts.ReconcileRepairCache
+
+	txr.subscriberQueueSize = opts.SubscriberQueueSize
+	if txr.subscriberQueueSize <= 0 {
+		txr.subscriberQueueSize = defaultSubscriberQueueSize
+	}
+	txr.subscriberOverflowPolicy = opts.SubscriberOverflowPolicy
+	if txr.subscriberOverflowPolicy == "" {
+		txr.subscriberOverflowPolicy = OverflowDisconnect
+	}
+
+	txr.advertiseAddress = opts.AdvertiseAddress
+	txr.forwardOptions = opts.ForwardOptions
+	txr.forwardClient = &http.Client{Timeout: 30 * time.Second}
+	if opts.LeaderElection && txr.advertiseAddress == "" {
+		return nil, fmt.Errorf("advertise address is required for leader election")
+	}
 
 	txr.Storage, err = storage.NewStorage(opts.StorageOpts)
 	if err != nil {
 		return nil, err
 	}
+	txr.watermarkMoved = make(chan struct{}, 1)
+	txr.sequencer = newSequencer(txr.Storage.TransactionSequenceNext, func(res *transactionsv1.TransferCompleteSubscribeResponse) {
+		txr.broadcaster <- res
+	}, func(watermark int64) {
+		if txr.isLeader() {
+			txr.signalWatermark()
+		}
+	})
+
+	if opts.LegacyAuthKey != "" {
+		err = txr.seedLegacyClient(opts.LegacyAuthKey)
+		if err != nil {
+			txr.log.Error().Err(err).Msg("unable to create a client from the auth key")
+			return nil, err
+		}
+	}
+
+	err = txr.loadClients()
+	if err != nil {
+		txr.log.Error().Err(err).Msg("unable to load api clients")
+		return nil, err
+	}
 
 	accounts, err := txr.Storage.GetAllAccounts()
 	if err != nil {
@@ -78,36 +234,82 @@ func NewTransactor(opts *NewTransactorOpts) (*Transactor, error) {
 	}
 	txr.userMapLock.Unlock()
 
-	go txr.run()
 	go txr.broadcast()
+	if txr.groupCommitSize > 1 {
+		go txr.runGroupCommits()
+	} else {
+		close(txr.groupCommitsDone)
+	}
+	go txr.expirePendingTransfers()
+	go txr.snapshotBalances()
+	go txr.reconcile()
+	go txr.dispatchWebhooks()
+	go txr.listenChanges()
+	go txr.listenClients()
+	go txr.notifyWatermarks()
+
+	if opts.LeaderElection {
+		go txr.elect(nil)
+	} else {
+		// a replica without election still takes the leader lock, so a second one can't write alongside it
+		lock, err := txr.leadAlone()
+		if err != nil {
+			txr.stop()
+			return nil, err
+		}
+		go txr.elect(lock)
+	}
 
 	txr.log.Info().Msg("successfully initiated transactor")
 	return txr, nil
 }
 
-func (t *Transactor) run() {
-	for {
-		select {
-		case fn := <-t.runner:
-			if fn == nil {
-				return
-			}
-			err := fn()
-			if errors.Is(err, ErrTimeToClose) {
-				return
-			}
-		}
+// Close stops taking writes, finishes the ones in progress and flushes their updates to the subscribers, whose streams then end.
+// It gives up when ctx is done. The storage is left open for the requests still being served.
+func (t *Transactor) Close(ctx context.Context) error {
+	err := t.drain(ctx)
+	if err != nil {
+		return err
+	}
+
+	// every write has finished, so nothing else hands the group committer a batch
+	close(t.groupCommits)
+	select {
+	case <-t.groupCommitsDone:
+	case <-ctx.Done():
+		return fmt.Errorf("waiting for group commits to flush: %w", ctx.Err())
+	}
+	t.stop()
+
+	// every write has finished, so nothing else sends on the broadcaster
+	close(t.broadcaster)
+	select {
+	case <-t.broadcastDone:
+	case <-ctx.Done():
+		return fmt.Errorf("waiting for broadcasts to flush: %w", ctx.Err())
+	}
+
+	t.log.Info().Msg("transactor closed")
+	return nil
+}
+
+// sleep waits for d, returning false if the transactor closes first
+func (t *Transactor) sleep(d time.Duration) bool {
+	select {
+	case <-time.After(d):
+		return true
+	case <-t.ctx.Done():
+		return false
 	}
 }
 
+// broadcast queues each update on the subscribers it matches, it never waits on a client
 func (t *Transactor) broadcast() {
+	defer close(t.broadcastDone)
 	for res := range t.broadcaster {
-		t.clients.Range(func(key string, conn connect.StreamingHandlerConn) bool {
-			err := conn.Send(res)
-			if err != nil {
-				t.clients.Delete(key) // it is safe to modify the map while iterating it
-				t.log.Error().Err(err).Msg("failed to send")
-				return true
+		t.clients.Range(func(key string, sub *subscriber) bool {
+			if sub.matches(res) {
+				sub.enqueue(res)
 			}
 			return true
 		})
@@ -120,14 +322,46 @@ func (t *Transactor) TransferCompleteSubscribe(
 	resp *connect.ServerStream[transactionsv1.TransferCompleteSubscribeResponse],
 ) error {
 	t.log.Info().Str("clientID ", req.Msg.Id).Msg("new transfer complete subscriber")
-	t.clients.Store(req.Msg.Id, resp.Conn())
+	sub := newSubscriber(resp.Conn(), req.Msg, t.subscriberOverflowPolicy, t.subscriberQueueSize)
+	t.clients.Store(req.Msg.Id, sub)
+	defer func() {
+		// a reconnect with the same id may have replaced this subscriber already
+		if current, ok := t.clients.Load(req.Msg.Id); ok && current == sub {
+			t.clients.Delete(req.Msg.Id)
+		}
+	}()
+
+	if sub.replayedTo > 0 {
+		err := t.replay(sub)
+		if err != nil {
+			t.log.Error().Err(err).Str("clientID", req.Msg.Id).Int64("resumeAfter", req.Msg.ResumeAfter).Msg("failed to replay transfers")
+			return connectError(err)
+		}
+	}
 
 	for {
 		select {
 		case <-ctx.Done():
-			t.clients.Delete(req.Msg.Id)
 			t.log.Debug().Str("clientID", req.Msg.Id).Msg("removing client")
 			return nil
+		case <-sub.done:
+			t.log.Warn().Str("clientID", req.Msg.Id).Int("queueSize", t.subscriberQueueSize).Msg("disconnecting subscriber that fell behind")
+			return connect.NewError(connect.CodeResourceExhausted, ErrSubscriberOverflow)
+		case <-t.broadcastDone:
+			// the transactor closed, send what's left then end the stream so the client reconnects elsewhere with resume_after
+			err := sub.write()
+			if err != nil {
+				t.log.Error().Err(err).Str("clientID", req.Msg.Id).Msg("failed to send")
+				return err
+			}
+			t.log.Debug().Str("clientID", req.Msg.Id).Msg("closing subscriber")
+			return connect.NewError(connect.CodeUnavailable, ErrTimeToClose)
+		case <-sub.notify:
+			err := sub.write()
+			if err != nil {
+				t.log.Error().Err(err).Str("clientID", req.Msg.Id).Msg("failed to send")
+				return err
+			}
 		}
 	}
 }
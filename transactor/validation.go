@@ -0,0 +1,422 @@
+package transactor
+
+import (
+	"context"
+	"fmt"
+	"github.com/bufbuild/connect-go"
+	"github.com/gofrs/uuid"
+	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	"net/url"
+	"xsyn-transactions/gen/transactions/v1"
+	"xsyn-transactions/storage"
+)
+
+// ErrInvalidRequest is returned when a request fails validation, the error detail lists the invalid fields
+var ErrInvalidRequest = fmt.Errorf("invalid request")
+
+// LedgerRule is how precise and how large an amount on a ledger can be
+type LedgerRule struct {
+	// Precision is the number of decimal places an amount can have, amounts are stored as NUMERIC(28) so this is 0 unless the column changes
+	Precision int32
+	// Min and Max are the smallest and largest amount of a single transfer
+	Min decimal.Decimal
+	Max decimal.Decimal
+}
+
+// the largest value a NUMERIC(28) column holds
+var maxStoredAmount = decimal.New(1, 28).Sub(decimal.NewFromInt(1))
+
+// LedgerRules are the amount rules of each ledger, a ledger without rules can't be transferred on
+var LedgerRules = map[transactionsv1.Ledger]LedgerRule{
+	transactionsv1.Ledger_SUPS: {
+		Precision: 0,
+		Min:       decimal.NewFromInt(1),
+		Max:       maxStoredAmount,
+	},
+}
+
+// amountViolation describes what is wrong with a transfer amount on the ledger, or returns an empty string if nothing is
+func amountViolation(amount decimal.Decimal, ledger transactionsv1.Ledger) string {
+	rule, ok := LedgerRules[ledger]
+	if !ok {
+		return fmt.Sprintf("ledger %s has no amount rules", ledger)
+	}
+	if !amount.Equal(amount.Truncate(rule.Precision)) {
+		return fmt.Sprintf("must have at most %d decimal places", rule.Precision)
+	}
+	if amount.LessThan(rule.Min) {
+		return fmt.Sprintf("must be at least %s", rule.Min)
+	}
+	if amount.GreaterThan(rule.Max) {
+		return fmt.Sprintf("must be at most %s", rule.Max)
+	}
+	return ""
+}
+
+// the longest reference id a transfer can have
+const maxReferenceIDLength = 255
+
+// the most bytes a transfer's metadata can take as json
+const maxMetadataSize = 16 * 1024
+
+// the most transactions a page can have, and how many it has when the request doesn't say
+const maxPageSize = 1000
+const defaultPageSize = 50
+
+// validator collects the violations of a request, so a client sees every invalid field at once
+type validator struct {
+	violations []*transactionsv1.FieldViolation
+}
+
+func (v *validator) add(field string, format string, args ...interface{}) {
+	v.violations = append(v.violations, &transactionsv1.FieldViolation{
+		Field:       field,
+		Description: fmt.Sprintf(format, args...),
+	})
+}
+
+func (v *validator) required(field string, value string) bool {
+	if value == "" {
+		v.add(field, "is required")
+		return false
+	}
+	return true
+}
+
+func (v *validator) uuid(field string, value string) {
+	if !v.required(field, value) {
+		return
+	}
+	v.optionalUUID(field, value)
+}
+
+func (v *validator) optionalUUID(field string, value string) {
+	if value == "" {
+		return
+	}
+	_, err := uuid.FromString(value)
+	if err != nil {
+		v.add(field, "must be a uuid")
+	}
+}
+
+func (v *validator) ledger(field string, ledger transactionsv1.Ledger) bool {
+	if _, ok := LedgerRules[ledger]; !ok {
+		v.add(field, "must be a ledger, got %s", ledger)
+		return false
+	}
+	return true
+}
+
+func (v *validator) transferCode(field string, code transactionsv1.TransferCode) {
+	if _, ok := transactionsv1.TransferCode_name[int32(code)]; !ok || code == transactionsv1.TransferCode_UnusedTransferCode {
+		v.add(field, "must be a transfer code, got %s", code)
+	}
+}
+
+// decimal parses a non-negative amount, the zero value is returned with ok false when it isn't one
+func (v *validator) decimal(field string, value string) (decimal.Decimal, bool) {
+	amount, err := decimal.NewFromString(value)
+	if err != nil {
+		v.add(field, "must be a number")
+		return decimal.Zero, false
+	}
+	if amount.IsNegative() {
+		v.add(field, "must not be negative")
+		return decimal.Zero, false
+	}
+	return amount, true
+}
+
+// amount checks a transfer amount against the rules of its ledger
+func (v *validator) amount(field string, value string, ledger transactionsv1.Ledger, ledgerOK bool) {
+	if !v.required(field, value) {
+		return
+	}
+	amount, ok := v.decimal(field, value)
+	if !ok || !ledgerOK {
+		return
+	}
+	if violation := amountViolation(amount, ledger); violation != "" {
+		v.add(field, "%s", violation)
+	}
+}
+
+func (v *validator) transfer(prefix string, creditUserID string, debitUserID string, code transactionsv1.TransferCode, ledger transactionsv1.Ledger, amount string) {
+	v.uuid(prefix+"credit_user_id", creditUserID)
+	v.uuid(prefix+"debit_user_id", debitUserID)
+	if creditUserID != "" && creditUserID == debitUserID {
+		v.add(prefix+"credit_user_id", "must not be the debit user id")
+	}
+	v.transferCode(prefix+"code", code)
+	ledgerOK := v.ledger(prefix+"ledger", ledger)
+	v.amount(prefix+"amount", amount, ledger, ledgerOK)
+}
+
+// attribution checks the reference id and metadata stored with a transfer
+func (v *validator) attribution(prefix string, referenceID string, metadata *structpb.Struct) {
+	if len(referenceID) > maxReferenceIDLength {
+		v.add(prefix+"reference_id", "must be at most %d characters", maxReferenceIDLength)
+	}
+	if metadata != nil {
+		b, err := protojson.Marshal(metadata)
+		if err != nil {
+			v.add(prefix+"metadata", "must be a json object")
+		} else if len(b) > maxMetadataSize {
+			v.add(prefix+"metadata", "must be at most %d bytes as json", maxMetadataSize)
+		}
+	}
+}
+
+func (v *validator) account(userID string, ledger transactionsv1.Ledger) bool {
+	v.uuid("user_id", userID)
+	return v.ledger("ledger", ledger)
+}
+
+func (v *validator) err() error {
+	if len(v.violations) == 0 {
+		return nil
+	}
+
+	err := connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%w: %s %s", ErrInvalidRequest, v.violations[0].Field, v.violations[0].Description))
+	detail, detailErr := connect.NewErrorDetail(&transactionsv1.ErrorDetail{
+		Reason:     transactionsv1.ErrorReason_ErrorReasonInvalidArgument,
+		Violations: v.violations,
+	})
+	if detailErr == nil {
+		err.AddDetail(detail)
+	}
+	return err
+}
+
+// Validate checks a request message of the Transactor, Accounts or Webhooks services,
+// returning an InvalidArgument error with a violation for every invalid field
+func Validate(msg interface{}) error {
+	v := &validator{}
+
+	switch req := msg.(type) {
+	case *transactionsv1.TransactRequest:
+		v.transfer("", req.CreditUserId, req.DebitUserId, req.Code, req.Ledger, req.Amount)
+		v.attribution("", req.ReferenceId, req.Metadata)
+	case *transactionsv1.TransactWithIDRequest:
+		v.transfer("", req.CreditUserId, req.DebitUserId, req.Code, req.Ledger, req.Amount)
+		v.uuid("tx_id", req.TxId)
+		v.attribution("", req.ReferenceId, req.Metadata)
+	case *transactionsv1.TransactBatchRequest:
+		if len(req.Transfers) == 0 {
+			v.add("transfers", "is required")
+		}
+		for i, transfer := range req.Transfers {
+			prefix := fmt.Sprintf("transfers[%d].", i)
+			v.transfer(prefix, transfer.CreditUserId, transfer.DebitUserId, transfer.Code, transfer.Ledger, transfer.Amount)
+			v.attribution(prefix, transfer.ReferenceId, transfer.Metadata)
+			// a batch is committed all or nothing, a key on one leg couldn't replay the rest
+			if transfer.IdempotencyKey != "" {
+				v.add(prefix+"idempotency_key", "isn't supported in a batch")
+			}
+		}
+	case *transactionsv1.TransferReserveRequest:
+		v.transfer("", req.CreditUserId, req.DebitUserId, req.Code, req.Ledger, req.Amount)
+		if req.TimeoutSeconds < 0 || req.TimeoutSeconds > int64(maxPendingTransferTimeout.Seconds()) {
+			v.add("timeout_seconds", "must be between 0 and %d", int64(maxPendingTransferTimeout.Seconds()))
+		}
+		v.attribution("", req.ReferenceId, req.Metadata)
+	case *transactionsv1.TransferPostRequest:
+		v.uuid("reserve_id", req.ReserveId)
+	case *transactionsv1.TransferVoidRequest:
+		v.uuid("reserve_id", req.ReserveId)
+	case *transactionsv1.RefundRequest:
+		v.uuid("transaction_id", req.TransactionId)
+		// a partial refund is checked against the ledger of the original once it is loaded
+		if req.Amount != "" {
+			amount, ok := v.decimal("amount", req.Amount)
+			if ok && !amount.IsPositive() {
+				v.add("amount", "must be positive")
+			}
+		}
+		v.attribution("", req.ReferenceId, req.Metadata)
+	case *transactionsv1.TransferCompleteSubscribeRequest:
+		v.required("id", req.Id)
+		for i, userID := range req.UserIds {
+			v.uuid(fmt.Sprintf("user_ids[%d]", i), userID)
+		}
+		for i, accountID := range req.AccountIds {
+			v.uuid(fmt.Sprintf("account_ids[%d]", i), accountID)
+		}
+		for i, ledger := range req.Ledgers {
+			v.ledger(fmt.Sprintf("ledgers[%d]", i), ledger)
+		}
+		for i, code := range req.Codes {
+			v.transferCode(fmt.Sprintf("codes[%d]", i), code)
+		}
+		if req.ResumeAfter < 0 {
+			v.add("resume_after", "must not be negative")
+		}
+
+	case *transactionsv1.AccountGetViaUserRequest:
+		v.account(req.UserId, req.Ledger)
+	case *transactionsv1.AccountsUserRequest:
+		v.uuid("user_id", req.UserId)
+		for i, ledger := range req.CreateIfNotExist {
+			v.ledger(fmt.Sprintf("create_if_not_exist[%d]", i), ledger)
+		}
+	case *transactionsv1.GetBalanceRequest:
+		v.account(req.UserId, req.Ledger)
+	case *transactionsv1.GetBalanceAtRequest:
+		v.account(req.UserId, req.Ledger)
+		if req.Timestamp <= 0 {
+			v.add("timestamp", "must be a unix timestamp")
+		}
+	case *transactionsv1.TransactionGetByIDRequest:
+		v.uuid("transaction_id", req.TransactionId)
+	case *transactionsv1.TransactionsGetByAccountIDRequest:
+		v.uuid("account_id", req.AccountId)
+		if req.Offset < 0 {
+			v.add("offset", "must not be negative")
+		}
+		if req.PageSize < 0 || req.PageSize > maxPageSize {
+			v.add("page_size", fmt.Sprintf("must be between 0 and %d", maxPageSize))
+		}
+		if req.SortBy != "" && !storage.ValidTransactionColumn(req.SortBy) {
+			v.add("sort_by", "must be a transaction column")
+		}
+		if req.SortDir != "" && req.SortDir != "asc" && req.SortDir != "desc" {
+			v.add("sort_dir", "must be asc or desc")
+		}
+		if req.From < 0 {
+			v.add("from", "must not be negative")
+		}
+		if req.To < 0 {
+			v.add("to", "must not be negative")
+		}
+		if req.From > 0 && req.To > 0 && req.To <= req.From {
+			v.add("to", "must be after from")
+		}
+		for i, code := range req.Codes {
+			v.transferCode(fmt.Sprintf("codes[%d]", i), code)
+		}
+		if _, ok := transactionsv1.TransferDirection_name[int32(req.Direction)]; !ok {
+			v.add("direction", "must be a transfer direction, got %s", req.Direction)
+		}
+		v.optionalUUID("counterparty_account_id", req.CounterpartyAccountId)
+		var amountMin, amountMax decimal.Decimal
+		minOK, maxOK := false, false
+		if req.AmountMin != "" {
+			amountMin, minOK = v.decimal("amount_min", req.AmountMin)
+		}
+		if req.AmountMax != "" {
+			amountMax, maxOK = v.decimal("amount_max", req.AmountMax)
+		}
+		if minOK && maxOK && amountMax.LessThan(amountMin) {
+			v.add("amount_max", "must not be less than amount_min")
+		}
+		v.optionalUUID("initiator_id", req.InitiatorId)
+		v.attribution("", req.ReferenceId, req.Metadata)
+	case *transactionsv1.StatementGetRequest:
+		v.account(req.UserId, req.Ledger)
+		if req.From < 0 {
+			v.add("from", "must not be negative")
+		}
+		if req.To <= req.From {
+			v.add("to", "must be after from")
+		}
+	case *transactionsv1.AccountFlagsSetRequest:
+		ledgerOK := v.account(req.UserId, req.Ledger)
+		if _, ok := transactionsv1.AccountCode_name[int32(req.Code)]; !ok {
+			v.add("code", "must be an account code, got %s", req.Code)
+		}
+		if req.OverdraftLimit != "" {
+			limit, ok := v.decimal("overdraft_limit", req.OverdraftLimit)
+			if ok && ledgerOK {
+				rule := LedgerRules[req.Ledger]
+				if !limit.Equal(limit.Truncate(rule.Precision)) {
+					v.add("overdraft_limit", "must have at most %d decimal places", rule.Precision)
+				} else if limit.GreaterThan(maxStoredAmount) {
+					v.add("overdraft_limit", "must be at most %s", maxStoredAmount)
+				}
+			}
+		}
+	case *transactionsv1.AccountFreezeRequest:
+		v.account(req.UserId, req.Ledger)
+		v.required("reason", req.Reason)
+		v.required("actor", req.Actor)
+	case *transactionsv1.AccountUnfreezeRequest:
+		v.account(req.UserId, req.Ledger)
+		v.required("reason", req.Reason)
+		v.required("actor", req.Actor)
+	case *transactionsv1.AccountFreezeHistoryRequest:
+		v.account(req.UserId, req.Ledger)
+
+	case *transactionsv1.WebhookEndpointCreateRequest:
+		if v.required("url", req.Url) {
+			u, err := url.Parse(req.Url)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				v.add("url", "must be an http or https url")
+			}
+		}
+	case *transactionsv1.WebhookEndpointsListRequest:
+	case *transactionsv1.WebhookEndpointDeleteRequest:
+		v.uuid("id", req.Id)
+	case *transactionsv1.WebhookDeliveriesListRequest:
+		v.optionalUUID("endpoint_id", req.EndpointId)
+		if _, ok := transactionsv1.WebhookDeliveryStatus_name[int32(req.Status)]; !ok {
+			v.add("status", "must be a delivery status, got %s", req.Status)
+		}
+		if req.Limit < 0 {
+			v.add("limit", "must not be negative")
+		}
+	case *transactionsv1.WebhookDeliveryRetryRequest:
+		v.uuid("id", req.Id)
+	}
+
+	return v.err()
+}
+
+// validationInterceptor rejects requests that fail Validate, it covers streaming handlers as well as unary ones
+type validationInterceptor struct{}
+
+// NewValidationInterceptor rejects requests that fail Validate before they reach a handler, or are forwarded to the leader
+func NewValidationInterceptor() connect.Interceptor {
+	return &validationInterceptor{}
+}
+
+func (i *validationInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if !req.Spec().IsClient {
+			err := Validate(req.Any())
+			if err != nil {
+				return nil, err
+			}
+		}
+		return next(ctx, req)
+	}
+}
+
+// WrapStreamingClient leaves client streams alone, the server validates what they send
+func (i *validationInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler validates the first message a stream receives, before the handler can act on it
+func (i *validationInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		return next(ctx, &validatedConn{StreamingHandlerConn: conn})
+	}
+}
+
+// validatedConn validates the first message received on a stream, server streams only ever receive the request
+type validatedConn struct {
+	connect.StreamingHandlerConn
+	received bool
+}
+
+func (c *validatedConn) Receive(msg interface{}) error {
+	err := c.StreamingHandlerConn.Receive(msg)
+	if err != nil || c.received {
+		return err
+	}
+	c.received = true
+	return Validate(msg)
+}
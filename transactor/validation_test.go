@@ -0,0 +1,274 @@
+package transactor
+
+import (
+	"context"
+	"errors"
+	"github.com/bufbuild/connect-go"
+	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/types/known/structpb"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"xsyn-transactions/gen/transactions/v1"
+	"xsyn-transactions/gen/transactions/v1/transactionsv1connect"
+)
+
+const (
+	testUserA = "8bd5fc3b-9f9b-4c39-a6c4-6d8d8d5a3b41"
+	testUserB = "2f1c6a0e-5d3e-4b8c-9a3a-0c4f1b7e9d22"
+)
+
+// violatedFields returns the fields of the violations in a Validate error
+func violatedFields(t *testing.T, err error) []string {
+	if err == nil {
+		return nil
+	}
+	connectErr := &connect.Error{}
+	if !errors.As(err, &connectErr) || connectErr.Code() != connect.CodeInvalidArgument {
+		t.Fatalf("expected an invalid argument error, got %v", err)
+	}
+	if !errors.Is(err, ErrInvalidRequest) {
+		t.Fatalf("expected ErrInvalidRequest, got %v", err)
+	}
+	fields := []string{}
+	for _, d := range connectErr.Details() {
+		msg, err := d.Value()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if detail, ok := msg.(*transactionsv1.ErrorDetail); ok {
+			for _, violation := range detail.Violations {
+				fields = append(fields, violation.Field)
+			}
+		}
+	}
+	return fields
+}
+
+func transactRequest(change func(req *transactionsv1.TransactRequest)) *transactionsv1.TransactRequest {
+	req := &transactionsv1.TransactRequest{
+		CreditUserId: testUserA,
+		DebitUserId:  testUserB,
+		Code:         transactionsv1.TransferCode_Deposit,
+		Ledger:       transactionsv1.Ledger_SUPS,
+		Amount:       "100",
+	}
+	if change != nil {
+		change(req)
+	}
+	return req
+}
+
+func TestValidate(t *testing.T) {
+	bigMetadata, _ := structpb.NewStruct(map[string]interface{}{"note": strings.Repeat("a", maxMetadataSize)})
+
+	tests := []struct {
+		name   string
+		msg    interface{}
+		fields []string
+	}{
+		{"valid transfer", transactRequest(nil), nil},
+		{"missing users", transactRequest(func(req *transactionsv1.TransactRequest) {
+			req.CreditUserId = ""
+			req.DebitUserId = ""
+		}), []string{"credit_user_id", "debit_user_id"}},
+		{"user isn't a uuid", transactRequest(func(req *transactionsv1.TransactRequest) { req.DebitUserId = "user" }), []string{"debit_user_id"}},
+		{"transfer to yourself", transactRequest(func(req *transactionsv1.TransactRequest) { req.DebitUserId = testUserA }), []string{"credit_user_id"}},
+		{"unused transfer code", transactRequest(func(req *transactionsv1.TransactRequest) {
+			req.Code = transactionsv1.TransferCode_UnusedTransferCode
+		}), []string{"code"}},
+		{"unknown transfer code", transactRequest(func(req *transactionsv1.TransactRequest) { req.Code = 9999 }), []string{"code"}},
+		{"unknown ledger skips the amount rules", transactRequest(func(req *transactionsv1.TransactRequest) {
+			req.Ledger = 9999
+			req.Amount = "0.5"
+		}), []string{"ledger"}},
+		{"missing amount", transactRequest(func(req *transactionsv1.TransactRequest) { req.Amount = "" }), []string{"amount"}},
+		{"amount isn't a number", transactRequest(func(req *transactionsv1.TransactRequest) { req.Amount = "ten" }), []string{"amount"}},
+		{"negative amount", transactRequest(func(req *transactionsv1.TransactRequest) { req.Amount = "-1" }), []string{"amount"}},
+		{"zero amount", transactRequest(func(req *transactionsv1.TransactRequest) { req.Amount = "0" }), []string{"amount"}},
+		{"fractional amount", transactRequest(func(req *transactionsv1.TransactRequest) { req.Amount = "1.5" }), []string{"amount"}},
+		{"amount too large to store", transactRequest(func(req *transactionsv1.TransactRequest) {
+			req.Amount = "1" + strings.Repeat("0", 28)
+		}), []string{"amount"}},
+		{"long reference id", transactRequest(func(req *transactionsv1.TransactRequest) {
+			req.ReferenceId = strings.Repeat("r", maxReferenceIDLength+1)
+		}), []string{"reference_id"}},
+		{"large metadata", transactRequest(func(req *transactionsv1.TransactRequest) { req.Metadata = bigMetadata }), []string{"metadata"}},
+		{"every violation at once", &transactionsv1.TransactRequest{Amount: "-1"}, []string{"credit_user_id", "debit_user_id", "code", "ledger", "amount"}},
+
+		{"transfer with id", &transactionsv1.TransactWithIDRequest{
+			CreditUserId: testUserA, DebitUserId: testUserB, Code: transactionsv1.TransferCode_Deposit, Ledger: transactionsv1.Ledger_SUPS, Amount: "1", TxId: "tx",
+		}, []string{"tx_id"}},
+
+		{"empty batch", &transactionsv1.TransactBatchRequest{}, []string{"transfers"}},
+		{"batch legs", &transactionsv1.TransactBatchRequest{Transfers: []*transactionsv1.TransactRequest{
+			transactRequest(nil),
+			transactRequest(func(req *transactionsv1.TransactRequest) { req.Amount = "0" }),
+			transactRequest(func(req *transactionsv1.TransactRequest) { req.IdempotencyKey = "key" }),
+		}}, []string{"transfers[1].amount", "transfers[2].idempotency_key"}},
+
+		{"reserve with a negative timeout", &transactionsv1.TransferReserveRequest{
+			CreditUserId: testUserA, DebitUserId: testUserB, Code: transactionsv1.TransferCode_Deposit, Ledger: transactionsv1.Ledger_SUPS, Amount: "1", TimeoutSeconds: -1,
+		}, []string{"timeout_seconds"}},
+		{"reserve for longer than a hold can last", &transactionsv1.TransferReserveRequest{
+			CreditUserId: testUserA, DebitUserId: testUserB, Code: transactionsv1.TransferCode_Deposit, Ledger: transactionsv1.Ledger_SUPS, Amount: "1", TimeoutSeconds: int64(maxPendingTransferTimeout.Seconds()) + 1,
+		}, []string{"timeout_seconds"}},
+		{"post without a reserve id", &transactionsv1.TransferPostRequest{}, []string{"reserve_id"}},
+
+		{"full refund", &transactionsv1.RefundRequest{TransactionId: testUserA}, nil},
+		{"partial refund", &transactionsv1.RefundRequest{TransactionId: testUserA, Amount: "5"}, nil},
+		{"zero refund", &transactionsv1.RefundRequest{TransactionId: testUserA, Amount: "0"}, []string{"amount"}},
+		{"negative refund", &transactionsv1.RefundRequest{TransactionId: testUserA, Amount: "-5"}, []string{"amount"}},
+
+		{"subscribe to everything", &transactionsv1.TransferCompleteSubscribeRequest{Id: "sub"}, nil},
+		{"subscribe with bad filters", &transactionsv1.TransferCompleteSubscribeRequest{
+			UserIds:     []string{testUserA, "user"},
+			Ledgers:     []transactionsv1.Ledger{9999},
+			Codes:       []transactionsv1.TransferCode{transactionsv1.TransferCode_UnusedTransferCode},
+			ResumeAfter: -1,
+		}, []string{"id", "user_ids[1]", "ledgers[0]", "codes[0]", "resume_after"}},
+
+		{"balance at without a timestamp", &transactionsv1.GetBalanceAtRequest{UserId: testUserA, Ledger: transactionsv1.Ledger_SUPS}, []string{"timestamp"}},
+
+		{"statement", &transactionsv1.StatementGetRequest{UserId: testUserA, Ledger: transactionsv1.Ledger_SUPS, From: 1, To: 2}, nil},
+		{"statement ending before it starts", &transactionsv1.StatementGetRequest{UserId: testUserA, Ledger: transactionsv1.Ledger_SUPS, From: 2, To: 2}, []string{"to"}},
+
+		{"overdraft limit", &transactionsv1.AccountFlagsSetRequest{UserId: testUserA, Ledger: transactionsv1.Ledger_SUPS, OverdraftLimit: "100"}, nil},
+		{"fractional overdraft limit", &transactionsv1.AccountFlagsSetRequest{UserId: testUserA, Ledger: transactionsv1.Ledger_SUPS, OverdraftLimit: "0.5"}, []string{"overdraft_limit"}},
+		{"negative overdraft limit", &transactionsv1.AccountFlagsSetRequest{UserId: testUserA, Ledger: transactionsv1.Ledger_SUPS, OverdraftLimit: "-1"}, []string{"overdraft_limit"}},
+		{"unknown account code", &transactionsv1.AccountFlagsSetRequest{UserId: testUserA, Ledger: transactionsv1.Ledger_SUPS, Code: 9999}, []string{"code"}},
+		{"freeze without a reason", &transactionsv1.AccountFreezeRequest{UserId: testUserA, Ledger: transactionsv1.Ledger_SUPS, Actor: "admin"}, []string{"reason"}},
+		{"unfreeze without an actor", &transactionsv1.AccountUnfreezeRequest{UserId: testUserA, Ledger: transactionsv1.Ledger_SUPS, Reason: "resolved"}, []string{"actor"}},
+
+		{"webhook url", &transactionsv1.WebhookEndpointCreateRequest{Url: "https://example.com/hook"}, nil},
+		{"webhook url isn't http", &transactionsv1.WebhookEndpointCreateRequest{Url: "ftp://example.com/hook"}, []string{"url"}},
+		{"negative delivery limit", &transactionsv1.WebhookDeliveriesListRequest{Limit: -1}, []string{"limit"}},
+
+		{"message without rules", &transactionsv1.WebhookEndpointsListRequest{}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fields := violatedFields(t, Validate(tt.msg))
+			if !equalStrings(fields, tt.fields) {
+				t.Fatalf("expected violations of %v, got %v", tt.fields, fields)
+			}
+		})
+	}
+}
+
+func TestValidateTransactionsQuery(t *testing.T) {
+	tests := []struct {
+		name   string
+		req    *transactionsv1.TransactionsGetByAccountIDRequest
+		fields []string
+	}{
+		{"defaults", &transactionsv1.TransactionsGetByAccountIDRequest{}, nil},
+		{"largest page", &transactionsv1.TransactionsGetByAccountIDRequest{PageSize: maxPageSize}, nil},
+		{"page too large", &transactionsv1.TransactionsGetByAccountIDRequest{PageSize: maxPageSize + 1}, []string{"page_size"}},
+		{"negative page and offset", &transactionsv1.TransactionsGetByAccountIDRequest{PageSize: -1, Offset: -1}, []string{"offset", "page_size"}},
+		{"sort by a column", &transactionsv1.TransactionsGetByAccountIDRequest{SortBy: "amount", SortDir: "asc"}, nil},
+		{"sort by something else", &transactionsv1.TransactionsGetByAccountIDRequest{SortBy: "amount; drop table", SortDir: "up"}, []string{"sort_by", "sort_dir"}},
+		{"time range", &transactionsv1.TransactionsGetByAccountIDRequest{From: 10, To: 20}, nil},
+		{"time range backwards", &transactionsv1.TransactionsGetByAccountIDRequest{From: 20, To: 10}, []string{"to"}},
+		{"open time range", &transactionsv1.TransactionsGetByAccountIDRequest{From: 20}, nil},
+		{"amount range", &transactionsv1.TransactionsGetByAccountIDRequest{AmountMin: "10", AmountMax: "10"}, nil},
+		{"amount range backwards", &transactionsv1.TransactionsGetByAccountIDRequest{AmountMin: "10", AmountMax: "5"}, []string{"amount_max"}},
+		{"amount isn't a number", &transactionsv1.TransactionsGetByAccountIDRequest{AmountMin: "ten"}, []string{"amount_min"}},
+		{"unknown direction", &transactionsv1.TransactionsGetByAccountIDRequest{Direction: 9999}, []string{"direction"}},
+		{"attribution filters", &transactionsv1.TransactionsGetByAccountIDRequest{InitiatorId: testUserB, CounterpartyAccountId: testUserB, ReferenceId: "order"}, nil},
+		{"attribution filters aren't uuids", &transactionsv1.TransactionsGetByAccountIDRequest{InitiatorId: "client", CounterpartyAccountId: "account"}, []string{"counterparty_account_id", "initiator_id"}},
+		{"filter by codes", &transactionsv1.TransactionsGetByAccountIDRequest{Codes: []transactionsv1.TransferCode{transactionsv1.TransferCode_Deposit, 9999}}, []string{"codes[1]"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.AccountId = testUserA
+			fields := violatedFields(t, Validate(tt.req))
+			if !equalStrings(fields, tt.fields) {
+				t.Fatalf("expected violations of %v, got %v", tt.fields, fields)
+			}
+		})
+	}
+}
+
+func TestAmountViolation(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   string
+		ledger   transactionsv1.Ledger
+		violated bool
+	}{
+		{"smallest", "1", transactionsv1.Ledger_SUPS, false},
+		{"largest", maxStoredAmount.String(), transactionsv1.Ledger_SUPS, false},
+		{"trailing zeros", "5.000", transactionsv1.Ledger_SUPS, false},
+		{"below the minimum", "0", transactionsv1.Ledger_SUPS, true},
+		{"too precise", "1.01", transactionsv1.Ledger_SUPS, true},
+		{"above the maximum", maxStoredAmount.Add(decimal.NewFromInt(1)).String(), transactionsv1.Ledger_SUPS, true},
+		{"ledger without rules", "1", 9999, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violation := amountViolation(decimal.RequireFromString(tt.amount), tt.ledger)
+			if (violation != "") != tt.violated {
+				t.Fatalf("expected violated %v, got %q", tt.violated, violation)
+			}
+		})
+	}
+}
+
+func TestValidationInterceptorValidatesStreams(t *testing.T) {
+	handler := &authTestHandler{clients: make(chan *Client, 1)}
+	mux := http.NewServeMux()
+	mux.Handle(transactionsv1connect.NewTransactorHandler(handler, connect.WithInterceptors(NewValidationInterceptor())))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	client := transactionsv1connect.NewTransactorClient(server.Client(), server.URL)
+
+	tests := []struct {
+		name string
+		req  *transactionsv1.TransferCompleteSubscribeRequest
+		code connect.Code
+	}{
+		{"valid", &transactionsv1.TransferCompleteSubscribeRequest{Id: "subscriber", UserIds: []string{testUserA}}, 0},
+		{"without an id", &transactionsv1.TransferCompleteSubscribeRequest{}, connect.CodeInvalidArgument},
+		{"user id that isn't a uuid", &transactionsv1.TransferCompleteSubscribeRequest{Id: "subscriber", UserIds: []string{"alice"}}, connect.CodeInvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream, err := client.TransferCompleteSubscribe(context.Background(), connect.NewRequest(tt.req))
+			if err == nil {
+				defer stream.Close()
+				if stream.Receive() {
+					if tt.code != 0 {
+						t.Fatalf("received %v on an invalid stream", stream.Msg())
+					}
+					<-handler.clients
+					return
+				}
+				err = stream.Err()
+			}
+			if connect.CodeOf(err) != tt.code {
+				t.Fatalf("expected %v, got %v", tt.code, err)
+			}
+			select {
+			case <-handler.clients:
+				if tt.code != 0 {
+					t.Fatal("handler ran for an invalid stream")
+				}
+			default:
+			}
+		})
+	}
+}
+
+func equalStrings(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
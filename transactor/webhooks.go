@@ -0,0 +1,256 @@
+package transactor
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/bufbuild/connect-go"
+	"github.com/volatiletech/null/v8"
+	"google.golang.org/protobuf/encoding/protojson"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+	"xsyn-transactions/boiler"
+	"xsyn-transactions/gen/transactions/v1"
+)
+
+// how often the outbox is checked for events and deliveries that are due
+const webhookDispatchInterval = time.Second
+
+// how many events or deliveries are handled in a dispatch
+const webhookDispatchBatchSize = 100
+
+// how many deliveries are sent at once
+const webhookDeliveryConcurrency = 10
+
+// the longest a request to an endpoint can take
+const webhookTimeout = 10 * time.Second
+
+// how long claimed deliveries are held before another dispatch can send them again. It covers a whole batch timing out,
+// webhookDeliveryConcurrency at a time, with a minute to spare for recording the outcomes.
+const webhookDeliveryLease = (webhookDispatchBatchSize+webhookDeliveryConcurrency-1)/webhookDeliveryConcurrency*webhookTimeout + time.Minute
+
+// a delivery is dead after this many failed attempts
+const webhookMaxAttempts = 12
+
+// the backoff after the first failed attempt, doubling up to webhookMaxBackoff
+const webhookBaseBackoff = 5 * time.Second
+const webhookMaxBackoff = time.Hour
+
+const webhookEventTypeTransferCompleted = "transfer.completed"
+
+// WebhookSignatureHeader is "t=<unix timestamp>,v1=<hex hmac sha256 of "<timestamp>.<body>" with the endpoint secret>"
+const WebhookSignatureHeader = "Xsyn-Signature"
+
+var webhookClient = &http.Client{Timeout: webhookTimeout}
+
+// WebhookEndpointCreate registers an endpoint for transfer events, the secret is only ever returned here
+func (t *Transactor) WebhookEndpointCreate(ctx context.Context, req *connect.Request[transactionsv1.WebhookEndpointCreateRequest]) (*connect.Response[transactionsv1.WebhookEndpointCreateResponse], error) {
+	u, err := url.Parse(req.Msg.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid webhook url: %s", req.Msg.Url))
+	}
+
+	secret := req.Msg.Secret
+	if secret == "" {
+		b := make([]byte, 32)
+		_, err = rand.Read(b)
+		if err != nil {
+			return nil, connectError(err)
+		}
+		secret = hex.EncodeToString(b)
+	}
+
+	endpoint, err := t.Storage.WebhookEndpointCreate(u.String(), secret)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse[transactionsv1.WebhookEndpointCreateResponse](&transactionsv1.WebhookEndpointCreateResponse{
+		Endpoint: endpoint,
+		Secret:   secret,
+	}), nil
+}
+
+func (t *Transactor) WebhookEndpointsList(ctx context.Context, req *connect.Request[transactionsv1.WebhookEndpointsListRequest]) (*connect.Response[transactionsv1.WebhookEndpointsListResponse], error) {
+	endpoints, err := t.Storage.WebhookEndpointsGet()
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse[transactionsv1.WebhookEndpointsListResponse](&transactionsv1.WebhookEndpointsListResponse{Endpoints: endpoints}), nil
+}
+
+// WebhookEndpointDelete stops deliveries to an endpoint, its pending deliveries are marked dead
+func (t *Transactor) WebhookEndpointDelete(ctx context.Context, req *connect.Request[transactionsv1.WebhookEndpointDeleteRequest]) (*connect.Response[transactionsv1.WebhookEndpointDeleteResponse], error) {
+	err := t.Storage.WebhookEndpointDelete(req.Msg.Id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("webhook endpoint not found"))
+	}
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse[transactionsv1.WebhookEndpointDeleteResponse](&transactionsv1.WebhookEndpointDeleteResponse{}), nil
+}
+
+func (t *Transactor) WebhookDeliveriesList(ctx context.Context, req *connect.Request[transactionsv1.WebhookDeliveriesListRequest]) (*connect.Response[transactionsv1.WebhookDeliveriesListResponse], error) {
+	limit := int(req.Msg.Limit)
+	if limit <= 0 || limit > 1000 {
+		limit = 100
+	}
+
+	deliveries, err := t.Storage.WebhookDeliveriesGet(req.Msg.EndpointId, req.Msg.Status, limit)
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse[transactionsv1.WebhookDeliveriesListResponse](&transactionsv1.WebhookDeliveriesListResponse{Deliveries: deliveries}), nil
+}
+
+// WebhookDeliveryRetry puts a dead delivery back to pending
+func (t *Transactor) WebhookDeliveryRetry(ctx context.Context, req *connect.Request[transactionsv1.WebhookDeliveryRetryRequest]) (*connect.Response[transactionsv1.WebhookDeliveryRetryResponse], error) {
+	delivery, err := t.Storage.WebhookDeliveryRetry(req.Msg.Id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("webhook delivery not found"))
+	}
+	if err != nil {
+		return nil, connectError(err)
+	}
+
+	return connect.NewResponse[transactionsv1.WebhookDeliveryRetryResponse](&transactionsv1.WebhookDeliveryRetryResponse{Delivery: delivery}), nil
+}
+
+// dispatchWebhooks fans outbox events out to the endpoints and sends the deliveries that are due.
+// Every event is delivered at least once, endpoints should dedupe on the event id.
+func (t *Transactor) dispatchWebhooks() {
+	ticker := time.NewTicker(webhookDispatchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-t.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		_, err := t.Storage.OutboxEventsFanOut(webhookDispatchBatchSize)
+		if err != nil {
+			t.log.Error().Err(err).Msg("failed to fan out outbox events")
+		}
+
+		deliveries, err := t.Storage.WebhookDeliveriesClaim(webhookDispatchBatchSize, webhookDeliveryLease)
+		if err != nil {
+			t.log.Error().Err(err).Msg("failed to claim webhook deliveries")
+			continue
+		}
+
+		sem := make(chan struct{}, webhookDeliveryConcurrency)
+		wg := sync.WaitGroup{}
+		for _, delivery := range deliveries {
+			sem <- struct{}{}
+			wg.Add(1)
+			go func(delivery *boiler.WebhookDelivery) {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+				t.deliverWebhook(delivery)
+			}(delivery)
+		}
+		wg.Wait()
+	}
+}
+
+// deliverWebhook posts a delivery and records the outcome
+func (t *Transactor) deliverWebhook(delivery *boiler.WebhookDelivery) {
+	err := postWebhook(delivery)
+	if err == nil {
+		err = t.Storage.WebhookDeliveryDelivered(delivery)
+		if err != nil {
+			t.log.Error().Err(err).Str("deliveryID", delivery.ID).Msg("failed to mark webhook delivered")
+		}
+		return
+	}
+
+	nextAttemptAt := null.Time{}
+	if delivery.Attempts+1 < webhookMaxAttempts {
+		nextAttemptAt = null.TimeFrom(time.Now().Add(webhookBackoff(delivery.Attempts + 1)))
+	}
+
+	t.log.Warn().Err(err).
+		Str("deliveryID", delivery.ID).
+		Str("endpointID", delivery.EndpointID).
+		Int("attempt", delivery.Attempts+1).
+		Bool("dead", !nextAttemptAt.Valid).
+		Msg("webhook delivery failed")
+
+	err = t.Storage.WebhookDeliveryFailed(delivery, err, nextAttemptAt)
+	if err != nil {
+		t.log.Error().Err(err).Str("deliveryID", delivery.ID).Msg("failed to record webhook failure")
+	}
+}
+
+// webhookBackoff returns the wait after a number of failed attempts
+func webhookBackoff(attempts int) time.Duration {
+	backoff := webhookBaseBackoff
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= webhookMaxBackoff {
+			return webhookMaxBackoff
+		}
+	}
+	return backoff
+}
+
+func postWebhook(delivery *boiler.WebhookDelivery) error {
+	transfer := &transactionsv1.CompletedTransfer{}
+	err := protojson.Unmarshal(delivery.R.Event.Payload, transfer)
+	if err != nil {
+		return err
+	}
+
+	body, err := protojson.Marshal(&transactionsv1.WebhookEvent{
+		Id:        delivery.EventID,
+		Type:      webhookEventTypeTransferCompleted,
+		CreatedAt: delivery.R.Event.CreatedAt.Unix(),
+		Transfer:  transfer,
+	})
+	if err != nil {
+		return err
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(delivery.R.Endpoint.Secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+
+	req, err := http.NewRequest(http.MethodPost, delivery.R.Endpoint.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookSignatureHeader, fmt.Sprintf("t=%s,v1=%s", timestamp, hex.EncodeToString(mac.Sum(nil))))
+	req.Header.Set("Xsyn-Event-Id", strconv.FormatInt(delivery.EventID, 10))
+	req.Header.Set("Xsyn-Delivery-Id", delivery.ID)
+
+	resp, err := webhookClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook endpoint responded with %d", resp.StatusCode)
+	}
+
+	return nil
+}
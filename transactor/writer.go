@@ -0,0 +1,145 @@
+package transactor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"sort"
+)
+
+// the default number of shards account writes are spread over
+const defaultWriteShards = 64
+
+// the default number of writes that can be in progress or waiting on a shard, later writes wait for room
+const defaultWriteQueueSize = 100
+
+// writeShards serializes writes per account, every account hashes to one shard and a write holds the shards of
+// all the accounts it touches. Writes to disjoint accounts run in parallel while writes to the same account
+// apply one at a time.
+type writeShards struct {
+	// a shard is held by sending to it, blocked senders are served in order so an account's writes apply in the order they arrived
+	shards []chan struct{}
+}
+
+func newWriteShards(n int) *writeShards {
+	if n <= 0 {
+		n = defaultWriteShards
+	}
+	ws := &writeShards{shards: make([]chan struct{}, n)}
+	for i := range ws.shards {
+		ws.shards[i] = make(chan struct{}, 1)
+	}
+	return ws
+}
+
+// of returns the shards of the accounts in ascending order, always locking in the same order means two writes can't deadlock
+func (ws *writeShards) of(accountIDs []string) []int {
+	seen := map[int]bool{}
+	shards := []int{}
+	for _, accountID := range accountIDs {
+		h := fnv.New32a()
+		_, _ = h.Write([]byte(accountID))
+		shard := int(h.Sum32() % uint32(len(ws.shards)))
+		if seen[shard] {
+			continue
+		}
+		seen[shard] = true
+		shards = append(shards, shard)
+	}
+	sort.Ints(shards)
+	return shards
+}
+
+// all returns every shard, holding them all waits out every other write
+func (ws *writeShards) all() []int {
+	shards := make([]int, len(ws.shards))
+	for i := range shards {
+		shards[i] = i
+	}
+	return shards
+}
+
+// lock takes the shards in order, giving back the ones it has if ctx is done first
+func (ws *writeShards) lock(ctx context.Context, shards []int) error {
+	for i, shard := range shards {
+		select {
+		case ws.shards[shard] <- struct{}{}:
+		case <-ctx.Done():
+			ws.unlock(shards[:i])
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+func (ws *writeShards) unlock(shards []int) {
+	for i := len(shards) - 1; i >= 0; i-- {
+		<-ws.shards[shards[i]]
+	}
+}
+
+// write runs a database write on the accounts it touches, only the leader writes so it errors on a follower
+func (t *Transactor) write(ctx context.Context, accountIDs []string, fn func() error) error {
+	return t.run(ctx, t.writeShards.of(accountIDs), func() error {
+		if !t.isLeader() {
+			return ErrNotLeader
+		}
+		return fn()
+	})
+}
+
+// queue runs fn once every write in progress has finished, with no other write running alongside it
+func (t *Transactor) queue(ctx context.Context, fn func() error) error {
+	return t.run(ctx, t.writeShards.all(), fn)
+}
+
+// run waits for a slot in the queue and the shards, then runs fn while holding them.
+// Waiting gives up when ctx is done, with ErrQueueFull if the queue never had room.
+func (t *Transactor) run(ctx context.Context, shards []int, fn func() error) error {
+	if t.closed.Load() {
+		return ErrTimeToClose
+	}
+
+	select {
+	case t.writeSlots <- struct{}{}: //take a slot
+	case <-ctx.Done(): //unless the caller gives up waiting for one
+		if errors.Is(ctx.Err(), context.Canceled) {
+			return ctx.Err()
+		}
+		t.log.Error().Int("queueSize", cap(t.writeSlots)).Msg("Transaction queue is blocked! Too many transactions waiting to be processed.")
+		return ErrQueueFull
+	}
+	defer func() { <-t.writeSlots }()
+
+	// a write that got a slot before the transactor closed is finished, one still waiting for room isn't
+	if t.closed.Load() {
+		return ErrTimeToClose
+	}
+
+	err := t.writeShards.lock(ctx, shards)
+	if err != nil {
+		return err
+	}
+	defer t.writeShards.unlock(shards)
+
+	// skip the write if the caller went away while it waited its turn
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return fn()
+}
+
+// drain stops new writes and waits for the ones holding a slot to finish
+func (t *Transactor) drain(ctx context.Context) error {
+	t.closed.Store(true)
+
+	for i := 0; i < cap(t.writeSlots); i++ {
+		select {
+		case t.writeSlots <- struct{}{}:
+		case <-ctx.Done():
+			return fmt.Errorf("waiting for writes to finish: %w", ctx.Err())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,449 @@
+package transactor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/gofrs/uuid"
+	"github.com/rs/zerolog"
+	"github.com/shopspring/decimal"
+	"os"
+	"runtime"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+	"xsyn-transactions/gen/transactions/v1"
+	"xsyn-transactions/storage"
+)
+
+// BenchmarkTransact makes transfers between disjoint pairs of accounts with a growing connection pool,
+// throughput should scale with the connections until the database is saturated.
+// It needs a migrated database, the XSYN_TRANSACTIONS_DB_* variables point it at one.
+func BenchmarkTransact(b *testing.B) {
+	if os.Getenv("XSYN_TRANSACTIONS_BENCH") != "true" {
+		b.Skip("set XSYN_TRANSACTIONS_BENCH=true to benchmark against a database")
+	}
+
+	log := zerolog.Nop()
+	txr, err := NewTransactor(&NewTransactorOpts{
+		StorageOpts: benchStorageOpts(&log),
+		Log:         &log,
+	})
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer func() {
+		_ = txr.Close(context.Background())
+		_ = txr.Storage.Close()
+	}()
+
+	ctx := withClient(context.Background(), &Client{
+		Name:          "bench",
+		scopes:        map[Scope]bool{ScopeTransact: true},
+		transferCodes: map[transactionsv1.TransferCode]bool{transactionsv1.TransferCode_Unknown: true},
+		ledgers:       map[transactionsv1.Ledger]bool{transactionsv1.Ledger_SUPS: true},
+	})
+
+	treasury := benchAccount(b, txr)
+	_, err = txr.Storage.AccountFlagsSet(txr.Storage, treasury.Id, &storage.AccountFlags{AllowNegativeBalance: true})
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	for _, conns := range []int{1, 2, 4, 8, 16, 32} {
+		b.Run(fmt.Sprintf("conns=%d", conns), func(b *testing.B) {
+			txr.Storage.SetMaxOpenConns(conns)
+			txr.Storage.SetMaxIdleConns(conns)
+
+			// enough writers to keep every connection busy, each with its own pair of accounts
+			parallelism := (2*conns + runtime.GOMAXPROCS(0) - 1) / runtime.GOMAXPROCS(0)
+			pairs := make([][2]string, parallelism*runtime.GOMAXPROCS(0))
+			for i := range pairs {
+				from := benchAccount(b, txr)
+				to := benchAccount(b, txr)
+				_, err := txr.transact(context.Background(), &NewTransaction{
+					CreditUserID:    from.UserId,
+					CreditAccountID: from.Id,
+					DebitUserID:     treasury.UserId,
+					DebitAccountID:  treasury.Id,
+					Amount:          decimal.NewFromInt(1_000_000),
+					Ledger:          transactionsv1.Ledger_SUPS,
+					TransferCode:    transactionsv1.TransferCode_Unknown,
+				})
+				if err != nil {
+					b.Fatal(err)
+				}
+				pairs[i] = [2]string{from.UserId, to.UserId}
+			}
+
+			next := int64(-1)
+			b.SetParallelism(parallelism)
+			b.ResetTimer()
+			start := time.Now()
+			b.RunParallel(func(pb *testing.PB) {
+				pair := pairs[atomic.AddInt64(&next, 1)]
+				for pb.Next() {
+					nt, err := txr.newTransaction(ctx, &transactionsv1.TransactRequest{
+						DebitUserId:  pair[0],
+						CreditUserId: pair[1],
+						Amount:       "1",
+						Ledger:       transactionsv1.Ledger_SUPS,
+						Code:         transactionsv1.TransferCode_Unknown,
+					})
+					if err != nil {
+						b.Error(err)
+						return
+					}
+					_, err = txr.transact(ctx, nt)
+					if err != nil {
+						b.Error(err)
+						return
+					}
+				}
+			})
+			b.ReportMetric(float64(b.N)/time.Since(start).Seconds(), "transfers/s")
+		})
+	}
+}
+
+// TestTransactOnOneConnection writes from more writers than there are pooled connections,
+// a write has to finish on the connection it began with or the writers starve each other.
+// It needs a migrated database like BenchmarkTransact.
+func TestTransactOnOneConnection(t *testing.T) {
+	if os.Getenv("XSYN_TRANSACTIONS_BENCH") != "true" {
+		t.Skip("set XSYN_TRANSACTIONS_BENCH=true to test against a database")
+	}
+
+	log := zerolog.Nop()
+	txr, err := NewTransactor(&NewTransactorOpts{
+		StorageOpts: benchStorageOpts(&log),
+		Log:         &log,
+		WriteShards: 8,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = txr.Close(context.Background())
+		_ = txr.Storage.Close()
+	}()
+
+	treasury := benchAccount(t, txr)
+	_, err = txr.Storage.AccountFlagsSet(txr.Storage, treasury.Id, &storage.AccountFlags{AllowNegativeBalance: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// each writer moves funds between its own pair of accounts, so the writers run on different shards
+	pairs := [][2]*transactionsv1.Account{}
+	for i := 0; i < 8; i++ {
+		from := benchAccount(t, txr)
+		to := benchAccount(t, txr)
+		_, err := txr.transact(context.Background(), &NewTransaction{
+			CreditUserID:    from.UserId,
+			CreditAccountID: from.Id,
+			DebitUserID:     treasury.UserId,
+			DebitAccountID:  treasury.Id,
+			Amount:          decimal.NewFromInt(100),
+			Ledger:          transactionsv1.Ledger_SUPS,
+			TransferCode:    transactionsv1.TransferCode_Unknown,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		pairs = append(pairs, [2]*transactionsv1.Account{from, to})
+	}
+
+	txr.Storage.SetMaxOpenConns(1)
+	txr.Storage.SetMaxIdleConns(1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	errs := make(chan error, len(pairs))
+	for _, pair := range pairs {
+		go func(from *transactionsv1.Account, to *transactionsv1.Account) {
+			for i := 0; i < 10; i++ {
+				_, err := txr.transact(ctx, &NewTransaction{
+					CreditUserID:    to.UserId,
+					CreditAccountID: to.Id,
+					DebitUserID:     from.UserId,
+					DebitAccountID:  from.Id,
+					Amount:          decimal.NewFromInt(1),
+					Ledger:          transactionsv1.Ledger_SUPS,
+					TransferCode:    transactionsv1.TransferCode_Unknown,
+				})
+				if err != nil {
+					errs <- err
+					return
+				}
+			}
+			errs <- nil
+		}(pair[0], pair[1])
+	}
+	for range pairs {
+		err := <-errs
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func benchAccount(b testing.TB, txr *Transactor) *transactionsv1.Account {
+	userID := uuid.Must(uuid.NewV4()).String()
+	err := txr.Storage.CreateAccount(userID, transactionsv1.AccountCode_AccountUser, transactionsv1.Ledger_SUPS)
+	if err != nil {
+		b.Fatal(err)
+	}
+	account, err := txr.get(userID, transactionsv1.Ledger_SUPS)
+	if err != nil {
+		b.Fatal(err)
+	}
+	return account
+}
+
+func benchStorageOpts(log *zerolog.Logger) *storage.Opts {
+	env := func(key string, value string) string {
+		if v, ok := os.LookupEnv("XSYN_TRANSACTIONS_" + key); ok {
+			return v
+		}
+		return value
+	}
+	port, _ := strconv.Atoi(env("DB_PORT", "5433"))
+
+	return &storage.Opts{
+		DatabaseTxUser: env("DB_USER", "xsyn-transactions-db"),
+		DatabaseTxPass: env("DB_PASS", "dev"),
+		DatabaseHost:   env("DB_HOST", "localhost"),
+		DatabasePort:   port,
+		DatabaseName:   env("DB_NAME", "xsyn-transactions-db"),
+		MaxIdle:        32,
+		MaxOpen:        32,
+		Log:            log,
+	}
+}
+
+// newWriteTestTransactor is a leader with the shards and write queue, and no database
+func newWriteTestTransactor(shards int, queueSize int) *Transactor {
+	log := zerolog.Nop()
+	txr := &Transactor{
+		log:         &log,
+		writeShards: newWriteShards(shards),
+		writeSlots:  make(chan struct{}, queueSize),
+	}
+	txr.leader.Store(true)
+	return txr
+}
+
+// disjointAccounts returns n accounts that all hash to different shards
+func disjointAccounts(t *testing.T, ws *writeShards, n int) []string {
+	accounts := []string{}
+	seen := map[int]bool{}
+	for i := 0; len(accounts) < n && i < 10000; i++ {
+		accountID := fmt.Sprintf("account-%d", i)
+		shard := ws.of([]string{accountID})[0]
+		if seen[shard] {
+			continue
+		}
+		seen[shard] = true
+		accounts = append(accounts, accountID)
+	}
+	if len(accounts) < n {
+		t.Fatalf("only found %d accounts in different shards", len(accounts))
+	}
+	return accounts
+}
+
+func TestWriteShardsOf(t *testing.T) {
+	ws := newWriteShards(8)
+	accounts := disjointAccounts(t, ws, 3)
+
+	tests := []struct {
+		name     string
+		accounts []string
+		shards   int
+	}{
+		{"one account", accounts[:1], 1},
+		{"same account twice", []string{accounts[0], accounts[0]}, 1},
+		{"disjoint accounts", accounts, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shards := ws.of(tt.accounts)
+			if len(shards) != tt.shards {
+				t.Fatalf("expected %d shards, got %v", tt.shards, shards)
+			}
+			for i := 1; i < len(shards); i++ {
+				if shards[i-1] >= shards[i] {
+					t.Fatalf("shards aren't in ascending order: %v", shards)
+				}
+			}
+		})
+	}
+}
+
+func TestWriteDisjointAccountsInParallel(t *testing.T) {
+	txr := newWriteTestTransactor(64, 10)
+	accounts := disjointAccounts(t, txr.writeShards, 4)
+
+	// every write waits for all of them to be running, which only happens if they run in parallel
+	var running sync.WaitGroup
+	running.Add(len(accounts))
+	allRunning := make(chan struct{})
+	go func() {
+		running.Wait()
+		close(allRunning)
+	}()
+
+	errs := make(chan error, len(accounts))
+	for _, accountID := range accounts {
+		go func(accountID string) {
+			errs <- txr.write(context.Background(), []string{accountID}, func() error {
+				running.Done()
+				select {
+				case <-allRunning:
+					return nil
+				case <-time.After(5 * time.Second):
+					return fmt.Errorf("writes to disjoint accounts didn't run in parallel")
+				}
+			})
+		}(accountID)
+	}
+	for range accounts {
+		if err := <-errs; err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestWriteSameAccountOneAtATime(t *testing.T) {
+	txr := newWriteTestTransactor(64, 10)
+	accounts := disjointAccounts(t, txr.writeShards, 2)
+
+	var active, maxActive int32
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		// every write touches the first account, half of them the second as well
+		accountIDs := accounts[:1+i%2]
+		go func() {
+			defer wg.Done()
+			err := txr.write(context.Background(), accountIDs, func() error {
+				n := atomic.AddInt32(&active, 1)
+				for {
+					m := atomic.LoadInt32(&maxActive)
+					if n <= m || atomic.CompareAndSwapInt32(&maxActive, m, n) {
+						break
+					}
+				}
+				time.Sleep(time.Millisecond)
+				atomic.AddInt32(&active, -1)
+				return nil
+			})
+			if err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if maxActive != 1 {
+		t.Fatalf("expected writes to the same account one at a time, %d ran at once", maxActive)
+	}
+}
+
+func TestWriteQueueFull(t *testing.T) {
+	txr := newWriteTestTransactor(64, 1)
+	accounts := disjointAccounts(t, txr.writeShards, 2)
+
+	// the only slot is taken by a write that waits until released
+	release := make(chan struct{})
+	started := make(chan struct{})
+	done := make(chan error, 1)
+	go func() {
+		done <- txr.write(context.Background(), accounts[:1], func() error {
+			close(started)
+			<-release
+			return nil
+		})
+	}()
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	ran := false
+	err := txr.write(ctx, accounts[1:], func() error {
+		ran = true
+		return nil
+	})
+	if !errors.Is(err, ErrQueueFull) {
+		t.Fatalf("expected the queue to be full, got %v", err)
+	}
+	if ran {
+		t.Fatal("ran a write that never got a slot")
+	}
+
+	close(release)
+	if err = <-done; err != nil {
+		t.Fatal(err)
+	}
+
+	// the slot is free again
+	err = txr.write(context.Background(), accounts[1:], func() error { return nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWriteSkippedWhenCallerGoesAway(t *testing.T) {
+	txr := newWriteTestTransactor(64, 10)
+	accounts := disjointAccounts(t, txr.writeShards, 1)
+
+	release := make(chan struct{})
+	started := make(chan struct{})
+	done := make(chan error, 1)
+	go func() {
+		done <- txr.write(context.Background(), accounts, func() error {
+			close(started)
+			<-release
+			return nil
+		})
+	}()
+	<-started
+
+	// waits on the held shard until its deadline
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	ran := false
+	err := txr.write(ctx, accounts, func() error {
+		ran = true
+		return nil
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the deadline to pass waiting for the shard, got %v", err)
+	}
+	if ran {
+		t.Fatal("ran a write whose caller went away")
+	}
+
+	close(release)
+	if err = <-done; err != nil {
+		t.Fatal(err)
+	}
+	if len(txr.writeSlots) != 0 {
+		t.Fatalf("%d write slots still held", len(txr.writeSlots))
+	}
+}
+
+func TestWriteOnFollower(t *testing.T) {
+	txr := newWriteTestTransactor(64, 10)
+	txr.leader.Store(false)
+
+	err := txr.write(context.Background(), []string{"account"}, func() error {
+		t.Fatal("a follower ran a write")
+		return nil
+	})
+	if !errors.Is(err, ErrNotLeader) {
+		t.Fatalf("expected ErrNotLeader, got %v", err)
+	}
+}